@@ -6,6 +6,7 @@ package countconnector // import "github.com/open-telemetry/opentelemetry-collec
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/confmap"
@@ -34,6 +35,16 @@ const (
 	defaultMetricDescProfiles = "The number of profiles observed."
 )
 
+// Supported aggregation temporalities of the counts emitted on the flush interval.
+const (
+	aggregationTemporalityDelta      = "delta"
+	aggregationTemporalityCumulative = "cumulative"
+)
+
+// defaultMetricsExpiration is the default duration after which the
+// cumulative counts not updated are dropped.
+const defaultMetricsExpiration = 5 * time.Minute
+
 // Config for the connector
 type Config struct {
 	Spans      map[string]MetricInfo `mapstructure:"spans"`
@@ -42,6 +53,19 @@ type Config struct {
 	DataPoints map[string]MetricInfo `mapstructure:"datapoints"`
 	Logs       map[string]MetricInfo `mapstructure:"logs"`
 	Profiles   map[string]MetricInfo `mapstructure:"profiles"`
+	// FlushInterval, if set, accumulates the counts of each attribute set
+	// across the consumed data and emits them on the interval. If not set,
+	// the counts are emitted for each consumed batch.
+	FlushInterval time.Duration `mapstructure:"flush_interval"`
+	// AggregationTemporality of the counts emitted on the flush interval,
+	// either "delta" (default) or "cumulative". Cumulative counts are kept
+	// and emitted on each flush, including the counts not updated since the
+	// previous flush.
+	AggregationTemporality string `mapstructure:"aggregation_temporality"`
+	// MetricsExpiration is the duration after which the cumulative counts
+	// not updated are dropped and no longer emitted. It bounds the number
+	// of attribute sets kept by the connector.
+	MetricsExpiration time.Duration `mapstructure:"metrics_expiration"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -63,6 +87,24 @@ type AttributeConfig struct {
 }
 
 func (c *Config) Validate() error {
+	if c.FlushInterval < 0 {
+		return fmt.Errorf("flush_interval must not be negative: %v", c.FlushInterval)
+	}
+	if c.MetricsExpiration < 0 {
+		return fmt.Errorf("metrics_expiration must not be negative: %v", c.MetricsExpiration)
+	}
+	switch c.AggregationTemporality {
+	case "", aggregationTemporalityDelta:
+	case aggregationTemporalityCumulative:
+		if c.FlushInterval == 0 {
+			return errors.New("cumulative aggregation_temporality requires flush_interval")
+		}
+		if c.MetricsExpiration == 0 {
+			return errors.New("cumulative aggregation_temporality requires metrics_expiration to bound the counts")
+		}
+	default:
+		return fmt.Errorf("aggregation_temporality must be %q or %q: %q", aggregationTemporalityDelta, aggregationTemporalityCumulative, c.AggregationTemporality)
+	}
 	for name, info := range c.Spans {
 		if name == "" {
 			return errors.New("spans: metric name missing")
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +55,7 @@ func TestLoadConfig(t *testing.T) {
 						Description: defaultMetricDescProfiles,
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -89,6 +91,7 @@ func TestLoadConfig(t *testing.T) {
 						Description: "My description for default profile count metric.",
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -124,6 +127,7 @@ func TestLoadConfig(t *testing.T) {
 						Description: "My profile count.",
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -165,6 +169,7 @@ func TestLoadConfig(t *testing.T) {
 						Conditions:  []string{`IsMatch(resource.attributes["host.name"], "pod-l")`},
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -224,6 +229,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -274,6 +280,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -378,6 +385,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
 			},
 		},
 		{
@@ -430,6 +438,45 @@ func TestLoadConfig(t *testing.T) {
 						Description: defaultMetricDescProfiles,
 					},
 				},
+				MetricsExpiration: defaultMetricsExpiration,
+			},
+		},
+		{
+			name: "flush_interval",
+			expect: &Config{
+				Spans: map[string]MetricInfo{
+					defaultMetricNameSpans: {
+						Description: defaultMetricDescSpans,
+					},
+				},
+				SpanEvents: map[string]MetricInfo{
+					defaultMetricNameSpanEvents: {
+						Description: defaultMetricDescSpanEvents,
+					},
+				},
+				Metrics: map[string]MetricInfo{
+					defaultMetricNameMetrics: {
+						Description: defaultMetricDescMetrics,
+					},
+				},
+				DataPoints: map[string]MetricInfo{
+					defaultMetricNameDataPoints: {
+						Description: defaultMetricDescDataPoints,
+					},
+				},
+				Logs: map[string]MetricInfo{
+					"my.logrecord.count": {
+						Description: "My log record count.",
+					},
+				},
+				Profiles: map[string]MetricInfo{
+					defaultMetricNameProfiles: {
+						Description: defaultMetricDescProfiles,
+					},
+				},
+				FlushInterval:          30 * time.Second,
+				AggregationTemporality: aggregationTemporalityCumulative,
+				MetricsExpiration:      10 * time.Minute,
 			},
 		},
 	}
@@ -595,6 +642,43 @@ func TestConfigErrors(t *testing.T) {
 			},
 			expect: fmt.Sprintf("profiles condition: metric %q: unable to parse OTTL condition", defaultMetricNameProfiles),
 		},
+		{
+			name: "negative_flush_interval",
+			input: &Config{
+				FlushInterval: -time.Second,
+			},
+			expect: "flush_interval must not be negative: -1s",
+		},
+		{
+			name: "invalid_aggregation_temporality",
+			input: &Config{
+				FlushInterval:          time.Second,
+				AggregationTemporality: "unknown",
+			},
+			expect: `aggregation_temporality must be "delta" or "cumulative": "unknown"`,
+		},
+		{
+			name: "cumulative_without_flush_interval",
+			input: &Config{
+				AggregationTemporality: aggregationTemporalityCumulative,
+			},
+			expect: "cumulative aggregation_temporality requires flush_interval",
+		},
+		{
+			name: "negative_metrics_expiration",
+			input: &Config{
+				MetricsExpiration: -time.Second,
+			},
+			expect: "metrics_expiration must not be negative: -1s",
+		},
+		{
+			name: "cumulative_without_metrics_expiration",
+			input: &Config{
+				FlushInterval:          time.Second,
+				AggregationTemporality: aggregationTemporalityCumulative,
+			},
+			expect: "cumulative aggregation_temporality requires metrics_expiration to bound the counts",
+		},
 	}
 
 	for _, tc := range testCases {
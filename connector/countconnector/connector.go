@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -15,6 +17,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
@@ -29,8 +32,14 @@ import (
 // profiles and emit the counts onto a metrics pipeline.
 type count struct {
 	metricsConsumer consumer.Metrics
-	component.StartFunc
-	component.ShutdownFunc
+	logger          *zap.Logger
+
+	// window accumulates the counts emitted on the flush interval, it is
+	// nil if the counts are emitted for each consumed batch.
+	window        *window
+	flushInterval time.Duration
+	shutdownCh    chan struct{}
+	wg            sync.WaitGroup
 
 	spansMetricDefs      map[string]metricDef[ottlspan.TransformContext]
 	spanEventsMetricDefs map[string]metricDef[ottlspanevent.TransformContext]
@@ -44,6 +53,61 @@ func (c *count) Capabilities() consumer.Capabilities {
 	return consumer.Capabilities{MutatesData: false}
 }
 
+func (c *count) Start(context.Context, component.Host) error {
+	if c.window == nil {
+		return nil
+	}
+	c.shutdownCh = make(chan struct{})
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.flush(context.Background())
+			case <-c.shutdownCh:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *count) Shutdown(ctx context.Context) error {
+	if c.shutdownCh == nil {
+		return nil
+	}
+	close(c.shutdownCh)
+	c.wg.Wait()
+	c.shutdownCh = nil
+	// Emit the counts recorded since the last flush.
+	c.flush(ctx)
+	return nil
+}
+
+// flush emits the counts accumulated in the window.
+func (c *count) flush(ctx context.Context) {
+	countMetrics := c.window.flush(time.Now())
+	if countMetrics.DataPointCount() == 0 {
+		return
+	}
+	if err := c.metricsConsumer.ConsumeMetrics(ctx, countMetrics); err != nil {
+		c.logger.Error("failed to emit the aggregated counts", zap.Error(err))
+	}
+}
+
+// emit sends the counts of a consumed batch to the next consumer, or
+// records them in the window if the counts are emitted on an interval.
+func (c *count) emit(ctx context.Context, countMetrics pmetric.Metrics) error {
+	if c.window != nil {
+		c.window.record(countMetrics)
+		return nil
+	}
+	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+}
+
 func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	var multiError error
 	countMetrics := pmetric.NewMetrics()
@@ -86,7 +150,7 @@ func (c *count) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
@@ -161,7 +225,7 @@ func (c *count) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
@@ -199,7 +263,7 @@ func (c *count) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
 
 func (c *count) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error {
@@ -238,5 +302,5 @@ func (c *count) ConsumeProfiles(ctx context.Context, ld pprofile.Profiles) error
 	if multiError != nil {
 		return multiError
 	}
-	return c.metricsConsumer.ConsumeMetrics(ctx, countMetrics)
+	return c.emit(ctx, countMetrics)
 }
@@ -7,6 +7,7 @@ import (
 	"context"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,8 @@ import (
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/connector/xconnector"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
@@ -695,6 +698,164 @@ func TestLogsToMetrics(t *testing.T) {
 	}
 }
 
+func TestLogsToMetricsWithFlushInterval(t *testing.T) {
+	testCases := []struct {
+		name        string
+		temporality string
+	}{
+		{
+			name:        "delta",
+			temporality: aggregationTemporalityDelta,
+		},
+		{
+			name:        "cumulative",
+			temporality: aggregationTemporalityCumulative,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Config{
+				Logs: map[string]MetricInfo{
+					"count.all": {
+						Description: "All logs count",
+					},
+					"count.if": {
+						Description: "Count if ...",
+						Conditions: []string{
+							`resource.attributes["resource.optional"] != nil`,
+						},
+					},
+				},
+				// Long enough for the counts to be only flushed by the test.
+				FlushInterval:          time.Hour,
+				AggregationTemporality: tc.temporality,
+				MetricsExpiration:      time.Hour,
+			}
+			require.NoError(t, cfg.Validate())
+			factory := NewFactory()
+			sink := &consumertest.MetricsSink{}
+			conn, err := factory.CreateLogsToMetrics(context.Background(),
+				connectortest.NewNopSettings(metadata.Type), cfg, sink)
+			require.NoError(t, err)
+			require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+
+			testLogs, err := golden.ReadLogs(filepath.Join("testdata", "logs", "input.yaml"))
+			require.NoError(t, err)
+			expected, err := golden.ReadMetrics(filepath.Join("testdata", "logs", "multiple_metrics.yaml"))
+			require.NoError(t, err)
+
+			// The counts of both batches are accumulated until the flush.
+			require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs))
+			require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs))
+			assert.Empty(t, sink.AllMetrics())
+			conn.(*count).flush(context.Background())
+			require.Len(t, sink.AllMetrics(), 1)
+			assertWindowCounts(t, expected, 2, tc.temporality, sink.AllMetrics()[0])
+
+			// Shutdown flushes the counts recorded since the previous flush.
+			require.NoError(t, conn.ConsumeLogs(context.Background(), testLogs))
+			require.NoError(t, conn.Shutdown(context.Background()))
+			require.Len(t, sink.AllMetrics(), 2)
+			if tc.temporality == aggregationTemporalityCumulative {
+				assertWindowCounts(t, expected, 3, tc.temporality, sink.AllMetrics()[1])
+				assertSameStartTimestamps(t, sink.AllMetrics()[0], sink.AllMetrics()[1])
+			} else {
+				assertWindowCounts(t, expected, 1, tc.temporality, sink.AllMetrics()[1])
+			}
+		})
+	}
+}
+
+func TestWindowExpiration(t *testing.T) {
+	w := newWindow(pmetric.AggregationTemporalityCumulative, time.Minute)
+
+	newCounts := func(env string) pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		rm := md.ResourceMetrics().AppendEmpty()
+		rm.Resource().Attributes().PutStr("service.name", "svc")
+		m := rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+		m.SetName("log.count")
+		dp := m.SetEmptySum().DataPoints().AppendEmpty()
+		dp.Attributes().PutStr("env", env)
+		dp.SetIntValue(1)
+		return md
+	}
+	w.record(newCounts("prod"))
+	w.record(newCounts("dev"))
+	start := time.Now()
+	assert.Equal(t, 2, w.flush(start).DataPointCount())
+
+	// The counts updated within the expiration are kept.
+	w.record(newCounts("prod"))
+	assert.Equal(t, 2, w.flush(start.Add(30*time.Second)).DataPointCount())
+
+	// The idle counts are dropped, the others are still emitted.
+	md := w.flush(start.Add(time.Minute))
+	require.Equal(t, 1, md.DataPointCount())
+	dp := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0).Sum().DataPoints().At(0)
+	assert.Equal(t, map[string]any{"env": "prod"}, dp.Attributes().AsRaw())
+	assert.Equal(t, int64(2), dp.IntValue())
+
+	// The resources left without counts are dropped.
+	assert.Equal(t, 0, w.flush(start.Add(2*time.Minute)).DataPointCount())
+	assert.Empty(t, w.resources)
+}
+
+// assertWindowCounts asserts the flushed counts are the counts of a single
+// batch multiplied by the number of batches.
+func assertWindowCounts(t *testing.T, batch pmetric.Metrics, batches int64, temporality string, actual pmetric.Metrics) {
+	t.Helper()
+
+	expected := pmetric.NewMetrics()
+	batch.CopyTo(expected)
+	forEachSum(expected, func(sum pmetric.Sum) {
+		sum.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+		if temporality == aggregationTemporalityCumulative {
+			sum.SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		}
+		for i := 0; i < sum.DataPoints().Len(); i++ {
+			dp := sum.DataPoints().At(i)
+			dp.SetIntValue(dp.IntValue() * batches)
+		}
+	})
+	assert.NoError(t, pmetrictest.CompareMetrics(expected, actual,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder()))
+}
+
+func assertSameStartTimestamps(t *testing.T, first, second pmetric.Metrics) {
+	t.Helper()
+
+	var firstStarts, secondStarts []pcommon.Timestamp
+	forEachSum(first, func(sum pmetric.Sum) {
+		for i := 0; i < sum.DataPoints().Len(); i++ {
+			firstStarts = append(firstStarts, sum.DataPoints().At(i).StartTimestamp())
+		}
+	})
+	forEachSum(second, func(sum pmetric.Sum) {
+		for i := 0; i < sum.DataPoints().Len(); i++ {
+			secondStarts = append(secondStarts, sum.DataPoints().At(i).StartTimestamp())
+		}
+	})
+	assert.ElementsMatch(t, firstStarts, secondStarts)
+}
+
+func forEachSum(md pmetric.Metrics, fn func(sum pmetric.Sum)) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				fn(ms.At(k).Sum())
+			}
+		}
+	}
+}
+
 // The test input file has a repetitive structure:
 // - There are four resources, each with four profiles, each with one sample.
 // - The four resources have the following sets of attributes:
@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/connector"
 	"go.opentelemetry.io/collector/connector/xconnector"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pmetric"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
@@ -38,7 +39,9 @@ func NewFactory() connector.Factory {
 
 // createDefaultConfig creates the default configuration.
 func createDefaultConfig() component.Config {
-	return &Config{}
+	return &Config{
+		MetricsExpiration: defaultMetricsExpiration,
+	}
 }
 
 // createTracesToMetrics creates a traces to metrics connector based on provided config.
@@ -80,6 +83,9 @@ func createTracesToMetrics(
 
 	return &count{
 		metricsConsumer:      nextConsumer,
+		logger:               set.Logger,
+		window:               newCountWindow(c),
+		flushInterval:        c.FlushInterval,
 		spansMetricDefs:      spanMetricDefs,
 		spanEventsMetricDefs: spanEventMetricDefs,
 	}, nil
@@ -123,6 +129,9 @@ func createMetricsToMetrics(
 
 	return &count{
 		metricsConsumer:      nextConsumer,
+		logger:               set.Logger,
+		window:               newCountWindow(c),
+		flushInterval:        c.FlushInterval,
 		metricsMetricDefs:    metricMetricDefs,
 		dataPointsMetricDefs: dataPointMetricDefs,
 	}, nil
@@ -153,6 +162,9 @@ func createLogsToMetrics(
 
 	return &count{
 		metricsConsumer: nextConsumer,
+		logger:          set.Logger,
+		window:          newCountWindow(c),
+		flushInterval:   c.FlushInterval,
 		logsMetricDefs:  metricDefs,
 	}, nil
 }
@@ -182,6 +194,9 @@ func createProfilesToMetrics(
 
 	return &count{
 		metricsConsumer:    nextConsumer,
+		logger:             set.Logger,
+		window:             newCountWindow(c),
+		flushInterval:      c.FlushInterval,
 		profilesMetricDefs: metricDefs,
 	}, nil
 }
@@ -191,3 +206,15 @@ type metricDef[K any] struct {
 	desc      string
 	attrs     []AttributeConfig
 }
+
+// newCountWindow creates the window accumulating the counts if they are
+// emitted on a flush interval, it returns nil otherwise.
+func newCountWindow(c *Config) *window {
+	if c.FlushInterval == 0 {
+		return nil
+	}
+	if c.AggregationTemporality == aggregationTemporalityCumulative {
+		return newWindow(pmetric.AggregationTemporalityCumulative, c.MetricsExpiration)
+	}
+	return newWindow(pmetric.AggregationTemporalityDelta, 0)
+}
@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package countconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector"
+
+import (
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/countconnector/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// window accumulates the counts of the consumed batches until they are
+// flushed. The counts are keyed by resource, metric name and attributes.
+type window struct {
+	temporality pmetric.AggregationTemporality
+	// expiration is the duration after which the cumulative counts not
+	// updated are dropped.
+	expiration time.Duration
+
+	mu        sync.Mutex
+	resources map[[16]byte]*windowResource
+	// startTime is the start of the current window for delta counts.
+	startTime time.Time
+}
+
+type windowResource struct {
+	attrs   pcommon.Map
+	metrics map[string]*windowMetric
+	// order keeps the metrics in the order they were first recorded, so
+	// the flushed metrics are stable.
+	order []string
+}
+
+type windowMetric struct {
+	desc   string
+	counts map[[16]byte]*windowCount
+}
+
+type windowCount struct {
+	attrs pcommon.Map
+	count int64
+	// startTime is the time the count was first recorded, it is the start
+	// time of the cumulative counts.
+	startTime time.Time
+	// lastSeen is the time the count was last updated.
+	lastSeen time.Time
+}
+
+func newWindow(temporality pmetric.AggregationTemporality, expiration time.Duration) *window {
+	return &window{
+		temporality: temporality,
+		expiration:  expiration,
+		resources:   make(map[[16]byte]*windowResource),
+		startTime:   time.Now(),
+	}
+}
+
+// record adds the counts of a consumed batch to the window.
+func (w *window) record(md pmetric.Metrics) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := time.Now()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		resourceMetric := md.ResourceMetrics().At(i)
+		resourceAttrs := resourceMetric.Resource().Attributes()
+		resID := pdatautil.MapHash(resourceAttrs)
+		res, ok := w.resources[resID]
+		if !ok {
+			res = &windowResource{
+				attrs:   pcommon.NewMap(),
+				metrics: make(map[string]*windowMetric),
+			}
+			resourceAttrs.CopyTo(res.attrs)
+			w.resources[resID] = res
+		}
+		for j := 0; j < resourceMetric.ScopeMetrics().Len(); j++ {
+			metrics := resourceMetric.ScopeMetrics().At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				res.recordMetric(metrics.At(k), now)
+			}
+		}
+	}
+}
+
+func (r *windowResource) recordMetric(metric pmetric.Metric, now time.Time) {
+	m, ok := r.metrics[metric.Name()]
+	if !ok {
+		m = &windowMetric{
+			desc:   metric.Description(),
+			counts: make(map[[16]byte]*windowCount),
+		}
+		r.metrics[metric.Name()] = m
+		r.order = append(r.order, metric.Name())
+	}
+	dps := metric.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		key := noAttributes
+		if dp.Attributes().Len() > 0 {
+			key = pdatautil.MapHash(dp.Attributes())
+		}
+		c, ok := m.counts[key]
+		if !ok {
+			c = &windowCount{attrs: pcommon.NewMap(), startTime: now}
+			dp.Attributes().CopyTo(c.attrs)
+			m.counts[key] = c
+		}
+		c.count += dp.IntValue()
+		c.lastSeen = now
+	}
+}
+
+// flush returns the counts of the window. The delta counts are reset, so
+// the next window starts at the flush time, and the expired cumulative
+// counts are dropped.
+func (w *window) flush(now time.Time) pmetric.Metrics {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.temporality == pmetric.AggregationTemporalityCumulative && w.expiration > 0 {
+		w.expire(now)
+	}
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().EnsureCapacity(len(w.resources))
+	for _, res := range w.resources {
+		countResource := md.ResourceMetrics().AppendEmpty()
+		res.attrs.CopyTo(countResource.Resource().Attributes())
+		countScope := countResource.ScopeMetrics().AppendEmpty()
+		countScope.Scope().SetName(metadata.ScopeName)
+		for _, name := range res.order {
+			m := res.metrics[name]
+			countMetric := countScope.Metrics().AppendEmpty()
+			countMetric.SetName(name)
+			countMetric.SetDescription(m.desc)
+			sum := countMetric.SetEmptySum()
+			sum.SetIsMonotonic(true)
+			sum.SetAggregationTemporality(w.temporality)
+			sum.DataPoints().EnsureCapacity(len(m.counts))
+			for _, c := range m.counts {
+				dp := sum.DataPoints().AppendEmpty()
+				c.attrs.CopyTo(dp.Attributes())
+				dp.SetIntValue(c.count)
+				startTime := w.startTime
+				if w.temporality == pmetric.AggregationTemporalityCumulative {
+					startTime = c.startTime
+				}
+				dp.SetStartTimestamp(pcommon.NewTimestampFromTime(startTime))
+				dp.SetTimestamp(pcommon.NewTimestampFromTime(now))
+			}
+		}
+	}
+	if w.temporality == pmetric.AggregationTemporalityDelta {
+		w.resources = make(map[[16]byte]*windowResource)
+		w.startTime = now
+	}
+	return md
+}
+
+// expire drops the counts not updated within the expiration, and the
+// resources and metrics left without counts.
+func (w *window) expire(now time.Time) {
+	for resID, res := range w.resources {
+		order := res.order[:0]
+		for _, name := range res.order {
+			m := res.metrics[name]
+			for key, c := range m.counts {
+				if now.Sub(c.lastSeen) >= w.expiration {
+					delete(m.counts, key)
+				}
+			}
+			if len(m.counts) == 0 {
+				delete(res.metrics, name)
+				continue
+			}
+			order = append(order, name)
+		}
+		res.order = order
+		if len(res.order) == 0 {
+			delete(w.resources, resID)
+		}
+	}
+}
@@ -23,6 +23,19 @@ type Exemplars struct {
 	_ struct{}
 }
 
+// Fingerprint defines the configuration for grouping the exceptions by fingerprint.
+type Fingerprint struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxFrames is the number of innermost stack frames used to compute the fingerprint,
+	// all the frames are used if it is 0.
+	MaxFrames int `mapstructure:"max_frames"`
+	// MaxIssues is the maximum number of issues kept by the connector, the least
+	// recently seen issue is dropped when a new issue exceeds it.
+	MaxIssues int `mapstructure:"max_issues"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // Config defines the configuration options for exceptionsconnector
 type Config struct {
 	// Dimensions defines the list of additional dimensions on top of the provided:
@@ -35,6 +48,8 @@ type Config struct {
 	Dimensions []Dimension `mapstructure:"dimensions"`
 	// Exemplars defines the configuration for exemplars.
 	Exemplars Exemplars `mapstructure:"exemplars"`
+	// Fingerprint defines the configuration for grouping the exceptions by fingerprint.
+	Fingerprint Fingerprint `mapstructure:"fingerprint"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -47,6 +62,12 @@ func (c Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	if c.Fingerprint.MaxFrames < 0 {
+		return fmt.Errorf("fingerprint max_frames must not be negative: %d", c.Fingerprint.MaxFrames)
+	}
+	if c.Fingerprint.Enabled && c.Fingerprint.MaxIssues <= 0 {
+		return fmt.Errorf("fingerprint max_issues must be positive: %d", c.Fingerprint.MaxIssues)
+	}
 	return nil
 }
 
@@ -40,6 +40,11 @@ func TestLoadConfig(t *testing.T) {
 				Exemplars: Exemplars{
 					Enabled: false,
 				},
+				Fingerprint: Fingerprint{
+					Enabled:   true,
+					MaxFrames: 5,
+					MaxIssues: 100,
+				},
 			},
 		},
 	}
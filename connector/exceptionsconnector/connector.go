@@ -4,7 +4,10 @@
 package exceptionsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exceptionsconnector"
 
 import (
+	"time"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 	conventions "go.opentelemetry.io/otel/semconv/v1.27.0"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/pdatautil"
@@ -62,3 +65,20 @@ func getDimensionValue(d pdatautil.Dimension, spanAttrs pcommon.Map, eventAttrs
 	}
 	return v, ok
 }
+
+// addIssue computes the fingerprint of an exception event and records the occurrence
+// of the exception in the issues.
+func addIssue(iss *issues, serviceName string, event ptrace.SpanEvent, maxFrames int) (fp string, firstSeen, lastSeen pcommon.Timestamp) {
+	eventAttrs := event.Attributes()
+	excType, _ := pdatautil.GetAttributeValue(exceptionTypeKey, eventAttrs)
+	message, _ := pdatautil.GetAttributeValue(exceptionMessageKey, eventAttrs)
+	stacktrace, _ := pdatautil.GetAttributeValue(exceptionStacktraceKey, eventAttrs)
+	fp = fingerprint(excType, message, stacktrace, maxFrames)
+
+	ts := event.Timestamp()
+	if ts == 0 {
+		ts = pcommon.NewTimestampFromTime(time.Now())
+	}
+	firstSeen, lastSeen = iss.add(serviceName, excType, fp, ts)
+	return fp, firstSeen, lastSeen
+}
@@ -5,6 +5,7 @@ package exceptionsconnector // import "github.com/open-telemetry/opentelemetry-c
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -24,6 +25,10 @@ type logsConnector struct {
 	// Additional dimensions to add to logs.
 	dimensions []pdatautil.Dimension
 
+	// issues groups the exceptions by fingerprint, it is nil if the fingerprinting is disabled.
+	// The issues are not shared with the other connectors, each one groups the exceptions it consumes.
+	issues *issues
+
 	logsConsumer consumer.Logs
 	component.StartFunc
 	component.ShutdownFunc
@@ -34,11 +39,15 @@ type logsConnector struct {
 func newLogsConnector(logger *zap.Logger, config component.Config) *logsConnector {
 	cfg := config.(*Config)
 
-	return &logsConnector{
+	c := &logsConnector{
 		logger:     logger,
 		config:     *cfg,
 		dimensions: newDimensions(cfg.Dimensions),
 	}
+	if cfg.Fingerprint.Enabled {
+		c.issues = newIssues(cfg.Fingerprint.MaxIssues)
+	}
+	return c
 }
 
 // Capabilities implements the consumer interface.
@@ -122,5 +131,13 @@ func (c *logsConnector) attrToLogRecord(sl plog.ScopeLogs, serviceName string, s
 	// Add stacktrace to the log record.
 	attrVal, _ := pdatautil.GetAttributeValue(exceptionStacktraceKey, eventAttrs)
 	logRecord.Attributes().PutStr(exceptionStacktraceKey, attrVal)
+
+	// Add the fingerprint of the exception to the log record.
+	if c.issues != nil {
+		fp, firstSeen, lastSeen := addIssue(c.issues, serviceName, event, c.config.Fingerprint.MaxFrames)
+		logRecord.Attributes().PutStr(exceptionFingerprintKey, fp)
+		logRecord.Attributes().PutStr(exceptionFirstSeenKey, firstSeen.AsTime().Format(time.RFC3339Nano))
+		logRecord.Attributes().PutStr(exceptionLastSeenKey, lastSeen.AsTime().Format(time.RFC3339Nano))
+	}
 	return logRecord
 }
@@ -46,6 +46,47 @@ func TestConnectorLogConsumeTraces(t *testing.T) {
 	}
 }
 
+func TestConnectorLogConsumeTracesWithFingerprint(t *testing.T) {
+	lsink := new(consumertest.LogsSink)
+
+	p := newTestLogsConnector(lsink, zaptest.NewLogger(t))
+	p.config.Fingerprint = Fingerprint{Enabled: true, MaxFrames: 10, MaxIssues: 10}
+	p.issues = newIssues(p.config.Fingerprint.MaxIssues)
+
+	ctx := metadata.NewIncomingContext(context.Background(), nil)
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+
+	logs := lsink.AllLogs()
+	require.Len(t, logs, 2)
+	wantFingerprint := fingerprint("Exception", "Exception message", "Exception stacktrace", 10)
+	var firstSeen string
+	for _, ld := range logs {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			records := ld.ResourceLogs().At(i).ScopeLogs().At(0).LogRecords()
+			for j := 0; j < records.Len(); j++ {
+				attrs := records.At(j).Attributes()
+				fp, ok := attrs.Get(exceptionFingerprintKey)
+				require.True(t, ok)
+				assert.Equal(t, wantFingerprint, fp.Str())
+
+				svc, _ := attrs.Get(serviceNameKey)
+				seen, ok := attrs.Get(exceptionFirstSeenKey)
+				require.True(t, ok)
+				if svc.Str() == "service-a" {
+					if firstSeen == "" {
+						firstSeen = seen.Str()
+					}
+					// the first seen timestamp doesn't change across occurrences
+					assert.Equal(t, firstSeen, seen.Str())
+				}
+				_, ok = attrs.Get(exceptionLastSeenKey)
+				assert.True(t, ok)
+			}
+		}
+	}
+}
+
 func newTestLogsConnector(lcon consumer.Logs, logger *zap.Logger) *logsConnector {
 	cfg := &Config{
 		Dimensions: []Dimension{
@@ -39,6 +39,9 @@ type metricsConnector struct {
 	component.ShutdownFunc
 
 	exceptions map[string]*exception
+	// issues groups the exceptions by fingerprint, it is nil if the fingerprinting is disabled.
+	// The issues are not shared with the other connectors, each one groups the exceptions it consumes.
+	issues *issues
 
 	logger *zap.Logger
 
@@ -55,7 +58,7 @@ type exception struct {
 func newMetricsConnector(logger *zap.Logger, config component.Config) *metricsConnector {
 	cfg := config.(*Config)
 
-	return &metricsConnector{
+	c := &metricsConnector{
 		logger:         logger,
 		config:         *cfg,
 		dimensions:     newDimensions(cfg.Dimensions),
@@ -63,6 +66,10 @@ func newMetricsConnector(logger *zap.Logger, config component.Config) *metricsCo
 		startTimestamp: pcommon.NewTimestampFromTime(time.Now()),
 		exceptions:     make(map[string]*exception),
 	}
+	if cfg.Fingerprint.Enabled {
+		c.issues = newIssues(cfg.Fingerprint.MaxIssues)
+	}
+	return c
 }
 
 // Capabilities implements the consumer interface.
@@ -99,6 +106,9 @@ func (c *metricsConnector) ConsumeTraces(ctx context.Context, traces ptrace.Trac
 						attrs := buildDimensionKVs(c.dimensions, serviceName, span, eventAttrs, resourceAttr)
 						exc := c.addException(key, attrs)
 						c.addExemplar(exc, span.TraceID(), span.SpanID())
+						if c.issues != nil {
+							addIssue(c.issues, serviceName, event, c.config.Fingerprint.MaxFrames)
+						}
 					}
 				}
 			}
@@ -149,9 +159,47 @@ func (c *metricsConnector) collectExceptions(ilm pmetric.ScopeMetrics) error {
 		// Reset the exemplars for the next batch of spans.
 		exc.exemplars = pmetric.NewExemplarSlice()
 	}
+	if c.issues != nil {
+		c.collectIssues(ilm, timestamp)
+	}
 	return nil
 }
 
+// collectIssues writes the count, first and last seen timestamps of the exceptions
+// grouped by fingerprint into the metrics object.
+func (c *metricsConnector) collectIssues(ilm pmetric.ScopeMetrics, timestamp pcommon.Timestamp) {
+	mCount := ilm.Metrics().AppendEmpty()
+	mCount.SetName("exceptions.issues")
+	mCount.SetEmptySum().SetIsMonotonic(true)
+	mCount.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	mFirstSeen := ilm.Metrics().AppendEmpty()
+	mFirstSeen.SetName("exceptions.issues.first_seen")
+	mFirstSeen.SetUnit("s")
+	mFirstSeen.SetEmptyGauge()
+	mLastSeen := ilm.Metrics().AppendEmpty()
+	mLastSeen.SetName("exceptions.issues.last_seen")
+	mLastSeen.SetUnit("s")
+	mLastSeen.SetEmptyGauge()
+
+	c.issues.forEach(func(iss *issue) {
+		dp := mCount.Sum().DataPoints().AppendEmpty()
+		dp.SetStartTimestamp(iss.startTime)
+		dp.SetTimestamp(timestamp)
+		dp.SetIntValue(int64(iss.count))
+		iss.attrs.CopyTo(dp.Attributes())
+
+		for _, seen := range []struct {
+			metric pmetric.Metric
+			ts     pcommon.Timestamp
+		}{{mFirstSeen, iss.firstSeen}, {mLastSeen, iss.lastSeen}} {
+			dp := seen.metric.Gauge().DataPoints().AppendEmpty()
+			dp.SetTimestamp(timestamp)
+			dp.SetIntValue(seen.ts.AsTime().Unix())
+			iss.attrs.CopyTo(dp.Attributes())
+		}
+	})
+}
+
 func (c *metricsConnector) addException(excKey string, attrs pcommon.Map) *exception {
 	exc, ok := c.exceptions[excKey]
 	if !ok {
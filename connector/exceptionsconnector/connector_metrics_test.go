@@ -107,6 +107,54 @@ func TestConnectorConsumeTraces(t *testing.T) {
 	})
 }
 
+func TestConnectorConsumeTracesWithFingerprint(t *testing.T) {
+	msink := &consumertest.MetricsSink{}
+
+	p := newTestMetricsConnector(msink, stringp("defaultNullValue"), zaptest.NewLogger(t))
+	p.config.Fingerprint = Fingerprint{Enabled: true, MaxFrames: 10, MaxIssues: 10}
+	p.issues = newIssues(p.config.Fingerprint.MaxIssues)
+
+	ctx := metadata.NewIncomingContext(context.Background(), nil)
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+	require.NoError(t, p.ConsumeTraces(ctx, buildSampleTrace()))
+
+	metrics := msink.AllMetrics()
+	require.Len(t, metrics, 2)
+	m := metrics[1].ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 4, m.Len())
+
+	wantFingerprint := fingerprint("Exception", "Exception message", "Exception stacktrace", 10)
+	wantCounts := map[string]int64{"service-a": 4, "service-b": 2}
+	startTimes := make(map[string]pcommon.Timestamp)
+	p.issues.forEach(func(iss *issue) {
+		svc, _ := iss.attrs.Get(serviceNameKey)
+		startTimes[svc.Str()] = iss.startTime
+	})
+	assert.Equal(t, "exceptions.issues", m.At(1).Name())
+	dps := m.At(1).Sum().DataPoints()
+	require.Equal(t, 2, dps.Len())
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		svc, _ := dp.Attributes().Get(serviceNameKey)
+		excType, _ := dp.Attributes().Get(exceptionTypeKey)
+		fp, _ := dp.Attributes().Get(exceptionFingerprintKey)
+		assert.Equal(t, wantCounts[svc.Str()], dp.IntValue())
+		// the counts start when their issue is created
+		assert.Equal(t, startTimes[svc.Str()], dp.StartTimestamp())
+		assert.Equal(t, "Exception", excType.Str())
+		assert.Equal(t, wantFingerprint, fp.Str())
+	}
+
+	for i, name := range []string{"exceptions.issues.first_seen", "exceptions.issues.last_seen"} {
+		metric := m.At(2 + i)
+		assert.Equal(t, name, metric.Name())
+		require.Equal(t, 2, metric.Gauge().DataPoints().Len())
+		for j := 0; j < 2; j++ {
+			assert.InDelta(t, time.Now().Unix(), metric.Gauge().DataPoints().At(j).IntValue(), 60)
+		}
+	}
+}
+
 func BenchmarkConnectorConsumeTraces(b *testing.B) {
 	msink := &consumertest.MetricsSink{}
 
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exceptionsconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/exceptionsconnector"
+
+import (
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+const (
+	exceptionFingerprintKey = "exception.fingerprint"
+	exceptionFirstSeenKey   = "exception.first_seen"
+	exceptionLastSeenKey    = "exception.last_seen"
+)
+
+var (
+	// javaDotNetFrameRe matches the Java and .NET frames, e.g.
+	// "at com.example.Handler.handle(Handler.java:42)" or
+	// "at Example.Handler.Handle(String id) in C:\src\Handler.cs:line 42".
+	javaDotNetFrameRe = regexp.MustCompile(`^at\s+([^\s(]+)\(`)
+	// jsFrameRe matches the JavaScript frames, e.g.
+	// "at Object.handle (/app/src/handler.js:10:5)" or "at /app/src/handler.js:10:5".
+	jsFrameRe = regexp.MustCompile(`^at\s+(?:async\s+)?(?:(.+?)\s+\((.+?)\)|(\S+?))$`)
+	// pythonFrameRe matches the Python frames, e.g.
+	// `File "/app/handler.py", line 10, in handle`.
+	pythonFrameRe = regexp.MustCompile(`^File\s+"([^"]+)",\s+line\s+\d+,\s+in\s+(\S+)`)
+	// goFrameRe matches the Go function lines of a goroutine stack, e.g.
+	// "github.com/example/app.(*Handler).Handle(0xc000012345, 0x1)".
+	goFrameRe = regexp.MustCompile(`^(\S+)\([^()]*\)$`)
+
+	// locationRe matches the line and column suffixes of a file location.
+	locationRe = regexp.MustCompile(`(:\d+)+$`)
+	// generatedRe matches the generated parts of the frame names, e.g. the
+	// "$1" of the Java anonymous classes or the addresses of the lambdas.
+	generatedRe = regexp.MustCompile(`\$\d+|/0x[0-9a-fA-F]+|\$\$Lambda\$?\d*`)
+
+	// variableRes match the variable parts of the exception messages.
+	variableRes = []*regexp.Regexp{
+		regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`),
+		regexp.MustCompile(`0x[0-9a-fA-F]+`),
+		regexp.MustCompile(`"[^"]*"|'[^']*'`),
+		regexp.MustCompile(`\d+(\.\d+)?`),
+	}
+)
+
+// fingerprint returns a stable identifier for the exceptions with the same type
+// and the same stack frames. The line numbers, file paths and messages of the
+// exceptions are ignored, unless the stack trace has no frames, in which case
+// the message with its variable parts removed is used instead.
+func fingerprint(excType, message, stacktrace string, maxFrames int) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(excType))
+	frames := parseFrames(stacktrace)
+	if maxFrames > 0 && len(frames) > maxFrames {
+		frames = frames[:maxFrames]
+	}
+	if len(frames) == 0 {
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(normalizeMessage(message)))
+	}
+	for _, frame := range frames {
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(frame))
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// parseFrames returns the normalized frames of a Java, .NET, JavaScript,
+// Python or Go stack trace, the innermost frame first.
+func parseFrames(stacktrace string) []string {
+	var frames []string
+	python := false
+	lines := strings.Split(stacktrace, "\n")
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if m := javaDotNetFrameRe.FindStringSubmatch(line); m != nil {
+			frames = append(frames, normalizeFrame(m[1]))
+			continue
+		}
+		if m := jsFrameRe.FindStringSubmatch(line); m != nil {
+			frames = append(frames, jsFrame(m[1], m[2]+m[3]))
+			continue
+		}
+		if m := pythonFrameRe.FindStringSubmatch(line); m != nil {
+			python = true
+			frames = append(frames, path.Base(strings.ReplaceAll(m[1], `\`, "/"))+":"+m[2])
+			continue
+		}
+		// the Go function lines are followed by their indented file location
+		if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "\t") && !strings.HasPrefix(line, "goroutine ") {
+			if m := goFrameRe.FindStringSubmatch(line); m != nil {
+				frames = append(frames, normalizeFrame(m[1]))
+			}
+		}
+	}
+	// the Python tracebacks list the innermost frame last
+	if python {
+		slices.Reverse(frames)
+	}
+	return frames
+}
+
+func jsFrame(function, location string) string {
+	if function != "" {
+		return normalizeFrame(function)
+	}
+	// the anonymous functions are identified by their file
+	return path.Base(locationRe.ReplaceAllString(location, ""))
+}
+
+func normalizeFrame(frame string) string {
+	return generatedRe.ReplaceAllString(frame, "")
+}
+
+func normalizeMessage(message string) string {
+	for _, re := range variableRes {
+		message = re.ReplaceAllString(message, "?")
+	}
+	return message
+}
+
+// issue is the occurrences of the exceptions with the same fingerprint.
+type issue struct {
+	key   string
+	count int
+	attrs pcommon.Map
+	// startTime is the time the issue was created, it is the start time
+	// of its cumulative count, which starts over if the issue is evicted.
+	startTime pcommon.Timestamp
+	firstSeen pcommon.Timestamp
+	lastSeen  pcommon.Timestamp
+}
+
+// issues keeps track of the occurrences of the exceptions by service and
+// fingerprint, up to maxIssues issues. issues is safe for concurrent use.
+type issues struct {
+	lock      sync.Mutex
+	maxIssues int
+	issues    map[string]*list.Element
+	// recent orders the issues from the most to the least recently seen,
+	// the least recently seen issue is evicted to make room for a new one.
+	recent *list.List
+}
+
+func newIssues(maxIssues int) *issues {
+	return &issues{
+		maxIssues: maxIssues,
+		issues:    make(map[string]*list.Element),
+		recent:    list.New(),
+	}
+}
+
+// add records an occurrence of the exception with the fingerprint and returns
+// its first and last seen timestamps.
+func (i *issues) add(serviceName, excType, fp string, ts pcommon.Timestamp) (pcommon.Timestamp, pcommon.Timestamp) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	key := serviceName + metricKeySeparator + fp
+	elem, ok := i.issues[key]
+	if ok {
+		i.recent.MoveToFront(elem)
+	} else {
+		if i.recent.Len() >= i.maxIssues {
+			oldest := i.recent.Back()
+			i.recent.Remove(oldest)
+			delete(i.issues, oldest.Value.(*issue).key)
+		}
+		attrs := pcommon.NewMap()
+		attrs.PutStr(serviceNameKey, serviceName)
+		attrs.PutStr(exceptionTypeKey, excType)
+		attrs.PutStr(exceptionFingerprintKey, fp)
+		elem = i.recent.PushFront(&issue{
+			key:       key,
+			attrs:     attrs,
+			startTime: pcommon.NewTimestampFromTime(time.Now()),
+			firstSeen: ts,
+			lastSeen:  ts,
+		})
+		i.issues[key] = elem
+	}
+	iss := elem.Value.(*issue)
+	iss.count++
+	iss.firstSeen = min(iss.firstSeen, ts)
+	iss.lastSeen = max(iss.lastSeen, ts)
+	return iss.firstSeen, iss.lastSeen
+}
+
+// forEach calls fn for each of the issues.
+func (i *issues) forEach(fn func(iss *issue)) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	for elem := i.recent.Front(); elem != nil; elem = elem.Next() {
+		fn(elem.Value.(*issue))
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package exceptionsconnector
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestParseFrames(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		stacktrace string
+		want       []string
+	}{
+		{
+			name: "java",
+			stacktrace: `java.lang.IllegalStateException: order 42 not found
+	at com.example.OrderService.find(OrderService.java:42)
+	at com.example.OrderController$$Lambda$123/0x0000000800c03000.apply(Unknown Source)
+	at com.example.OrderController$1.run(OrderController.java:17)
+Caused by: java.io.IOException: timeout
+	at com.example.Db.query(Db.java:7)
+	... 3 more`,
+			want: []string{
+				"com.example.OrderService.find",
+				"com.example.OrderController.apply",
+				"com.example.OrderController.run",
+				"com.example.Db.query",
+			},
+		},
+		{
+			name: "dotnet",
+			stacktrace: `System.InvalidOperationException: Order 42 not found
+   at Example.OrderService.Find(Int32 id) in C:\src\OrderService.cs:line 42
+   at Example.OrderController.Get(Int32 id) in C:\src\OrderController.cs:line 17`,
+			want: []string{
+				"Example.OrderService.Find",
+				"Example.OrderController.Get",
+			},
+		},
+		{
+			name: "javascript",
+			stacktrace: `TypeError: Cannot read properties of undefined (reading 'id')
+    at OrderService.find (/app/src/orders.js:42:13)
+    at async Router.handle (/app/node_modules/router/index.js:10:5)
+    at /app/src/server.js:7:3`,
+			want: []string{
+				"OrderService.find",
+				"Router.handle",
+				"server.js",
+			},
+		},
+		{
+			name: "python",
+			stacktrace: `Traceback (most recent call last):
+  File "/app/server.py", line 7, in handle
+    order = find(order_id)
+  File "/app/orders.py", line 42, in find
+    raise KeyError(order_id)
+KeyError: 42`,
+			want: []string{
+				"orders.py:find",
+				"server.py:handle",
+			},
+		},
+		{
+			name: "go",
+			stacktrace: `panic: order 42 not found
+
+goroutine 1 [running]:
+github.com/example/app.(*OrderService).Find(0xc000012345, 0x2a)
+	/app/orders.go:42 +0x1d
+main.main()
+	/app/main.go:7 +0x25
+exit status 2`,
+			want: []string{
+				"github.com/example/app.(*OrderService).Find",
+				"main.main",
+			},
+		},
+		{
+			name:       "no frames",
+			stacktrace: "Exception stacktrace",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseFrames(tc.stacktrace))
+		})
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	stacktrace := `java.lang.IllegalStateException: order 42 not found
+	at com.example.OrderService.find(OrderService.java:42)
+	at com.example.OrderController.get(OrderController.java:17)`
+
+	fp := fingerprint("java.lang.IllegalStateException", "order 42 not found", stacktrace, 10)
+	assert.Len(t, fp, 16)
+
+	// the messages and the line numbers are ignored
+	otherLines := strings.NewReplacer("42", "43", "17", "18").Replace(stacktrace)
+	assert.Equal(t, fp, fingerprint("java.lang.IllegalStateException", "order 43 not found", otherLines, 10))
+
+	// the type and the frames are part of the fingerprint
+	assert.NotEqual(t, fp, fingerprint("java.lang.RuntimeException", "order 42 not found", stacktrace, 10))
+	otherFrames := strings.ReplaceAll(stacktrace, "OrderController.get", "OrderController.list")
+	assert.NotEqual(t, fp, fingerprint("java.lang.IllegalStateException", "order 42 not found", otherFrames, 10))
+	// unless they are beyond the max frames
+	assert.Equal(t,
+		fingerprint("java.lang.IllegalStateException", "", stacktrace, 1),
+		fingerprint("java.lang.IllegalStateException", "", otherFrames, 1),
+	)
+
+	// the variable parts of the messages are ignored without frames
+	assert.Equal(t,
+		fingerprint("KeyError", `user "alice" 42 not found in 0x1f`, "", 10),
+		fingerprint("KeyError", `user "bob" 7 not found in 0x2e`, "", 10),
+	)
+	assert.NotEqual(t,
+		fingerprint("KeyError", "user not found", "", 10),
+		fingerprint("KeyError", "order not found", "", 10),
+	)
+}
+
+func TestIssues(t *testing.T) {
+	iss := newIssues(10)
+
+	firstSeen, lastSeen := iss.add("svc", "Exception", "fp", pcommon.Timestamp(20))
+	assert.Equal(t, pcommon.Timestamp(20), firstSeen)
+	assert.Equal(t, pcommon.Timestamp(20), lastSeen)
+
+	firstSeen, lastSeen = iss.add("svc", "Exception", "fp", pcommon.Timestamp(10))
+	assert.Equal(t, pcommon.Timestamp(10), firstSeen)
+	assert.Equal(t, pcommon.Timestamp(20), lastSeen)
+
+	firstSeen, lastSeen = iss.add("svc", "Exception", "fp", pcommon.Timestamp(30))
+	assert.Equal(t, pcommon.Timestamp(10), firstSeen)
+	assert.Equal(t, pcommon.Timestamp(30), lastSeen)
+
+	iss.add("other", "Exception", "fp", pcommon.Timestamp(30))
+
+	counts := make(map[string]int)
+	iss.forEach(func(iss *issue) {
+		svc, _ := iss.attrs.Get(serviceNameKey)
+		fp, _ := iss.attrs.Get(exceptionFingerprintKey)
+		assert.Equal(t, "fp", fp.Str())
+		counts[svc.Str()] = iss.count
+	})
+	assert.Equal(t, map[string]int{"svc": 3, "other": 1}, counts)
+}
+
+func TestIssuesMaxIssues(t *testing.T) {
+	iss := newIssues(2)
+	iss.add("svc", "Exception", "fp1", pcommon.Timestamp(10))
+	iss.add("svc", "Exception", "fp2", pcommon.Timestamp(20))
+	// fp1 is seen again, so fp2 is the least recently seen issue
+	iss.add("svc", "Exception", "fp1", pcommon.Timestamp(30))
+	iss.add("svc", "Exception", "fp3", pcommon.Timestamp(40))
+
+	counts := make(map[string]int)
+	iss.forEach(func(iss *issue) {
+		fp, _ := iss.attrs.Get(exceptionFingerprintKey)
+		counts[fp.Str()] = iss.count
+	})
+	assert.Equal(t, map[string]int{"fp1": 2, "fp3": 1}, counts)
+
+	// the evicted issue starts over, with a new start time
+	evicted := pcommon.NewTimestampFromTime(time.Now())
+	firstSeen, _ := iss.add("svc", "Exception", "fp2", pcommon.Timestamp(50))
+	assert.Equal(t, pcommon.Timestamp(50), firstSeen)
+	iss.forEach(func(iss *issue) {
+		fp, _ := iss.attrs.Get(exceptionFingerprintKey)
+		if fp.Str() == "fp2" {
+			assert.Equal(t, 1, iss.count)
+			assert.GreaterOrEqual(t, iss.startTime, evicted)
+		} else {
+			assert.LessOrEqual(t, iss.startTime, evicted)
+		}
+	})
+}
@@ -13,6 +13,10 @@ import (
 var (
 	errNoPipelinePriority    = errors.New("No pipelines are defined in the priority list")
 	errInvalidRetryIntervals = errors.New("Retry interval must be positive")
+	errInvalidErrorRate      = errors.New("Error rate threshold must be between 0 and 1")
+	errInvalidHealthCheck    = errors.New("Health check window and min requests must be positive when an error rate threshold is set")
+	errInvalidRecoverySteps  = errors.New("Recovery steps must be increasing ratios between 0 and 1")
+	errInvalidRecoveryStep   = errors.New("Recovery step interval must be positive when recovery steps are set")
 )
 
 type Config struct {
@@ -33,6 +37,24 @@ type Config struct {
 	// MaxRetry is the maximum retries per level, once this limit is hit for a level, even if the next pipeline level fails,
 	// it will not try to recover the level that exceeded the maximum retries
 	MaxRetries int `mapstructure:"max_retries"` // **Deprecated**
+
+	// ErrorRateThreshold is the ratio of failed consume calls of a level over the HealthCheckWindow above which
+	// the level is considered unhealthy. If it is 0, the level is considered unhealthy on any failed consume call
+	ErrorRateThreshold float64 `mapstructure:"error_rate_threshold"`
+
+	// HealthCheckWindow is the sliding window over which the error rate of a level is computed
+	HealthCheckWindow time.Duration `mapstructure:"health_check_window"`
+
+	// MinRequests is the minimum number of consume calls of a level in the HealthCheckWindow before the level
+	// can be considered unhealthy by its error rate
+	MinRequests int `mapstructure:"min_requests"`
+
+	// RecoverySteps is the list of increasing ratios of the traffic shifted back to a level that was successfully
+	// retried, e.g. [0.1, 0.5, 1]. If it is empty, all the traffic is shifted back at once
+	RecoverySteps []float64 `mapstructure:"recovery_steps"`
+
+	// RecoveryStepInterval is how long each of the RecoverySteps lasts before moving to the next step
+	RecoveryStepInterval time.Duration `mapstructure:"recovery_step_interval"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -45,5 +67,21 @@ func (c *Config) Validate() error {
 	if c.RetryInterval <= 0 {
 		return errInvalidRetryIntervals
 	}
+	if c.ErrorRateThreshold < 0 || c.ErrorRateThreshold >= 1 {
+		return errInvalidErrorRate
+	}
+	if c.ErrorRateThreshold > 0 && (c.HealthCheckWindow <= 0 || c.MinRequests <= 0) {
+		return errInvalidHealthCheck
+	}
+	prev := 0.0
+	for _, step := range c.RecoverySteps {
+		if step <= prev || step > 1 {
+			return errInvalidRecoverySteps
+		}
+		prev = step
+	}
+	if len(c.RecoverySteps) > 0 && c.RecoveryStepInterval <= 0 {
+		return errInvalidRecoveryStep
+	}
 	return nil
 }
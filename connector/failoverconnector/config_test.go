@@ -31,7 +31,10 @@ func TestLoadConfig(t *testing.T) {
 						pipeline.NewIDWithName(pipeline.SignalTraces, ""),
 					},
 				},
-				RetryInterval: 10 * time.Minute,
+				RetryInterval:        10 * time.Minute,
+				HealthCheckWindow:    time.Minute,
+				MinRequests:          10,
+				RecoveryStepInterval: time.Minute,
 			},
 		},
 		{
@@ -52,7 +55,12 @@ func TestLoadConfig(t *testing.T) {
 						pipeline.NewIDWithName(pipeline.SignalTraces, "fourth"),
 					},
 				},
-				RetryInterval: 5 * time.Minute,
+				RetryInterval:        5 * time.Minute,
+				ErrorRateThreshold:   0.5,
+				HealthCheckWindow:    30 * time.Second,
+				MinRequests:          20,
+				RecoverySteps:        []float64{0.1, 0.5, 1},
+				RecoveryStepInterval: 2 * time.Minute,
 			},
 		},
 	}
@@ -91,6 +99,26 @@ func TestValidateConfig(t *testing.T) {
 			id:   component.NewIDWithName(metadata.Type, "invalid"),
 			err:  errInvalidRetryIntervals,
 		},
+		{
+			name: "invalid error_rate_threshold",
+			id:   component.NewIDWithName(metadata.Type, "invalid_error_rate"),
+			err:  errInvalidErrorRate,
+		},
+		{
+			name: "invalid health check",
+			id:   component.NewIDWithName(metadata.Type, "invalid_health_check"),
+			err:  errInvalidHealthCheck,
+		},
+		{
+			name: "invalid recovery_steps",
+			id:   component.NewIDWithName(metadata.Type, "invalid_recovery_steps"),
+			err:  errInvalidRecoverySteps,
+		},
+		{
+			name: "invalid recovery_step_interval",
+			id:   component.NewIDWithName(metadata.Type, "invalid_recovery_step_interval"),
+			err:  errInvalidRecoveryStep,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -29,6 +29,10 @@ func createDefaultConfig() component.Config {
 		RetryInterval: 10 * time.Minute,
 		RetryGap:      0,
 		MaxRetries:    0,
+
+		HealthCheckWindow:    time.Minute,
+		MinRequests:          10,
+		RecoveryStepInterval: time.Minute,
 	}
 }
 
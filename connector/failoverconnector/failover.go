@@ -49,6 +49,49 @@ func (f *baseFailoverRouter[C]) reportConsumerError(idx int) {
 	f.errTryLock.TryExecute(f.pS.HandleError, idx)
 }
 
+// consumeByHealthyPipeline consumes the data by the recovering level if the data is routed to it, and
+// otherwise by the current healthy level. The data falls back to the lower priority levels on errors, and
+// the levels that become unhealthy are failed over
+func (f *baseFailoverRouter[C]) consumeByHealthyPipeline(consume func(C) error) error {
+	if idx, ok := f.pS.SelectRecoveringPipeline(); ok {
+		err := consume(f.getConsumerAtIndex(idx))
+		if f.pS.RecordResult(idx, err) {
+			f.pS.AbortRecovery(idx)
+		}
+		if err == nil {
+			return nil
+		}
+	}
+
+	_, idx := f.getCurrentConsumer()
+	if idx > 0 {
+		// the retry may not have been enabled on failover if the previous retry was still shutting down
+		f.pS.TryEnableRetry()
+	}
+	for ; idx < len(f.cfg.PipelinePriority); idx++ {
+		err := consume(f.getConsumerAtIndex(idx))
+		if f.pS.RecordResult(idx, err) {
+			f.reportConsumerError(idx)
+		}
+		if err == nil {
+			return nil
+		}
+	}
+	return errNoValidPipeline
+}
+
+// sampleRetryConsumers iterates through all unhealthy consumers to re-establish a healthy connection
+func (f *baseFailoverRouter[C]) sampleRetryConsumers(consume func(C) error) bool {
+	stableIndex := f.pS.CurrentPipeline()
+	for i := 0; i < stableIndex; i++ {
+		if err := consume(f.getConsumerAtIndex(i)); err == nil {
+			f.pS.RecoverPipeline(i)
+			return true
+		}
+	}
+	return false
+}
+
 func (f *baseFailoverRouter[C]) Shutdown() {
 	close(f.done)
 }
@@ -60,6 +103,12 @@ func newBaseFailoverRouter[C any](provider consumerProvider[C], cfg *Config) (*b
 		RetryInterval: cfg.RetryInterval,
 		RetryGap:      cfg.RetryGap,
 		MaxRetries:    cfg.MaxRetries,
+
+		ErrorRateThreshold:   cfg.ErrorRateThreshold,
+		HealthCheckWindow:    cfg.HealthCheckWindow,
+		MinRequests:          cfg.MinRequests,
+		RecoverySteps:        cfg.RecoverySteps,
+		RecoveryStepInterval: cfg.RecoveryStepInterval,
 	}
 
 	consumers := make([]C, 0)
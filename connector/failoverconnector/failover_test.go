@@ -137,3 +137,105 @@ func resetConsumers(conn *tracesFailover, consumers ...consumer.Traces) {
 	}
 	conn.failover.TestSetStableConsumerIndex(0)
 }
+
+func TestFailoverErrorRateThreshold(t *testing.T) {
+	var sinkFirst, sinkSecond consumertest.TracesSink
+	tracesFirst := pipeline.NewIDWithName(pipeline.SignalTraces, "traces/first")
+	tracesSecond := pipeline.NewIDWithName(pipeline.SignalTraces, "traces/second")
+
+	cfg := &Config{
+		PipelinePriority:   [][]pipeline.ID{{tracesFirst}, {tracesSecond}},
+		RetryInterval:      time.Minute,
+		ErrorRateThreshold: 0.5,
+		HealthCheckWindow:  time.Minute,
+		MinRequests:        4,
+	}
+
+	router := connector.NewTracesRouter(map[pipeline.ID]consumer.Traces{
+		tracesFirst:  &sinkFirst,
+		tracesSecond: &sinkSecond,
+	})
+
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, router.(consumer.Traces))
+	require.NoError(t, err)
+
+	failoverConnector := conn.(*tracesFailover)
+	defer func() {
+		assert.NoError(t, failoverConnector.Shutdown(context.Background()))
+	}()
+
+	tr := sampleTrace()
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Len(t, sinkFirst.AllTraces(), 2)
+
+	failoverConnector.failover.ModifyConsumerAtIndex(0, consumertest.NewErr(errTracesConsumer))
+
+	// the failed data falls back to the next level while the error rate is under the threshold
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Len(t, sinkSecond.AllTraces(), 2)
+	require.Equal(t, 0, failoverConnector.failover.TestGetCurrentConsumerIndex())
+
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Len(t, sinkSecond.AllTraces(), 3)
+	require.Equal(t, 1, failoverConnector.failover.TestGetCurrentConsumerIndex())
+}
+
+func TestFailoverGradualRecovery(t *testing.T) {
+	var sinkFirst, sinkSecond consumertest.TracesSink
+	tracesFirst := pipeline.NewIDWithName(pipeline.SignalTraces, "traces/first")
+	tracesSecond := pipeline.NewIDWithName(pipeline.SignalTraces, "traces/second")
+
+	cfg := &Config{
+		PipelinePriority:     [][]pipeline.ID{{tracesFirst}, {tracesSecond}},
+		RetryInterval:        50 * time.Millisecond,
+		RecoverySteps:        []float64{0.5, 1},
+		RecoveryStepInterval: time.Hour,
+	}
+
+	router := connector.NewTracesRouter(map[pipeline.ID]consumer.Traces{
+		tracesFirst:  &sinkFirst,
+		tracesSecond: &sinkSecond,
+	})
+
+	conn, err := NewFactory().CreateTracesToTraces(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, router.(consumer.Traces))
+	require.NoError(t, err)
+
+	failoverConnector := conn.(*tracesFailover)
+	defer func() {
+		assert.NoError(t, failoverConnector.Shutdown(context.Background()))
+	}()
+
+	tr := sampleTrace()
+
+	failoverConnector.failover.ModifyConsumerAtIndex(0, consumertest.NewErr(errTracesConsumer))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Equal(t, 1, failoverConnector.failover.TestGetCurrentConsumerIndex())
+
+	failoverConnector.failover.ModifyConsumerAtIndex(0, &sinkFirst)
+	require.Eventually(t, func() bool {
+		require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+		return failoverConnector.failover.pS.Recovering()
+	}, 3*time.Second, 5*time.Millisecond)
+
+	// the level is not healthy/active until it went through all the recovery steps
+	require.Equal(t, 1, failoverConnector.failover.TestGetCurrentConsumerIndex())
+
+	sinkFirst.Reset()
+	sinkSecond.Reset()
+	for i := 0; i < 10; i++ {
+		require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	}
+	// the retries may route some more data to the recovering level
+	assert.InDelta(t, 5, len(sinkSecond.AllTraces()), 1)
+	assert.Len(t, sinkFirst.AllTraces(), 10-len(sinkSecond.AllTraces()))
+
+	failoverConnector.failover.pS.TestSetRecoveryStep(len(cfg.RecoverySteps))
+	require.NoError(t, conn.ConsumeTraces(context.Background(), tr))
+	require.Equal(t, 0, failoverConnector.failover.TestGetCurrentConsumerIndex())
+	require.False(t, failoverConnector.failover.pS.Recovering())
+}
@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package state // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/failoverconnector/internal/state"
+
+import (
+	"sync"
+	"time"
+)
+
+// errorRateBuckets is the number of buckets the window of an ErrorRate is split into, the outcomes
+// expire from the window one bucket at a time
+const errorRateBuckets = 10
+
+type errorRateBucket struct {
+	start     time.Time
+	successes int
+	failures  int
+}
+
+// ErrorRate tracks the outcomes of the consume calls of a pipeline level over a sliding window
+type ErrorRate struct {
+	window      time.Duration
+	bucketWidth time.Duration
+
+	lock    sync.Mutex
+	buckets [errorRateBuckets]errorRateBucket
+}
+
+func NewErrorRate(window time.Duration) *ErrorRate {
+	bucketWidth := window / errorRateBuckets
+	if bucketWidth <= 0 {
+		bucketWidth = 1
+	}
+	return &ErrorRate{
+		window:      window,
+		bucketWidth: bucketWidth,
+	}
+}
+
+// Record adds the outcome of a consume call to the window
+func (e *ErrorRate) Record(now time.Time, success bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	start := now.Truncate(e.bucketWidth)
+	b := &e.buckets[(start.UnixNano()/int64(e.bucketWidth))%errorRateBuckets]
+	if !b.start.Equal(start) {
+		*b = errorRateBucket{start: start}
+	}
+	if success {
+		b.successes++
+	} else {
+		b.failures++
+	}
+}
+
+// Rate returns the ratio of failed consume calls and the total number of consume calls in the window
+func (e *ErrorRate) Rate(now time.Time) (float64, int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var successes, failures int
+	for _, b := range e.buckets {
+		if b.start.IsZero() || now.Sub(b.start) >= e.window {
+			continue
+		}
+		successes += b.successes
+		failures += b.failures
+	}
+	total := successes + failures
+	if total == 0 {
+		return 0, 0
+	}
+	return float64(failures) / float64(total), total
+}
+
+// Reset drops all the outcomes in the window
+func (e *ErrorRate) Reset() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.buckets = [errorRateBuckets]errorRateBucket{}
+}
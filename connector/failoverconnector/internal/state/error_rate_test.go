@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRate(t *testing.T) {
+	errorRate := NewErrorRate(10 * time.Second)
+	now := time.Unix(1000, 0)
+
+	rate, total := errorRate.Rate(now)
+	require.Equal(t, 0.0, rate)
+	require.Equal(t, 0, total)
+
+	errorRate.Record(now, true)
+	errorRate.Record(now, false)
+	errorRate.Record(now.Add(5*time.Second), false)
+	errorRate.Record(now.Add(5*time.Second), false)
+
+	rate, total = errorRate.Rate(now.Add(5 * time.Second))
+	require.Equal(t, 0.75, rate)
+	require.Equal(t, 4, total)
+
+	// the first outcomes expire from the window
+	rate, total = errorRate.Rate(now.Add(12 * time.Second))
+	require.Equal(t, 1.0, rate)
+	require.Equal(t, 2, total)
+
+	// the buckets are reused once they expired
+	errorRate.Record(now.Add(20*time.Second), true)
+	rate, total = errorRate.Rate(now.Add(20 * time.Second))
+	require.Equal(t, 0.0, rate)
+	require.Equal(t, 1, total)
+
+	errorRate.Reset()
+	_, total = errorRate.Rate(now.Add(20 * time.Second))
+	require.Equal(t, 0, total)
+}
@@ -18,6 +18,19 @@ type PipelineSelector struct {
 
 	retryCancel CancelManager
 	done        chan struct{}
+
+	errorRates map[int]*ErrorRate
+	recovery   recoveryState
+}
+
+// recoveryState is the state of the traffic shifting to a higher priority level that was successfully retried
+type recoveryState struct {
+	// pipeline is the recovering level, it is -1 if no level is recovering
+	pipeline  int
+	step      int
+	stepStart time.Time
+	// requests is the number of requests routed during the current step
+	requests int
 }
 
 // HandleError is called when an error is returned on a healthy pipeline
@@ -88,10 +101,117 @@ func (p *PipelineSelector) CurrentPipeline() int {
 func (p *PipelineSelector) ResetHealthyPipeline(pipelineIndex int) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
+	p.resetHealthyPipeline(pipelineIndex)
+}
+
+func (p *PipelineSelector) resetHealthyPipeline(pipelineIndex int) {
 	if pipelineIndex == 0 {
 		p.retryCancel.Cancel()
 	}
 	p.currentPipeline = pipelineIndex
+	p.recovery = recoveryState{pipeline: -1}
+}
+
+// RecordResult records the outcome of a consume call on a pipeline level and returns whether the level is
+// unhealthy. Without an error rate threshold, a level is unhealthy on any error
+func (p *PipelineSelector) RecordResult(idx int, err error) bool {
+	if p.constants.ErrorRateThreshold <= 0 {
+		return err != nil
+	}
+
+	now := time.Now()
+	errorRate := p.errorRate(idx)
+	errorRate.Record(now, err == nil)
+	if err == nil {
+		return false
+	}
+	rate, total := errorRate.Rate(now)
+	return total >= p.constants.MinRequests && rate > p.constants.ErrorRateThreshold
+}
+
+func (p *PipelineSelector) errorRate(idx int) *ErrorRate {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	errorRate, ok := p.errorRates[idx]
+	if !ok {
+		errorRate = NewErrorRate(p.constants.HealthCheckWindow)
+		p.errorRates[idx] = errorRate
+	}
+	return errorRate
+}
+
+// RecoverPipeline is called when a higher priority level was successfully retried. Without recovery steps
+// the level becomes healthy/active, otherwise the traffic is gradually shifted to the level
+func (p *PipelineSelector) RecoverPipeline(pipelineIndex int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.constants.RecoverySteps) == 0 {
+		if errorRate, ok := p.errorRates[pipelineIndex]; ok {
+			errorRate.Reset()
+		}
+		p.resetHealthyPipeline(pipelineIndex)
+		return
+	}
+
+	if pipelineIndex >= p.currentPipeline {
+		return
+	}
+	if p.recovery.pipeline >= 0 && p.recovery.pipeline <= pipelineIndex {
+		return
+	}
+	if errorRate, ok := p.errorRates[pipelineIndex]; ok {
+		errorRate.Reset()
+	}
+	p.recovery = recoveryState{
+		pipeline:  pipelineIndex,
+		stepStart: time.Now(),
+	}
+}
+
+// Recovering returns whether a level is recovering
+func (p *PipelineSelector) Recovering() bool {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.recovery.pipeline >= 0
+}
+
+// SelectRecoveringPipeline returns the recovering level if the next request is to be routed to it. The
+// recovering level becomes healthy/active after it went through all the recovery steps
+func (p *PipelineSelector) SelectRecoveringPipeline() (int, bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.recovery.pipeline < 0 {
+		return 0, false
+	}
+
+	now := time.Now()
+	if now.Sub(p.recovery.stepStart) >= p.constants.RecoveryStepInterval {
+		p.recovery.step++
+		p.recovery.stepStart = now
+		p.recovery.requests = 0
+		if p.recovery.step >= len(p.constants.RecoverySteps) {
+			p.resetHealthyPipeline(p.recovery.pipeline)
+			return 0, false
+		}
+	}
+
+	// the requests of the step are routed to the recovering level in the ratio of the step
+	ratio := p.constants.RecoverySteps[p.recovery.step]
+	p.recovery.requests++
+	if int(float64(p.recovery.requests)*ratio) == int(float64(p.recovery.requests-1)*ratio) {
+		return 0, false
+	}
+	return p.recovery.pipeline, true
+}
+
+// AbortRecovery stops shifting the traffic to a recovering level that became unhealthy again, the level
+// will be retried on the next retry interval
+func (p *PipelineSelector) AbortRecovery(pipelineIndex int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.recovery.pipeline == pipelineIndex {
+		p.recovery = recoveryState{pipeline: -1}
+	}
 }
 
 func NewPipelineSelector(retryChan chan<- struct{}, done chan struct{}, consts PSConstants) *PipelineSelector {
@@ -104,6 +224,8 @@ func NewPipelineSelector(retryChan chan<- struct{}, done chan struct{}, consts P
 		retryEnabledToken: retryEnabledToken,
 		retryChan:         retryChan,
 		done:              done,
+		errorRates:        make(map[int]*ErrorRate),
+		recovery:          recoveryState{pipeline: -1},
 	}
 	return ps
 }
@@ -114,3 +236,10 @@ func (p *PipelineSelector) TestSetCurrentPipeline(idx int) {
 	defer p.lock.Unlock()
 	p.currentPipeline = idx
 }
+
+func (p *PipelineSelector) TestSetRecoveryStep(step int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.recovery.step = step - 1
+	p.recovery.stepStart = time.Time{}
+}
@@ -4,6 +4,7 @@
 package state
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -73,3 +74,127 @@ func TestCurrentPipelineWithReset(t *testing.T) {
 		return idx == 0
 	}, 3*time.Second, 5*time.Millisecond)
 }
+
+func TestRecordResultWithErrorRateThreshold(t *testing.T) {
+	done := make(chan struct{})
+	retryChan := make(chan struct{}, 1)
+	constants := PSConstants{
+		RetryInterval:      50 * time.Millisecond,
+		ErrorRateThreshold: 0.5,
+		HealthCheckWindow:  time.Minute,
+		MinRequests:        4,
+	}
+	pS := NewPipelineSelector(retryChan, done, constants)
+
+	require.False(t, pS.RecordResult(0, nil))
+	require.False(t, pS.RecordResult(0, nil))
+	// below min requests
+	require.False(t, pS.RecordResult(0, errors.New("error")))
+	// error rate of 0.5 doesn't exceed the threshold
+	require.False(t, pS.RecordResult(0, errors.New("error")))
+	require.True(t, pS.RecordResult(0, errors.New("error")))
+
+	// the error rates are tracked by level
+	require.False(t, pS.RecordResult(1, errors.New("error")))
+}
+
+func TestRecordResultWithoutErrorRateThreshold(t *testing.T) {
+	done := make(chan struct{})
+	retryChan := make(chan struct{}, 1)
+	constants := PSConstants{
+		RetryInterval: 50 * time.Millisecond,
+	}
+	pS := NewPipelineSelector(retryChan, done, constants)
+
+	require.False(t, pS.RecordResult(0, nil))
+	require.True(t, pS.RecordResult(0, errors.New("error")))
+}
+
+func TestRecoverPipelineWithoutRecoverySteps(t *testing.T) {
+	done := make(chan struct{})
+	retryChan := make(chan struct{}, 1)
+	constants := PSConstants{
+		RetryInterval:      50 * time.Millisecond,
+		ErrorRateThreshold: 0.5,
+		HealthCheckWindow:  time.Minute,
+		MinRequests:        2,
+	}
+	pS := NewPipelineSelector(retryChan, done, constants)
+
+	require.False(t, pS.RecordResult(0, errors.New("error")))
+	require.True(t, pS.RecordResult(0, errors.New("error")))
+	pS.TestSetCurrentPipeline(1)
+
+	pS.RecoverPipeline(0)
+	require.False(t, pS.Recovering())
+	require.Equal(t, 0, pS.CurrentPipeline())
+
+	// the errors recorded before the level failed over are forgotten
+	require.False(t, pS.RecordResult(0, errors.New("error")))
+}
+
+func TestRecoverPipelineWithRecoverySteps(t *testing.T) {
+	done := make(chan struct{})
+	retryChan := make(chan struct{}, 1)
+	constants := PSConstants{
+		RetryInterval:        50 * time.Millisecond,
+		RecoverySteps:        []float64{0.1, 0.5, 1},
+		RecoveryStepInterval: time.Hour,
+	}
+	pS := NewPipelineSelector(retryChan, done, constants)
+	pS.TestSetCurrentPipeline(1)
+
+	pS.RecoverPipeline(0)
+	require.True(t, pS.Recovering())
+	require.Equal(t, 1, pS.CurrentPipeline())
+
+	// 10% of the requests are routed to the recovering level
+	routed := 0
+	for i := 0; i < 100; i++ {
+		if idx, ok := pS.SelectRecoveringPipeline(); ok {
+			require.Equal(t, 0, idx)
+			routed++
+		}
+	}
+	require.Equal(t, 10, routed)
+
+	pS.TestSetRecoveryStep(1)
+	routed = 0
+	for i := 0; i < 100; i++ {
+		if _, ok := pS.SelectRecoveringPipeline(); ok {
+			routed++
+		}
+	}
+	require.Equal(t, 50, routed)
+
+	pS.TestSetRecoveryStep(len(constants.RecoverySteps))
+	_, ok := pS.SelectRecoveringPipeline()
+	require.False(t, ok)
+	require.False(t, pS.Recovering())
+	require.Equal(t, 0, pS.CurrentPipeline())
+}
+
+func TestAbortRecovery(t *testing.T) {
+	done := make(chan struct{})
+	retryChan := make(chan struct{}, 1)
+	constants := PSConstants{
+		RetryInterval:        50 * time.Millisecond,
+		RecoverySteps:        []float64{0.5, 1},
+		RecoveryStepInterval: time.Hour,
+	}
+	pS := NewPipelineSelector(retryChan, done, constants)
+	pS.TestSetCurrentPipeline(2)
+
+	pS.RecoverPipeline(1)
+	// a lower priority level than the recovering level is ignored
+	pS.RecoverPipeline(1)
+	require.True(t, pS.Recovering())
+
+	pS.AbortRecovery(1)
+	require.False(t, pS.Recovering())
+	require.Equal(t, 2, pS.CurrentPipeline())
+	for i := 0; i < 10; i++ {
+		_, ok := pS.SelectRecoveringPipeline()
+		require.False(t, ok)
+	}
+}
@@ -13,6 +13,18 @@ type PSConstants struct {
 	RetryInterval time.Duration
 	RetryGap      time.Duration
 	MaxRetries    int
+
+	// ErrorRateThreshold is the ratio of failed consume calls over the HealthCheckWindow above which a level
+	// is unhealthy, a level is unhealthy on any error if it is 0
+	ErrorRateThreshold float64
+	HealthCheckWindow  time.Duration
+	// MinRequests is the number of consume calls in the HealthCheckWindow required to evaluate the error rate
+	MinRequests int
+
+	// RecoverySteps are the ratios of the traffic routed to a recovering level, a recovered level receives
+	// all the traffic at once if there are no steps
+	RecoverySteps        []float64
+	RecoveryStepInterval time.Duration
 }
 
 type TryLock struct {
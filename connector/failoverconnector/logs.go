@@ -40,33 +40,16 @@ func (f *logsRouter) Consume(ctx context.Context, ld plog.Logs) error {
 
 // consumeByHealthyPipeline will consume the logs by the current healthy level
 func (f *logsRouter) consumeByHealthyPipeline(ctx context.Context, ld plog.Logs) error {
-	for {
-		tc, idx := f.getCurrentConsumer()
-		if idx >= len(f.cfg.PipelinePriority) {
-			return errNoValidPipeline
-		}
-
-		if err := tc.ConsumeLogs(ctx, ld); err != nil {
-			f.reportConsumerError(idx)
-			continue
-		}
-
-		return nil
-	}
+	return f.baseFailoverRouter.consumeByHealthyPipeline(func(c consumer.Logs) error {
+		return c.ConsumeLogs(ctx, ld)
+	})
 }
 
 // sampleRetryConsumers iterates through all unhealthy consumers to re-establish a healthy connection
 func (f *logsRouter) sampleRetryConsumers(ctx context.Context, ld plog.Logs) bool {
-	stableIndex := f.pS.CurrentPipeline()
-	for i := 0; i < stableIndex; i++ {
-		consumer := f.getConsumerAtIndex(i)
-		err := consumer.ConsumeLogs(ctx, ld)
-		if err == nil {
-			f.pS.ResetHealthyPipeline(i)
-			return true
-		}
-	}
-	return false
+	return f.baseFailoverRouter.sampleRetryConsumers(func(c consumer.Logs) error {
+		return c.ConsumeLogs(ctx, ld)
+	})
 }
 
 type logsFailover struct {
@@ -40,33 +40,16 @@ func (f *metricsRouter) Consume(ctx context.Context, md pmetric.Metrics) error {
 
 // consumeByHealthyPipeline will consume the metrics by the current healthy level
 func (f *metricsRouter) consumeByHealthyPipeline(ctx context.Context, md pmetric.Metrics) error {
-	for {
-		tc, idx := f.getCurrentConsumer()
-		if idx >= len(f.cfg.PipelinePriority) {
-			return errNoValidPipeline
-		}
-
-		if err := tc.ConsumeMetrics(ctx, md); err != nil {
-			f.reportConsumerError(idx)
-			continue
-		}
-
-		return nil
-	}
+	return f.baseFailoverRouter.consumeByHealthyPipeline(func(c consumer.Metrics) error {
+		return c.ConsumeMetrics(ctx, md)
+	})
 }
 
 // sampleRetryConsumers iterates through all unhealthy consumers to re-establish a healthy connection
 func (f *metricsRouter) sampleRetryConsumers(ctx context.Context, md pmetric.Metrics) bool {
-	stableIndex := f.pS.CurrentPipeline()
-	for i := 0; i < stableIndex; i++ {
-		consumer := f.getConsumerAtIndex(i)
-		err := consumer.ConsumeMetrics(ctx, md)
-		if err == nil {
-			f.pS.ResetHealthyPipeline(i)
-			return true
-		}
-	}
-	return false
+	return f.baseFailoverRouter.sampleRetryConsumers(func(c consumer.Metrics) error {
+		return c.ConsumeMetrics(ctx, md)
+	})
 }
 
 type metricsFailover struct {
@@ -41,33 +41,16 @@ func (f *tracesRouter) Consume(ctx context.Context, td ptrace.Traces) error {
 
 // consumeByHealthyPipeline will consume the traces by the current healthy level
 func (f *tracesRouter) consumeByHealthyPipeline(ctx context.Context, td ptrace.Traces) error {
-	for {
-		tc, idx := f.getCurrentConsumer()
-		if idx >= len(f.cfg.PipelinePriority) {
-			return errNoValidPipeline
-		}
-
-		if err := tc.ConsumeTraces(ctx, td); err != nil {
-			f.reportConsumerError(idx)
-			continue
-		}
-
-		return nil
-	}
+	return f.baseFailoverRouter.consumeByHealthyPipeline(func(c consumer.Traces) error {
+		return c.ConsumeTraces(ctx, td)
+	})
 }
 
 // sampleRetryConsumers iterates through all unhealthy consumers to re-establish a healthy connection
 func (f *tracesRouter) sampleRetryConsumers(ctx context.Context, td ptrace.Traces) bool {
-	stableIndex := f.pS.CurrentPipeline()
-	for i := 0; i < stableIndex; i++ {
-		consumer := f.getConsumerAtIndex(i)
-		err := consumer.ConsumeTraces(ctx, td)
-		if err == nil {
-			f.pS.ResetHealthyPipeline(i)
-			return true
-		}
-	}
-	return false
+	return f.baseFailoverRouter.sampleRetryConsumers(func(c consumer.Traces) error {
+		return c.ConsumeTraces(ctx, td)
+	})
 }
 
 type tracesFailover struct {
@@ -18,6 +18,16 @@ var (
 	errNoPipelines            = errors.New("invalid route: no pipelines defined")
 	errUnexpectedConsumer     = errors.New("expected consumer to be a connector router")
 	errNoTableItems           = errors.New("invalid routing table: the routing table is empty")
+	errPipelinesAndWeighted   = errors.New("invalid route: both pipelines and weighted_pipelines provided")
+	errInvalidWeight          = errors.New("invalid route: weighted_pipelines require pipelines and a positive weight")
+	errInvalidMirror          = errors.New("invalid route: mirror requires pipelines and a sampling_percentage between 0 and 100")
+)
+
+const (
+	// hashKeyResource splits the data by the hash of the resource attributes.
+	hashKeyResource = "resource"
+	// hashKeyTraceID splits the data by the trace ID of the spans or log records.
+	hashKeyTraceID = "trace_id"
 )
 
 // Config defines configuration for the Routing processor.
@@ -59,9 +69,32 @@ func (c *Config) Validate() error {
 		if item.Statement != "" && item.Condition != "" {
 			return errConditionAndStatement
 		}
-		if len(item.Pipelines) == 0 {
+		if len(item.Pipelines) == 0 && len(item.WeightedPipelines) == 0 {
 			return errNoPipelines
 		}
+		if len(item.Pipelines) != 0 && len(item.WeightedPipelines) != 0 {
+			return errPipelinesAndWeighted
+		}
+		for _, weighted := range item.WeightedPipelines {
+			if len(weighted.Pipelines) == 0 || weighted.Weight <= 0 {
+				return errInvalidWeight
+			}
+		}
+		if item.Mirror != nil {
+			if len(item.Mirror.Pipelines) == 0 || item.Mirror.SamplingPercentage <= 0 || item.Mirror.SamplingPercentage > 100 {
+				return errInvalidMirror
+			}
+		}
+
+		switch item.HashKey {
+		case "", hashKeyResource: // ok
+		case hashKeyTraceID:
+			if item.Context == "metric" || item.Context == "datapoint" {
+				return fmt.Errorf("%q hash_key is not supported in %q context", item.HashKey, item.Context)
+			}
+		default:
+			return errors.New("invalid hash_key: " + item.HashKey)
+		}
 
 		switch item.Context {
 		case "", "resource", "span", "metric", "datapoint", "log": // ok
@@ -100,8 +133,45 @@ type RoutingTableItem struct {
 	// matches this table item. When no pipelines are specified, the ones specified under
 	// DefaultPipelines are used, if any.
 	// The routing processor will fail upon the first failure from these pipelines.
+	// Either 'Pipelines' or 'WeightedPipelines' must be provided.
+	Pipelines []pipeline.ID `mapstructure:"pipelines"`
+
+	// WeightedPipelines splits the data matching this table item between several sets of pipelines,
+	// in proportion to their weights. The split is stable on the HashKey of the data, e.g. all the
+	// spans of a trace are routed to the same pipelines.
 	// Optional.
+	WeightedPipelines []WeightedPipelines `mapstructure:"weighted_pipelines"`
+
+	// HashKey is the key the weighted split and the mirror sampling are stable on.
+	// One of "resource", "trace_id". "trace_id" is only supported for traces and logs,
+	// the metrics are always split by "resource".
+	// Optional. Default "trace_id" for traces and logs.
+	HashKey string `mapstructure:"hash_key"`
+
+	// Mirror copies a sample of the data matching this table item to shadow pipelines. The errors
+	// of the shadow pipelines are logged and don't affect the routing of the data.
+	// Optional.
+	Mirror *MirrorConfig `mapstructure:"mirror"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// WeightedPipelines is a share of the data matching a table item
+type WeightedPipelines struct {
+	// Pipelines contains the list of pipelines receiving this share of the data.
+	Pipelines []pipeline.ID `mapstructure:"pipelines"`
+	// Weight is the share of the data relative to the sum of the weights of the table item.
+	Weight int `mapstructure:"weight"`
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+// MirrorConfig specifies the shadow pipelines the data matching a table item is copied to
+type MirrorConfig struct {
+	// Pipelines contains the list of shadow pipelines.
 	Pipelines []pipeline.ID `mapstructure:"pipelines"`
+	// SamplingPercentage is the percentage of the data copied to the shadow pipelines.
+	SamplingPercentage float64 `mapstructure:"sampling_percentage"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
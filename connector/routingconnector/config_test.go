@@ -375,6 +375,105 @@ func TestValidateConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "weighted pipelines provided",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						WeightedPipelines: []WeightedPipelines{
+							{Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "stable")}, Weight: 90},
+							{Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "canary")}, Weight: 10},
+						},
+						HashKey: "trace_id",
+						Mirror: &MirrorConfig{
+							Pipelines:          []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "shadow")},
+							SamplingPercentage: 5,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "both pipelines and weighted pipelines provided",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
+						},
+						WeightedPipelines: []WeightedPipelines{
+							{Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "canary")}, Weight: 10},
+						},
+					},
+				},
+			},
+			error: "invalid route: both pipelines and weighted_pipelines provided",
+		},
+		{
+			name: "weighted pipelines without weight",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						WeightedPipelines: []WeightedPipelines{
+							{Pipelines: []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "canary")}},
+						},
+					},
+				},
+			},
+			error: "invalid route: weighted_pipelines require pipelines and a positive weight",
+		},
+		{
+			name: "invalid mirror sampling percentage",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
+						},
+						Mirror: &MirrorConfig{
+							Pipelines:          []pipeline.ID{pipeline.NewIDWithName(pipeline.SignalTraces, "shadow")},
+							SamplingPercentage: 150,
+						},
+					},
+				},
+			},
+			error: "invalid route: mirror requires pipelines and a sampling_percentage between 0 and 100",
+		},
+		{
+			name: "invalid hash key",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Condition: `attributes["attr"] == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalTraces, "otlp"),
+						},
+						HashKey: "span_id",
+					},
+				},
+			},
+			error: "invalid hash_key: span_id",
+		},
+		{
+			name: "trace_id hash key in metric context",
+			config: &Config{
+				Table: []RoutingTableItem{
+					{
+						Context:   "metric",
+						Condition: `name == "acme"`,
+						Pipelines: []pipeline.ID{
+							pipeline.NewIDWithName(pipeline.SignalMetrics, "otlp"),
+						},
+						HashKey: "trace_id",
+					},
+				},
+			},
+			error: `"trace_id" hash_key is not supported in "metric" context`,
+		},
 	}
 
 	for _, tt := range tests {
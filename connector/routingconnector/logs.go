@@ -20,8 +20,9 @@ import (
 )
 
 type logsConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
+	// mirrorQueue sends the mirrored data to the shadow pipelines, it is
+	// started and shut down with the connector.
+	*mirrorQueue
 
 	logger *zap.Logger
 	config *Config
@@ -49,9 +50,10 @@ func newLogsConnector(
 	}
 
 	return &logsConnector{
-		logger: set.Logger,
-		config: cfg,
-		router: r,
+		mirrorQueue: newMirrorQueue(set.Logger, "logs"),
+		logger:      set.Logger,
+		config:      cfg,
+		router:      r,
 	}, nil
 }
 
@@ -61,6 +63,7 @@ func (c *logsConnector) Capabilities() consumer.Capabilities {
 
 func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	groups := make(map[consumer.Logs]plog.Logs)
+	mirrors := make(map[consumer.Logs]plog.Logs)
 	var errs error
 	for i := 0; i < len(c.router.routeSlice) && ld.ResourceLogs().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
@@ -68,7 +71,7 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
-				groupAllLogs(groups, route.consumer, ld)
+				groupRoutedLogs(groups, mirrors, route, ld)
 				ld = plog.NewLogs() // all logs have been routed
 			}
 		case "", "resource":
@@ -96,16 +99,79 @@ func (c *logsConnector) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 			}
 			groupAllLogs(groups, c.router.defaultConsumer, matchedLogs)
 		}
-		groupAllLogs(groups, route.consumer, matchedLogs)
+		groupRoutedLogs(groups, mirrors, route, matchedLogs)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
 	groupAllLogs(groups, c.router.defaultConsumer, ld)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeLogs(ctx, group))
 	}
+	// the mirrors are sent in the background, so they don't affect the primary routes
+	mirrorCtx := context.WithoutCancel(ctx)
+	for consumer, group := range mirrors {
+		c.enqueue(func() error {
+			return consumer.ConsumeLogs(mirrorCtx, group)
+		})
+	}
 	return errs
 }
 
+// groupRoutedLogs groups the logs matched by a route by the consumers of the route and,
+// if they are sampled, by the mirror consumer of the route.
+func groupRoutedLogs(
+	groups map[consumer.Logs]plog.Logs,
+	mirrors map[consumer.Logs]plog.Logs,
+	route routingItem[consumer.Logs],
+	ld plog.Logs,
+) {
+	if !route.split() {
+		groupAllLogs(groups, route.consumer, ld)
+		return
+	}
+	consumers := route.consumers()
+	if route.hashKey == hashKeyResource {
+		for i := 0; i < ld.ResourceLogs().Len(); i++ {
+			rs := ld.ResourceLogs().At(i)
+			hash := resourceHash(rs.Resource())
+			groupLogs(groups, consumers[route.pick(hash)], rs)
+			if route.mirrored(hash) {
+				groupLogs(mirrors, route.mirror, rs)
+			}
+		}
+		return
+	}
+
+	if route.mirrorThreshold > 0 {
+		sampled := plog.NewLogs()
+		remaining := plog.NewLogs()
+		ld.CopyTo(remaining)
+		plogutil.MoveRecordsWithContextIf(remaining, sampled,
+			func(rl plog.ResourceLogs, _ plog.ScopeLogs, lr plog.LogRecord) bool {
+				return route.mirrored(logRecordHash(rl, lr))
+			},
+		)
+		groupAllLogs(mirrors, route.mirror, sampled)
+	}
+	for i, cons := range consumers {
+		picked := plog.NewLogs()
+		plogutil.MoveRecordsWithContextIf(ld, picked,
+			func(rl plog.ResourceLogs, _ plog.ScopeLogs, lr plog.LogRecord) bool {
+				return route.pick(logRecordHash(rl, lr)) == i
+			},
+		)
+		groupAllLogs(groups, cons, picked)
+	}
+}
+
+// logRecordHash returns the hash of the trace ID of a log record, or the hash of its resource
+// if the log record has no trace ID.
+func logRecordHash(rl plog.ResourceLogs, lr plog.LogRecord) uint64 {
+	if lr.TraceID().IsEmpty() {
+		return resourceHash(rl.Resource())
+	}
+	return traceIDHash(lr.TraceID())
+}
+
 func groupAllLogs(
 	groups map[consumer.Logs]plog.Logs,
 	cons consumer.Logs,
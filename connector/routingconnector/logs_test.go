@@ -14,6 +14,7 @@ import (
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pipeline"
 
@@ -391,6 +392,64 @@ func TestLogsResourceAttributeDroppedByOTTL(t *testing.T) {
 	)
 }
 
+func TestLogsWeightedRoutingByTraceID(t *testing.T) {
+	logsStable := pipeline.NewIDWithName(pipeline.SignalLogs, "stable")
+	logsCanary := pipeline.NewIDWithName(pipeline.SignalLogs, "canary")
+
+	cfg := &Config{
+		Table: []RoutingTableItem{
+			{
+				Context:   "log",
+				Condition: `severity_text == "ERROR"`,
+				WeightedPipelines: []WeightedPipelines{
+					{Pipelines: []pipeline.ID{logsStable}, Weight: 1},
+					{Pipelines: []pipeline.ID{logsCanary}, Weight: 1},
+				},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	var stableSink, canarySink consumertest.LogsSink
+	router := connector.NewLogsRouter(map[pipeline.ID]consumer.Logs{
+		logsStable: &stableSink,
+		logsCanary: &canarySink,
+	})
+	conn, err := NewFactory().CreateLogsToLogs(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, router.(consumer.Logs))
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	records := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for i := 0; i < 100; i++ {
+		lr := records.AppendEmpty()
+		lr.SetSeverityText("ERROR")
+		lr.SetTraceID(pcommon.TraceID{byte(i), 1})
+	}
+	// the log records without trace ID are split by their resource
+	for i := 0; i < 10; i++ {
+		records.AppendEmpty().SetSeverityText("ERROR")
+	}
+	require.NoError(t, conn.ConsumeLogs(context.Background(), ld))
+
+	assert.Equal(t, 110, stableSink.LogRecordCount()+canarySink.LogRecordCount())
+	assert.InDelta(t, 55, canarySink.LogRecordCount(), 25)
+	var withoutTraceID []int
+	for _, sink := range []*consumertest.LogsSink{&stableSink, &canarySink} {
+		count := 0
+		for _, logs := range sink.AllLogs() {
+			records := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+			for i := 0; i < records.Len(); i++ {
+				if records.At(i).TraceID().IsEmpty() {
+					count++
+				}
+			}
+		}
+		withoutTraceID = append(withoutTraceID, count)
+	}
+	assert.ElementsMatch(t, []int{0, 10}, withoutTraceID)
+}
+
 func TestLogsConnectorCapabilities(t *testing.T) {
 	logsDefault := pipeline.NewIDWithName(pipeline.SignalLogs, "default")
 	logsOther := pipeline.NewIDWithName(pipeline.SignalLogs, "other")
@@ -21,8 +21,9 @@ import (
 )
 
 type metricsConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
+	// mirrorQueue sends the mirrored data to the shadow pipelines, it is
+	// started and shut down with the connector.
+	*mirrorQueue
 
 	logger *zap.Logger
 	config *Config
@@ -50,9 +51,10 @@ func newMetricsConnector(
 	}
 
 	return &metricsConnector{
-		logger: set.Logger,
-		config: cfg,
-		router: r,
+		mirrorQueue: newMirrorQueue(set.Logger, "metrics"),
+		logger:      set.Logger,
+		config:      cfg,
+		router:      r,
 	}, nil
 }
 
@@ -62,6 +64,7 @@ func (c *metricsConnector) Capabilities() consumer.Capabilities {
 
 func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metrics) error {
 	groups := make(map[consumer.Metrics]pmetric.Metrics)
+	mirrors := make(map[consumer.Metrics]pmetric.Metrics)
 	var errs error
 	for i := 0; i < len(c.router.routeSlice) && md.ResourceMetrics().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
@@ -69,7 +72,7 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
-				groupAllMetrics(groups, route.consumer, md)
+				groupRoutedMetrics(groups, mirrors, route, md)
 				md = pmetric.NewMetrics() // all metrics have been routed
 			}
 		case "", "resource":
@@ -106,16 +109,46 @@ func (c *metricsConnector) ConsumeMetrics(ctx context.Context, md pmetric.Metric
 			}
 			groupAllMetrics(groups, c.router.defaultConsumer, matchedMetrics)
 		}
-		groupAllMetrics(groups, route.consumer, matchedMetrics)
+		groupRoutedMetrics(groups, mirrors, route, matchedMetrics)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
 	groupAllMetrics(groups, c.router.defaultConsumer, md)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeMetrics(ctx, group))
 	}
+	// the mirrors are sent in the background, so they don't affect the primary routes
+	mirrorCtx := context.WithoutCancel(ctx)
+	for consumer, group := range mirrors {
+		c.enqueue(func() error {
+			return consumer.ConsumeMetrics(mirrorCtx, group)
+		})
+	}
 	return errs
 }
 
+// groupRoutedMetrics groups the metrics matched by a route by the consumers of the route and,
+// if they are sampled, by the mirror consumer of the route.
+func groupRoutedMetrics(
+	groups map[consumer.Metrics]pmetric.Metrics,
+	mirrors map[consumer.Metrics]pmetric.Metrics,
+	route routingItem[consumer.Metrics],
+	md pmetric.Metrics,
+) {
+	if !route.split() {
+		groupAllMetrics(groups, route.consumer, md)
+		return
+	}
+	consumers := route.consumers()
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rs := md.ResourceMetrics().At(i)
+		hash := resourceHash(rs.Resource())
+		groupMetrics(groups, consumers[route.pick(hash)], rs)
+		if route.mirrored(hash) {
+			groupMetrics(mirrors, route.mirror, rs)
+		}
+	}
+}
+
 func groupAllMetrics(
 	groups map[consumer.Metrics]pmetric.Metrics,
 	cons consumer.Metrics,
@@ -413,6 +413,55 @@ func TestMetricsResourceAttributeDroppedByOTTL(t *testing.T) {
 	)
 }
 
+func TestMetricsWeightedRouting(t *testing.T) {
+	metricsStable := pipeline.NewIDWithName(pipeline.SignalMetrics, "stable")
+	metricsCanary := pipeline.NewIDWithName(pipeline.SignalMetrics, "canary")
+
+	cfg := &Config{
+		Table: []RoutingTableItem{
+			{
+				Condition: `attributes["env"] == "prod"`,
+				WeightedPipelines: []WeightedPipelines{
+					{Pipelines: []pipeline.ID{metricsStable}, Weight: 1},
+					{Pipelines: []pipeline.ID{metricsCanary}, Weight: 1},
+				},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	var stableSink, canarySink consumertest.MetricsSink
+	router := connector.NewMetricsRouter(map[pipeline.ID]consumer.Metrics{
+		metricsStable: &stableSink,
+		metricsCanary: &canarySink,
+	})
+	conn, err := NewFactory().CreateMetricsToMetrics(context.Background(),
+		connectortest.NewNopSettings(metadata.Type), cfg, router.(consumer.Metrics))
+	require.NoError(t, err)
+
+	newMetrics := func() pmetric.Metrics {
+		md := pmetric.NewMetrics()
+		for i := 0; i < 100; i++ {
+			rm := md.ResourceMetrics().AppendEmpty()
+			rm.Resource().Attributes().PutStr("env", "prod")
+			rm.Resource().Attributes().PutInt("instance", int64(i))
+			rm.ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptyGauge().DataPoints().AppendEmpty()
+		}
+		return md
+	}
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), newMetrics()))
+
+	require.Len(t, canarySink.AllMetrics(), 1)
+	canary := canarySink.AllMetrics()[0]
+	assert.Equal(t, 100, stableSink.AllMetrics()[0].ResourceMetrics().Len()+canary.ResourceMetrics().Len())
+	assert.InDelta(t, 50, canary.ResourceMetrics().Len(), 20)
+
+	// the resources are routed to the same pipeline across requests
+	canarySink.Reset()
+	require.NoError(t, conn.ConsumeMetrics(context.Background(), newMetrics()))
+	assert.Equal(t, canary, canarySink.AllMetrics()[0])
+}
+
 func TestMetricsConnectorCapabilities(t *testing.T) {
 	metricsDefault := pipeline.NewIDWithName(pipeline.SignalMetrics, "default")
 	metricsOther := pipeline.NewIDWithName(pipeline.SignalMetrics, "other")
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+)
+
+// mirrorQueueSize is the number of mirrored batches waiting to be sent to the shadow pipelines.
+// The batches mirrored while the queue is full are dropped.
+const mirrorQueueSize = 100
+
+// mirrorQueue sends the mirrored data to the shadow pipelines in the background, so the shadow
+// pipelines neither delay nor fail the routing of the data to the primary pipelines.
+type mirrorQueue struct {
+	logger *zap.Logger
+	// signal is the type of the mirrored data, used in the log messages.
+	signal string
+
+	lock   sync.RWMutex
+	queue  chan func() error
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func newMirrorQueue(logger *zap.Logger, signal string) *mirrorQueue {
+	return &mirrorQueue{
+		logger: logger,
+		signal: signal,
+		queue:  make(chan func() error, mirrorQueueSize),
+	}
+}
+
+// Start starts sending the queued batches to the shadow pipelines.
+func (q *mirrorQueue) Start(context.Context, component.Host) error {
+	q.wg.Add(1)
+	go func() {
+		defer q.wg.Done()
+		for send := range q.queue {
+			if err := send(); err != nil {
+				q.logger.Warn("failed to mirror "+q.signal, zap.Error(err))
+			}
+		}
+	}()
+	return nil
+}
+
+// Shutdown stops accepting mirrored batches and waits for the queued ones to be sent.
+func (q *mirrorQueue) Shutdown(ctx context.Context) error {
+	q.lock.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.queue)
+	}
+	q.lock.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue queues a batch to be sent to the shadow pipelines, it never blocks.
+func (q *mirrorQueue) enqueue(send func() error) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	if q.closed {
+		return
+	}
+	select {
+	case q.queue <- send:
+	default:
+		q.logger.Warn("dropping mirrored " + q.signal + ", the mirror queue is full")
+	}
+}
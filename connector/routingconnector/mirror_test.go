@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestMirrorQueue(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	q := newMirrorQueue(zap.New(core), "traces")
+
+	// the batches are queued until the queue is started, and dropped
+	// once it is full
+	sent := 0
+	for i := 0; i < mirrorQueueSize+1; i++ {
+		q.enqueue(func() error {
+			sent++
+			return nil
+		})
+	}
+	assert.Equal(t, 1, logs.FilterMessage("dropping mirrored traces, the mirror queue is full").Len())
+
+	require.NoError(t, q.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, q.Shutdown(context.Background()))
+	assert.Equal(t, mirrorQueueSize, sent)
+
+	// the batches mirrored after the shutdown are dropped
+	q.enqueue(func() error {
+		sent++
+		return nil
+	})
+	assert.Equal(t, mirrorQueueSize, sent)
+}
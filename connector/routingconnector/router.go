@@ -4,11 +4,14 @@
 package routingconnector // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector"
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"strings"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pipeline"
 	"go.uber.org/zap"
 
@@ -19,8 +22,12 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
 )
 
+// mirrorScale is the precision of the mirror sampling percentage.
+const mirrorScale = 10000
+
 var errPipelineNotFound = errors.New("pipeline not found")
 
 // consumerProvider is a function with a type parameter C (expected to be one
@@ -80,6 +87,63 @@ type routingItem[C any] struct {
 	dataPointStatement *ottl.Statement[ottldatapoint.TransformContext]
 	logStatement       *ottl.Statement[ottllog.TransformContext]
 	statementContext   string
+
+	// targets and weights are the consumers the matched data is split between, they are only
+	// set for routes with weighted pipelines.
+	targets []C
+	weights []uint64
+	// totalWeight is the sum of the weights.
+	totalWeight uint64
+	hashKey     string
+	mirror      C
+	// mirrorThreshold is the sampling percentage of the mirror scaled to mirrorScale, it is 0 if
+	// the route has no mirror.
+	mirrorThreshold uint64
+}
+
+// split returns whether the matched data is split between consumers or mirrored by its hash key.
+func (r routingItem[C]) split() bool {
+	return len(r.targets) > 0 || r.mirrorThreshold > 0
+}
+
+// pick returns the index of the target consumer for the hash of the data.
+func (r routingItem[C]) pick(hash uint64) int {
+	if len(r.targets) == 0 {
+		return 0
+	}
+	bucket := hash % r.totalWeight
+	for i, weight := range r.weights {
+		if bucket < weight {
+			return i
+		}
+		bucket -= weight
+	}
+	return len(r.weights) - 1
+}
+
+// consumers returns the consumers the matched data is routed to, in the order of pick.
+func (r routingItem[C]) consumers() []C {
+	if len(r.targets) == 0 {
+		return []C{r.consumer}
+	}
+	return r.targets
+}
+
+// mirrored returns whether the data with the hash is copied to the mirror consumer.
+func (r routingItem[C]) mirrored(hash uint64) bool {
+	// remix the hash so the mirror sample doesn't follow the weighted split
+	return r.mirrorThreshold > 0 && ((hash*0x9e3779b97f4a7c15)>>32)%mirrorScale < r.mirrorThreshold
+}
+
+func resourceHash(resource pcommon.Resource) uint64 {
+	hash := pdatautil.MapHash(resource.Attributes())
+	return binary.LittleEndian.Uint64(hash[:8])
+}
+
+func traceIDHash(traceID pcommon.TraceID) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(traceID[:])
+	return h.Sum64()
 }
 
 func (r *router[C]) buildParsers(table []RoutingTableItem, settings component.TelemetrySettings) error {
@@ -254,16 +318,48 @@ func (r *router[C]) registerRouteConsumers() (err error) {
 			r.logger.Warn(fmt.Sprintf(`Statement %q already exists in the routing table, the route with target pipeline(s) %q will be ignored.`, item.Statement, exporters))
 		}
 
+		if err := r.registerTargetConsumers(&route, item); err != nil {
+			return err
+		}
+		if !ok {
+			r.routeSlice = append(r.routeSlice, route)
+		}
+
+		r.routes[key(item)] = route
+	}
+	return nil
+}
+
+// registerTargetConsumers registers the consumers a route sends the matched data to
+func (r *router[C]) registerTargetConsumers(route *routingItem[C], item RoutingTableItem) error {
+	var zero C
+	route.consumer, route.targets, route.weights, route.totalWeight = zero, nil, nil, 0
+	if len(item.WeightedPipelines) == 0 {
 		consumer, err := r.consumerProvider(item.Pipelines...)
 		if err != nil {
 			return fmt.Errorf("%w: %s", errPipelineNotFound, err.Error())
 		}
 		route.consumer = consumer
-		if !ok {
-			r.routeSlice = append(r.routeSlice, route)
+	}
+	for _, weighted := range item.WeightedPipelines {
+		consumer, err := r.consumerProvider(weighted.Pipelines...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errPipelineNotFound, err.Error())
 		}
+		route.targets = append(route.targets, consumer)
+		route.weights = append(route.weights, uint64(weighted.Weight))
+		route.totalWeight += uint64(weighted.Weight)
+	}
 
-		r.routes[key(item)] = route
+	route.hashKey = item.HashKey
+	route.mirror, route.mirrorThreshold = zero, 0
+	if item.Mirror != nil {
+		consumer, err := r.consumerProvider(item.Mirror.Pipelines...)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errPipelineNotFound, err.Error())
+		}
+		route.mirror = consumer
+		route.mirrorThreshold = max(uint64(item.Mirror.SamplingPercentage*mirrorScale/100), 1)
 	}
 	return nil
 }
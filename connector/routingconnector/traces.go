@@ -20,8 +20,9 @@ import (
 )
 
 type tracesConnector struct {
-	component.StartFunc
-	component.ShutdownFunc
+	// mirrorQueue sends the mirrored data to the shadow pipelines, it is
+	// started and shut down with the connector.
+	*mirrorQueue
 
 	logger *zap.Logger
 	config *Config
@@ -49,9 +50,10 @@ func newTracesConnector(
 	}
 
 	return &tracesConnector{
-		logger: set.Logger,
-		config: cfg,
-		router: r,
+		mirrorQueue: newMirrorQueue(set.Logger, "traces"),
+		logger:      set.Logger,
+		config:      cfg,
+		router:      r,
 	}, nil
 }
 
@@ -61,6 +63,7 @@ func (*tracesConnector) Capabilities() consumer.Capabilities {
 
 func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) error {
 	groups := make(map[consumer.Traces]ptrace.Traces)
+	mirrors := make(map[consumer.Traces]ptrace.Traces)
 	var errs error
 	for i := 0; i < len(c.router.routeSlice) && td.ResourceSpans().Len() > 0; i++ {
 		route := c.router.routeSlice[i]
@@ -68,7 +71,7 @@ func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) e
 		switch route.statementContext {
 		case "request":
 			if route.requestCondition.matchRequest(ctx) {
-				groupAllTraces(groups, route.consumer, td)
+				groupRoutedTraces(groups, mirrors, route, td)
 				td = ptrace.NewTraces() // all traces have been routed
 			}
 		case "", "resource":
@@ -96,16 +99,70 @@ func (c *tracesConnector) ConsumeTraces(ctx context.Context, td ptrace.Traces) e
 			}
 			groupAllTraces(groups, c.router.defaultConsumer, matchedSpans)
 		}
-		groupAllTraces(groups, route.consumer, matchedSpans)
+		groupRoutedTraces(groups, mirrors, route, matchedSpans)
 	}
 	// anything left wasn't matched by any route. Send to default consumer
 	groupAllTraces(groups, c.router.defaultConsumer, td)
 	for consumer, group := range groups {
 		errs = errors.Join(errs, consumer.ConsumeTraces(ctx, group))
 	}
+	// the mirrors are sent in the background, so they don't affect the primary routes
+	mirrorCtx := context.WithoutCancel(ctx)
+	for consumer, group := range mirrors {
+		c.enqueue(func() error {
+			return consumer.ConsumeTraces(mirrorCtx, group)
+		})
+	}
 	return errs
 }
 
+// groupRoutedTraces groups the traces matched by a route by the consumers of the route and,
+// if they are sampled, by the mirror consumer of the route.
+func groupRoutedTraces(
+	groups map[consumer.Traces]ptrace.Traces,
+	mirrors map[consumer.Traces]ptrace.Traces,
+	route routingItem[consumer.Traces],
+	td ptrace.Traces,
+) {
+	if !route.split() {
+		groupAllTraces(groups, route.consumer, td)
+		return
+	}
+	consumers := route.consumers()
+	if route.hashKey == hashKeyResource {
+		for i := 0; i < td.ResourceSpans().Len(); i++ {
+			rs := td.ResourceSpans().At(i)
+			hash := resourceHash(rs.Resource())
+			groupTraces(groups, consumers[route.pick(hash)], rs)
+			if route.mirrored(hash) {
+				groupTraces(mirrors, route.mirror, rs)
+			}
+		}
+		return
+	}
+
+	if route.mirrorThreshold > 0 {
+		sampled := ptrace.NewTraces()
+		remaining := ptrace.NewTraces()
+		td.CopyTo(remaining)
+		ptraceutil.MoveSpansWithContextIf(remaining, sampled,
+			func(_ ptrace.ResourceSpans, _ ptrace.ScopeSpans, s ptrace.Span) bool {
+				return route.mirrored(traceIDHash(s.TraceID()))
+			},
+		)
+		groupAllTraces(mirrors, route.mirror, sampled)
+	}
+	for i, cons := range consumers {
+		picked := ptrace.NewTraces()
+		ptraceutil.MoveSpansWithContextIf(td, picked,
+			func(_ ptrace.ResourceSpans, _ ptrace.ScopeSpans, s ptrace.Span) bool {
+				return route.pick(traceIDHash(s.TraceID())) == i
+			},
+		)
+		groupAllTraces(groups, cons, picked)
+	}
+}
+
 func groupAllTraces(
 	groups map[consumer.Traces]ptrace.Traces,
 	cons consumer.Traces,
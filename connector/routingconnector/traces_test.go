@@ -5,6 +5,7 @@ package routingconnector // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,8 +15,11 @@ import (
 	"go.opentelemetry.io/collector/connector/connectortest"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/pipeline"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/routingconnector/internal/metadata"
@@ -371,6 +375,110 @@ func TestTracesResourceAttributeDroppedByOTTL(t *testing.T) {
 	)
 }
 
+func TestTracesWeightedRoutingAndMirror(t *testing.T) {
+	tracesStable := pipeline.NewIDWithName(pipeline.SignalTraces, "stable")
+	tracesCanary := pipeline.NewIDWithName(pipeline.SignalTraces, "canary")
+	tracesShadow := pipeline.NewIDWithName(pipeline.SignalTraces, "shadow")
+
+	cfg := &Config{
+		Table: []RoutingTableItem{
+			{
+				Condition: `attributes["env"] == "prod"`,
+				WeightedPipelines: []WeightedPipelines{
+					{Pipelines: []pipeline.ID{tracesStable}, Weight: 75},
+					{Pipelines: []pipeline.ID{tracesCanary}, Weight: 25},
+				},
+				Mirror: &MirrorConfig{
+					Pipelines:          []pipeline.ID{tracesShadow},
+					SamplingPercentage: 50,
+				},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	newTraces := func() ptrace.Traces {
+		td := ptrace.NewTraces()
+		rs := td.ResourceSpans().AppendEmpty()
+		rs.Resource().Attributes().PutStr("env", "prod")
+		spans := rs.ScopeSpans().AppendEmpty().Spans()
+		for i := 0; i < 1000; i++ {
+			// two spans per trace
+			for j := 0; j < 2; j++ {
+				span := spans.AppendEmpty()
+				span.SetTraceID(pcommon.TraceID{byte(i), byte(i >> 8), 1})
+			}
+		}
+		return td
+	}
+	traceIDs := func(sink *consumertest.TracesSink) map[pcommon.TraceID]int {
+		ids := make(map[pcommon.TraceID]int)
+		for _, td := range sink.AllTraces() {
+			spans := td.ResourceSpans().At(0).ScopeSpans().At(0).Spans()
+			for i := 0; i < spans.Len(); i++ {
+				ids[spans.At(i).TraceID()]++
+			}
+		}
+		return ids
+	}
+
+	t.Run("weighted split and mirror", func(t *testing.T) {
+		var stableSink, canarySink, shadowSink consumertest.TracesSink
+		router := connector.NewTracesRouter(map[pipeline.ID]consumer.Traces{
+			tracesStable: &stableSink,
+			tracesCanary: &canarySink,
+			tracesShadow: &shadowSink,
+		})
+		conn, err := NewFactory().CreateTracesToTraces(context.Background(),
+			connectortest.NewNopSettings(metadata.Type), cfg, router.(consumer.Traces))
+		require.NoError(t, err)
+
+		require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+		require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces()))
+		assert.Equal(t, 2000, stableSink.SpanCount()+canarySink.SpanCount())
+		assert.InDelta(t, 500, canarySink.SpanCount(), 100)
+		// the shutdown waits for the mirrored traces to be sent
+		require.NoError(t, conn.Shutdown(context.Background()))
+		assert.InDelta(t, 1000, shadowSink.SpanCount(), 150)
+
+		// all the spans of a trace are routed to the same pipeline
+		canaryIDs := traceIDs(&canarySink)
+		for id, count := range canaryIDs {
+			assert.Equal(t, 2, count)
+			assert.NotContains(t, traceIDs(&stableSink), id)
+		}
+		for _, count := range traceIDs(&shadowSink) {
+			assert.Equal(t, 2, count)
+		}
+
+		// the split is stable across requests
+		canarySink.Reset()
+		require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces()))
+		assert.Equal(t, canaryIDs, traceIDs(&canarySink))
+	})
+
+	t.Run("mirror errors are logged", func(t *testing.T) {
+		var stableSink, canarySink consumertest.TracesSink
+		router := connector.NewTracesRouter(map[pipeline.ID]consumer.Traces{
+			tracesStable: &stableSink,
+			tracesCanary: &canarySink,
+			tracesShadow: consumertest.NewErr(errors.New("shadow error")),
+		})
+		core, logs := observer.New(zap.WarnLevel)
+		set := connectortest.NewNopSettings(metadata.Type)
+		set.Logger = zap.New(core)
+		conn, err := NewFactory().CreateTracesToTraces(context.Background(), set, cfg, router.(consumer.Traces))
+		require.NoError(t, err)
+
+		require.NoError(t, conn.Start(context.Background(), componenttest.NewNopHost()))
+		require.NoError(t, conn.ConsumeTraces(context.Background(), newTraces()))
+		assert.Equal(t, 2000, stableSink.SpanCount()+canarySink.SpanCount())
+		require.NoError(t, conn.Shutdown(context.Background()))
+		require.Equal(t, 1, logs.FilterMessage("failed to mirror traces").Len())
+		assert.Equal(t, "shadow error", logs.All()[0].ContextMap()["error"])
+	})
+}
+
 func TestTraceConnectorCapabilities(t *testing.T) {
 	tracesDefault := pipeline.NewIDWithName(pipeline.SignalTraces, "default")
 	tracesOther := pipeline.NewIDWithName(pipeline.SignalTraces, "0")
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/lightstep/go-expohisto/structure"
 	"go.opentelemetry.io/collector/component"
@@ -31,12 +32,26 @@ const (
 	// error of less than 5%.
 	// Ref: https://opentelemetry.io/docs/specs/otel/metrics/sdk/#base2-exponential-bucket-histogram-aggregation
 	defaultExponentialHistogramMaxSize = 160
+	defaultSummaryRelativeAccuracy     = 0.01
+	defaultDistinctCountPrecision      = 14
+)
+
+// Supported aggregation temporalities of the sum and histogram metrics.
+const (
+	AggregationTemporalityDelta      = "delta"
+	AggregationTemporalityCumulative = "cumulative"
 )
 
 var defaultHistogramBuckets = []float64{
 	2, 4, 6, 8, 10, 50, 100, 200, 400, 800, 1000, 1400, 2000, 5000, 10_000, 15_000,
 }
 
+var defaultSummaryQuantiles = []float64{0.5, 0.9, 0.95, 0.99}
+
+// DefaultMetricsExpiration is the default duration after which a cumulative
+// metric stream that hasn't been updated is dropped from the state.
+const DefaultMetricsExpiration = 5 * time.Minute
+
 // Regex for [key] selector after ExtractGrokPatterns
 var grokPatternKey = regexp.MustCompile(`ExtractGrokPatterns\([^)]*\)\s*\[[^\]]+\]`)
 
@@ -49,6 +64,17 @@ type Config struct {
 	Datapoints []MetricInfo `mapstructure:"datapoints"`
 	Logs       []MetricInfo `mapstructure:"logs"`
 	Profiles   []MetricInfo `mapstructure:"profiles"`
+	// MetricsExpiration is the duration after which a cumulative metric
+	// stream that hasn't been updated is dropped from the state, and its
+	// next data point starts a new stream. Defaults to 5 minutes. A value
+	// of 0 means that the streams never expire, which requires an
+	// AggregationCardinalityLimit to bound the state.
+	MetricsExpiration time.Duration `mapstructure:"metrics_expiration"`
+	// AggregationCardinalityLimit is the maximum number of attribute sets
+	// aggregated for a metric and a resource. The data exceeding the limit
+	// is aggregated in a single stream with the `otel.metric.overflow`
+	// attribute set to true. The default value (0) means no limit.
+	AggregationCardinalityLimit int `mapstructure:"aggregation_cardinality_limit"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -57,7 +83,16 @@ func (c *Config) Validate() error {
 	if len(c.Spans) == 0 && len(c.Datapoints) == 0 && len(c.Logs) == 0 && len(c.Profiles) == 0 {
 		return errors.New("no configuration provided, at least one should be specified")
 	}
+	if c.MetricsExpiration < 0 {
+		return fmt.Errorf("invalid metrics_expiration: %v, the duration should be positive", c.MetricsExpiration)
+	}
+	if c.AggregationCardinalityLimit < 0 {
+		return fmt.Errorf("invalid aggregation_cardinality_limit: %v, the limit should be positive", c.AggregationCardinalityLimit)
+	}
 	var multiError error // collect all errors at once
+	if c.MetricsExpiration == 0 && c.AggregationCardinalityLimit == 0 && c.hasCumulativeMetrics() {
+		multiError = errors.New("cumulative metrics require a metrics_expiration or an aggregation_cardinality_limit to bound their state")
+	}
 	if len(c.Spans) > 0 {
 		parser, err := ottlspan.NewParser(
 			customottl.SpanFuncs(),
@@ -172,6 +207,29 @@ type Gauge struct {
 	Value string `mapstructure:"value"`
 }
 
+// Summary produces quantiles of the values computed with a DDSketch. The sum
+// and the count of the summary are exact.
+type Summary struct {
+	// Quantiles are the quantiles reported by the summary, in the [0, 1]
+	// range.
+	Quantiles []float64 `mapstructure:"quantiles"`
+	// RelativeAccuracy is the relative accuracy guaranteed by the sketch
+	// for the quantile values, in the (0, 1) range.
+	RelativeAccuracy float64 `mapstructure:"relative_accuracy"`
+	Count            string  `mapstructure:"count"`
+	Value            string  `mapstructure:"value"`
+}
+
+// DistinctCount produces a gauge with the estimated number of distinct
+// values computed with a HyperLogLog sketch.
+type DistinctCount struct {
+	// Precision is the number of bits used for the registers of the sketch,
+	// in the [4, 18] range. Higher precisions are more accurate but use
+	// more memory.
+	Precision uint8  `mapstructure:"precision"`
+	Value     string `mapstructure:"value"`
+}
+
 // MetricInfo defines the structure of the metric produced by the connector.
 type MetricInfo struct {
 	Name        string `mapstructure:"name"`
@@ -191,6 +249,15 @@ type MetricInfo struct {
 	ExponentialHistogram *ExponentialHistogram `mapstructure:"exponential_histogram"`
 	Sum                  *Sum                  `mapstructure:"sum"`
 	Gauge                *Gauge                `mapstructure:"gauge"`
+	Summary              *Summary              `mapstructure:"summary"`
+	DistinctCount        *DistinctCount        `mapstructure:"distinct_count"`
+	// AggregationTemporality is the temporality of the sum and histogram
+	// metrics, either `delta` or `cumulative`. Cumulative metrics are
+	// aggregated in memory across the consumed payloads. The summary and
+	// distinct count metrics are produced from sketches accumulated across
+	// the consumed payloads with the cumulative temporality. The default
+	// value (empty) means delta temporality.
+	AggregationTemporality string `mapstructure:"aggregation_temporality"`
 	// prevent unkeyed literal initialization
 	_ struct{}
 }
@@ -207,6 +274,19 @@ func (mi *MetricInfo) ensureDefaults() {
 			mi.ExponentialHistogram.MaxSize = defaultExponentialHistogramMaxSize
 		}
 	}
+	if mi.Summary != nil {
+		if len(mi.Summary.Quantiles) == 0 {
+			mi.Summary.Quantiles = defaultSummaryQuantiles
+		}
+		if mi.Summary.RelativeAccuracy == 0 {
+			mi.Summary.RelativeAccuracy = defaultSummaryRelativeAccuracy
+		}
+	}
+	if mi.DistinctCount != nil {
+		if mi.DistinctCount.Precision == 0 {
+			mi.DistinctCount.Precision = defaultDistinctCountPrecision
+		}
+	}
 }
 
 func (mi *MetricInfo) validateAttributes() error {
@@ -270,6 +350,68 @@ func (mi *MetricInfo) validateGauge() error {
 	return nil
 }
 
+func (mi *MetricInfo) validateSummary() error {
+	if mi.Summary != nil {
+		if len(mi.Summary.Quantiles) == 0 {
+			return errors.New("summary quantiles missing")
+		}
+		for _, q := range mi.Summary.Quantiles {
+			if q < 0 || q > 1 {
+				return fmt.Errorf("invalid quantile %v, quantiles should be in the [0, 1] range", q)
+			}
+		}
+		if mi.Summary.RelativeAccuracy <= 0 || mi.Summary.RelativeAccuracy >= 1 {
+			return fmt.Errorf("invalid relative accuracy %v, should be in the (0, 1) range", mi.Summary.RelativeAccuracy)
+		}
+		if mi.Summary.Value == "" {
+			return errors.New("value OTTL statement is required")
+		}
+	}
+	return nil
+}
+
+func (mi *MetricInfo) validateDistinctCount() error {
+	if mi.DistinctCount != nil {
+		if mi.DistinctCount.Precision < 4 || mi.DistinctCount.Precision > 18 {
+			return fmt.Errorf("invalid precision %d, should be in the [4, 18] range", mi.DistinctCount.Precision)
+		}
+		if mi.DistinctCount.Value == "" {
+			return errors.New("value must be defined for distinct count metrics")
+		}
+	}
+	return nil
+}
+
+// hasCumulativeMetrics returns whether any of the metrics uses cumulative
+// temporality, and thereby keeps a state across the consumed payloads.
+func (c *Config) hasCumulativeMetrics() bool {
+	for _, mis := range [][]MetricInfo{c.Spans, c.Datapoints, c.Logs, c.Profiles} {
+		for _, mi := range mis {
+			if mi.AggregationTemporality == AggregationTemporalityCumulative {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (mi *MetricInfo) validateAggregationTemporality() error {
+	switch mi.AggregationTemporality {
+	case "", AggregationTemporalityDelta:
+		return nil
+	case AggregationTemporalityCumulative:
+		if mi.Gauge != nil {
+			return errors.New("cumulative temporality is not supported for gauge metrics")
+		}
+		return nil
+	default:
+		return fmt.Errorf(
+			"unsupported aggregation temporality %q, expected %q or %q",
+			mi.AggregationTemporality, AggregationTemporalityDelta, AggregationTemporalityCumulative,
+		)
+	}
+}
+
 // validateMetricInfo is an utility method validate all supported metric
 // types defined for the metric info including any ottl expressions.
 func validateMetricInfo[K any](mi MetricInfo, parser ottl.Parser[K]) error {
@@ -288,6 +430,15 @@ func validateMetricInfo[K any](mi MetricInfo, parser ottl.Parser[K]) error {
 	if err := mi.validateGauge(); err != nil {
 		return fmt.Errorf("gauge validation failed: %w", err)
 	}
+	if err := mi.validateSummary(); err != nil {
+		return fmt.Errorf("summary validation failed: %w", err)
+	}
+	if err := mi.validateDistinctCount(); err != nil {
+		return fmt.Errorf("distinct count validation failed: %w", err)
+	}
+	if err := mi.validateAggregationTemporality(); err != nil {
+		return fmt.Errorf("aggregation temporality validation failed: %w", err)
+	}
 
 	// Exactly one metric should be defined. Also, validate OTTL expressions,
 	// note that, here we only evaluate if statements are valid. Check for
@@ -334,6 +485,23 @@ func validateMetricInfo[K any](mi MetricInfo, parser ottl.Parser[K]) error {
 			}
 		}
 	}
+	if mi.Summary != nil {
+		metricsDefinedCount++
+		if mi.Summary.Count != "" {
+			if _, err := parser.ParseValueExpression(mi.Summary.Count); err != nil {
+				return fmt.Errorf("failed to parse count OTTL expression for summary: %w", err)
+			}
+		}
+		if _, err := parser.ParseValueExpression(mi.Summary.Value); err != nil {
+			return fmt.Errorf("failed to parse value OTTL expression for summary: %w", err)
+		}
+	}
+	if mi.DistinctCount != nil {
+		metricsDefinedCount++
+		if _, err := parser.ParseValueExpression(mi.DistinctCount.Value); err != nil {
+			return fmt.Errorf("failed to parse value OTTL expression for distinct count: %w", err)
+		}
+	}
 	if metricsDefinedCount != 1 {
 		return fmt.Errorf("exactly one of the metrics must be defined, %d found", metricsDefinedCount)
 	}
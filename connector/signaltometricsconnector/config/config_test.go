@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -90,6 +91,24 @@ func TestConfig(t *testing.T) {
 				fullErrorForSignal(t, "profiles", "sum validation failed"),
 			},
 		},
+		{
+			path: "invalid_summary",
+			errorMsgs: []string{
+				fullErrorForSignal(t, "spans", "summary validation failed: invalid quantile 1.5"),
+				fullErrorForSignal(t, "datapoints", "summary validation failed: invalid relative accuracy 2"),
+				fullErrorForSignal(t, "logs", "summary validation failed: value OTTL statement is required"),
+				fullErrorForSignal(t, "profiles", "summary validation failed: invalid quantile -1"),
+			},
+		},
+		{
+			path: "invalid_distinct_count",
+			errorMsgs: []string{
+				fullErrorForSignal(t, "spans", "distinct count validation failed: invalid precision 20"),
+				fullErrorForSignal(t, "datapoints", "distinct count validation failed: value must be defined for distinct count metrics"),
+				fullErrorForSignal(t, "logs", "distinct count validation failed: value must be defined for distinct count metrics"),
+				fullErrorForSignal(t, "profiles", "distinct count validation failed: invalid precision 2"),
+			},
+		},
 		{
 			path: "multiple_metric",
 			errorMsgs: []string{
@@ -123,9 +142,32 @@ func TestConfig(t *testing.T) {
 				fullErrorForSignal(t, "profiles", "failed to parse OTTL conditions"),
 			},
 		},
+		{
+			path: "invalid_aggregation_temporality",
+			errorMsgs: []string{
+				fullErrorForSignal(t, "spans", `aggregation temporality validation failed: unsupported aggregation temporality "unknown"`),
+				fullErrorForSignal(t, "datapoints", "aggregation temporality validation failed: cumulative temporality is not supported for gauge metrics"),
+				fullErrorForSignal(t, "logs", `aggregation temporality validation failed: unsupported aggregation temporality "unknown"`),
+				fullErrorForSignal(t, "profiles", `aggregation temporality validation failed: unsupported aggregation temporality "unknown"`),
+			},
+		},
+		{
+			path:      "invalid_metrics_expiration",
+			errorMsgs: []string{"invalid metrics_expiration: -1m0s, the duration should be positive"},
+		},
+		{
+			path:      "invalid_unbounded_cumulative_state",
+			errorMsgs: []string{"cumulative metrics require a metrics_expiration or an aggregation_cardinality_limit to bound their state"},
+		},
+		{
+			path:      "invalid_aggregation_cardinality_limit",
+			errorMsgs: []string{"invalid aggregation_cardinality_limit: -1, the limit should be positive"},
+		},
 		{
 			path: "valid_full",
 			expected: &Config{
+				MetricsExpiration:           5 * time.Minute,
+				AggregationCardinalityLimit: 1000,
 				Spans: []MetricInfo{
 					{
 						Name:                      "span.exp_histogram",
@@ -183,6 +225,7 @@ func TestConfig(t *testing.T) {
 						Sum: &Sum{
 							Value: `attributes["some.optional.1"]`,
 						},
+						AggregationTemporality: AggregationTemporalityCumulative,
 					},
 				},
 				Logs: []MetricInfo{
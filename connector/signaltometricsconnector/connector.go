@@ -34,6 +34,9 @@ type signalToMetrics struct {
 	logMetricDefs     []model.MetricDef[ottllog.TransformContext]
 	profileMetricDefs []model.MetricDef[ottlprofile.TransformContext]
 
+	cardinalityLimit int
+	cumulative       *aggregator.Cumulative
+
 	component.StartFunc
 	component.ShutdownFunc
 }
@@ -49,7 +52,7 @@ func (sm *signalToMetrics) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 
 	processedMetrics := pmetric.NewMetrics()
 	processedMetrics.ResourceMetrics().EnsureCapacity(td.ResourceSpans().Len())
-	aggregator := aggregator.NewAggregator[ottlspan.TransformContext](processedMetrics)
+	aggregator := aggregator.NewAggregator[ottlspan.TransformContext](processedMetrics, sm.cardinalityLimit, sm.cumulative)
 
 	for i := 0; i < td.ResourceSpans().Len(); i++ {
 		resourceSpan := td.ResourceSpans().At(i)
@@ -87,7 +90,9 @@ func (sm *signalToMetrics) ConsumeTraces(ctx context.Context, td ptrace.Traces)
 			}
 		}
 	}
-	aggregator.Finalize(sm.spanMetricDefs)
+	if err := aggregator.Finalize(sm.spanMetricDefs); err != nil {
+		return err
+	}
 	return sm.next.ConsumeMetrics(ctx, processedMetrics)
 }
 
@@ -98,7 +103,7 @@ func (sm *signalToMetrics) ConsumeMetrics(ctx context.Context, m pmetric.Metrics
 
 	processedMetrics := pmetric.NewMetrics()
 	processedMetrics.ResourceMetrics().EnsureCapacity(m.ResourceMetrics().Len())
-	aggregator := aggregator.NewAggregator[ottldatapoint.TransformContext](processedMetrics)
+	aggregator := aggregator.NewAggregator[ottldatapoint.TransformContext](processedMetrics, sm.cardinalityLimit, sm.cumulative)
 	for i := 0; i < m.ResourceMetrics().Len(); i++ {
 		resourceMetric := m.ResourceMetrics().At(i)
 		resourceAttrs := resourceMetric.Resource().Attributes()
@@ -195,7 +200,9 @@ func (sm *signalToMetrics) ConsumeMetrics(ctx context.Context, m pmetric.Metrics
 			}
 		}
 	}
-	aggregator.Finalize(sm.dpMetricDefs)
+	if err := aggregator.Finalize(sm.dpMetricDefs); err != nil {
+		return err
+	}
 	return sm.next.ConsumeMetrics(ctx, processedMetrics)
 }
 
@@ -206,7 +213,7 @@ func (sm *signalToMetrics) ConsumeLogs(ctx context.Context, logs plog.Logs) erro
 
 	processedMetrics := pmetric.NewMetrics()
 	processedMetrics.ResourceMetrics().EnsureCapacity(logs.ResourceLogs().Len())
-	aggregator := aggregator.NewAggregator[ottllog.TransformContext](processedMetrics)
+	aggregator := aggregator.NewAggregator[ottllog.TransformContext](processedMetrics, sm.cardinalityLimit, sm.cumulative)
 	for i := 0; i < logs.ResourceLogs().Len(); i++ {
 		resourceLog := logs.ResourceLogs().At(i)
 		resourceAttrs := resourceLog.Resource().Attributes()
@@ -242,7 +249,9 @@ func (sm *signalToMetrics) ConsumeLogs(ctx context.Context, logs plog.Logs) erro
 			}
 		}
 	}
-	aggregator.Finalize(sm.logMetricDefs)
+	if err := aggregator.Finalize(sm.logMetricDefs); err != nil {
+		return err
+	}
 	return sm.next.ConsumeMetrics(ctx, processedMetrics)
 }
 
@@ -253,7 +262,7 @@ func (sm *signalToMetrics) ConsumeProfiles(ctx context.Context, profiles pprofil
 
 	processedMetrics := pmetric.NewMetrics()
 	processedMetrics.ResourceMetrics().EnsureCapacity(profiles.ResourceProfiles().Len())
-	aggregator := aggregator.NewAggregator[ottlprofile.TransformContext](processedMetrics)
+	aggregator := aggregator.NewAggregator[ottlprofile.TransformContext](processedMetrics, sm.cardinalityLimit, sm.cumulative)
 
 	for i := 0; i < profiles.ResourceProfiles().Len(); i++ {
 		resourceProfile := profiles.ResourceProfiles().At(i)
@@ -293,6 +302,8 @@ func (sm *signalToMetrics) ConsumeProfiles(ctx context.Context, profiles pprofil
 			}
 		}
 	}
-	aggregator.Finalize(sm.profileMetricDefs)
+	if err := aggregator.Finalize(sm.profileMetricDefs); err != nil {
+		return err
+	}
 	return sm.next.ConsumeMetrics(ctx, processedMetrics)
 }
@@ -40,6 +40,8 @@ func TestConnectorWithTraces(t *testing.T) {
 		"exponential_histograms",
 		"metric_identity",
 		"gauge",
+		"summary",
+		"distinct_count",
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -168,6 +170,115 @@ func TestConnectorWithProfiles(t *testing.T) {
 	}
 }
 
+func TestConnectorCumulativeTemporality(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	inputTraces, err := golden.ReadTraces(filepath.Join(testDataDir, "traces", "traces.yaml"))
+	require.NoError(t, err)
+
+	next := &consumertest.MetricsSink{}
+	factory := NewFactory()
+	settings := connectortest.NewNopSettings(metadata.Type)
+	cfg := &config.Config{
+		AggregationCardinalityLimit: 1,
+		Spans: []config.MetricInfo{
+			{
+				Name:                   "span.count",
+				AggregationTemporality: config.AggregationTemporalityCumulative,
+				Sum:                    &config.Sum{Value: "1"},
+			},
+			{
+				Name:                   "span.count.by_name",
+				AggregationTemporality: config.AggregationTemporalityCumulative,
+				Attributes:             []config.Attribute{{Key: "db.system", Optional: true}},
+				Sum:                    &config.Sum{Value: "1"},
+			},
+			{
+				Name:                   "span.duration.summary",
+				AggregationTemporality: config.AggregationTemporalityCumulative,
+				Summary: &config.Summary{
+					Quantiles:        []float64{0.5},
+					RelativeAccuracy: 0.01,
+					Value:            "Milliseconds(end_time - start_time)",
+				},
+			},
+			{
+				Name:                   "span.name.distinct_count",
+				AggregationTemporality: config.AggregationTemporalityCumulative,
+				DistinctCount:          &config.DistinctCount{Precision: 14, Value: "name"},
+			},
+			{
+				Name:                   "span.duration",
+				AggregationTemporality: config.AggregationTemporalityCumulative,
+				Histogram: &config.Histogram{
+					Buckets: []float64{1, 10, 100},
+					Value:   "Milliseconds(end_time - start_time)",
+				},
+			},
+		},
+	}
+	require.NoError(t, xconfmap.Validate(cfg))
+	connector, err := factory.(xconnector.Factory).CreateTracesToMetrics(ctx, settings, cfg, next)
+	require.NoError(t, err)
+
+	require.NoError(t, connector.ConsumeTraces(ctx, inputTraces))
+	require.NoError(t, connector.ConsumeTraces(ctx, inputTraces))
+	require.Len(t, next.AllMetrics(), 2)
+
+	first := metricsByName(next.AllMetrics()[0])
+	second := metricsByName(next.AllMetrics()[1])
+	for _, name := range []string{"span.count", "span.count.by_name"} {
+		require.Contains(t, second, name)
+		firstSum, secondSum := first[name].Sum(), second[name].Sum()
+		assert.Equal(t, pmetric.AggregationTemporalityCumulative, secondSum.AggregationTemporality())
+		// The cardinality limit of 1 aggregates the spans with and without
+		// db.system in the overflow stream.
+		require.LessOrEqual(t, secondSum.DataPoints().Len(), 2)
+		var firstTotal, secondTotal int64
+		for i := 0; i < firstSum.DataPoints().Len(); i++ {
+			dp := firstSum.DataPoints().At(i)
+			firstTotal += dp.IntValue()
+			// the first datapoint of a stream starts when the stream was first observed
+			assert.NotZero(t, dp.StartTimestamp())
+			assert.Less(t, dp.StartTimestamp(), dp.Timestamp())
+		}
+		for i := 0; i < secondSum.DataPoints().Len(); i++ {
+			dp := secondSum.DataPoints().At(i)
+			secondTotal += dp.IntValue()
+			assert.NotZero(t, dp.StartTimestamp())
+			assert.LessOrEqual(t, dp.StartTimestamp(), dp.Timestamp())
+		}
+		assert.Equal(t, 2*firstTotal, secondTotal)
+	}
+	overflow := false
+	dps := second["span.count.by_name"].Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		v, ok := dps.At(i).Attributes().Get("otel.metric.overflow")
+		overflow = overflow || (ok && v.Bool())
+	}
+	assert.True(t, overflow)
+
+	firstHist := first["span.duration"].Histogram().DataPoints().At(0)
+	secondHist := second["span.duration"].Histogram().DataPoints().At(0)
+	assert.Equal(t, pmetric.AggregationTemporalityCumulative, second["span.duration"].Histogram().AggregationTemporality())
+	assert.Equal(t, 2*firstHist.Count(), secondHist.Count())
+	assert.InDelta(t, 2*firstHist.Sum(), secondHist.Sum(), 1e-9)
+	assert.Equal(t, firstHist.StartTimestamp(), secondHist.StartTimestamp())
+
+	firstSummary := first["span.duration.summary"].Summary().DataPoints().At(0)
+	secondSummary := second["span.duration.summary"].Summary().DataPoints().At(0)
+	assert.Equal(t, 2*firstSummary.Count(), secondSummary.Count())
+	assert.Equal(t, firstSummary.QuantileValues().At(0).Value(), secondSummary.QuantileValues().At(0).Value())
+	assert.Equal(t, firstSummary.StartTimestamp(), secondSummary.StartTimestamp())
+
+	// The same span names are consumed twice, so the distinct count doesn't change.
+	firstDistinct := first["span.name.distinct_count"].Gauge().DataPoints().At(0)
+	secondDistinct := second["span.name.distinct_count"].Gauge().DataPoints().At(0)
+	assert.Equal(t, int64(7), firstDistinct.IntValue())
+	assert.Equal(t, firstDistinct.IntValue(), secondDistinct.IntValue())
+}
+
 func BenchmarkConnectorWithTraces(b *testing.B) {
 	factory := NewFactory()
 	settings := connectortest.NewNopSettings(metadata.Type)
@@ -362,3 +473,17 @@ func assertAggregatedMetrics(t *testing.T, expected, actual pmetric.Metrics) {
 		pmetrictest.IgnoreTimestamp(),
 	))
 }
+
+func metricsByName(md pmetric.Metrics) map[string]pmetric.Metric {
+	metrics := make(map[string]pmetric.Metric)
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		sms := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < sms.Len(); j++ {
+			ms := sms.At(j).Metrics()
+			for k := 0; k < ms.Len(); k++ {
+				metrics[ms.At(k).Name()] = ms.At(k)
+			}
+		}
+	}
+	return metrics
+}
@@ -13,6 +13,7 @@ import (
 	"go.opentelemetry.io/collector/consumer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/config"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/aggregator"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/customottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/model"
@@ -35,7 +36,7 @@ func NewFactory() connector.Factory {
 }
 
 func createDefaultConfig() component.Config {
-	return &config.Config{}
+	return &config.Config{MetricsExpiration: config.DefaultMetricsExpiration}
 }
 
 func createTracesToMetrics(
@@ -64,8 +65,10 @@ func createTracesToMetrics(
 		collectorInstanceInfo: model.NewCollectorInstanceInfo(
 			set.TelemetrySettings,
 		),
-		next:           nextConsumer,
-		spanMetricDefs: metricDefs,
+		next:             nextConsumer,
+		spanMetricDefs:   metricDefs,
+		cardinalityLimit: c.AggregationCardinalityLimit,
+		cumulative:       aggregator.NewCumulative(c.MetricsExpiration, c.AggregationCardinalityLimit),
 	}, nil
 }
 
@@ -95,8 +98,10 @@ func createMetricsToMetrics(
 		collectorInstanceInfo: model.NewCollectorInstanceInfo(
 			set.TelemetrySettings,
 		),
-		next:         nextConsumer,
-		dpMetricDefs: metricDefs,
+		next:             nextConsumer,
+		dpMetricDefs:     metricDefs,
+		cardinalityLimit: c.AggregationCardinalityLimit,
+		cumulative:       aggregator.NewCumulative(c.MetricsExpiration, c.AggregationCardinalityLimit),
 	}, nil
 }
 
@@ -126,8 +131,10 @@ func createLogsToMetrics(
 		collectorInstanceInfo: model.NewCollectorInstanceInfo(
 			set.TelemetrySettings,
 		),
-		next:          nextConsumer,
-		logMetricDefs: metricDefs,
+		next:             nextConsumer,
+		logMetricDefs:    metricDefs,
+		cardinalityLimit: c.AggregationCardinalityLimit,
+		cumulative:       aggregator.NewCumulative(c.MetricsExpiration, c.AggregationCardinalityLimit),
 	}, nil
 }
 
@@ -159,5 +166,7 @@ func createProfilesToMetrics(
 		),
 		next:              nextConsumer,
 		profileMetricDefs: metricDefs,
+		cardinalityLimit:  c.AggregationCardinalityLimit,
+		cumulative:        aggregator.NewCumulative(c.MetricsExpiration, c.AggregationCardinalityLimit),
 	}, nil
 }
@@ -5,7 +5,9 @@ package aggregator // import "github.com/open-telemetry/opentelemetry-collector-
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -29,18 +31,37 @@ type Aggregator[K any] struct {
 	valueCounts map[model.MetricKey]map[[16]byte]map[[16]byte]*valueCountDP
 	sums        map[model.MetricKey]map[[16]byte]map[[16]byte]*sumDP
 	gauges      map[model.MetricKey]map[[16]byte]map[[16]byte]*gaugeDP
-	timestamp   time.Time
+	summaries   map[model.MetricKey]map[[16]byte]map[[16]byte]*summaryDP
+	// distinctCounts is keyed by the gauge metric key, as the distinct
+	// counts are produced as gauges.
+	distinctCounts map[model.MetricKey]map[[16]byte]map[[16]byte]*distinctCountDP
+	timestamp      time.Time
+	// cardinalityLimit limits the number of datapoints aggregated for a
+	// metric and a resource, 0 means no limit.
+	cardinalityLimit int
+	// cumulative accumulates the datapoints of the metrics with cumulative
+	// temporality across aggregator instances.
+	cumulative *Cumulative
 }
 
-// NewAggregator creates a new instance of aggregator.
-func NewAggregator[K any](metrics pmetric.Metrics) *Aggregator[K] {
+// NewAggregator creates a new instance of aggregator. The cumulative state
+// is required only if any of the metrics use cumulative temporality.
+func NewAggregator[K any](
+	metrics pmetric.Metrics,
+	cardinalityLimit int,
+	cumulative *Cumulative,
+) *Aggregator[K] {
 	return &Aggregator[K]{
-		result:      metrics,
-		smLookup:    make(map[[16]byte]pmetric.ScopeMetrics),
-		valueCounts: make(map[model.MetricKey]map[[16]byte]map[[16]byte]*valueCountDP),
-		sums:        make(map[model.MetricKey]map[[16]byte]map[[16]byte]*sumDP),
-		gauges:      make(map[model.MetricKey]map[[16]byte]map[[16]byte]*gaugeDP),
-		timestamp:   time.Now(),
+		result:           metrics,
+		cardinalityLimit: cardinalityLimit,
+		cumulative:       cumulative,
+		smLookup:         make(map[[16]byte]pmetric.ScopeMetrics),
+		valueCounts:      make(map[model.MetricKey]map[[16]byte]map[[16]byte]*valueCountDP),
+		sums:             make(map[model.MetricKey]map[[16]byte]map[[16]byte]*sumDP),
+		gauges:           make(map[model.MetricKey]map[[16]byte]map[[16]byte]*gaugeDP),
+		summaries:        make(map[model.MetricKey]map[[16]byte]map[[16]byte]*summaryDP),
+		distinctCounts:   make(map[model.MetricKey]map[[16]byte]map[[16]byte]*distinctCountDP),
+		timestamp:        time.Now(),
 	}
 }
 
@@ -90,7 +111,29 @@ func (a *Aggregator[K]) Aggregate(
 				v, v,
 			)
 		}
+	case pmetric.MetricTypeSummary:
+		val, count, err := getValueCount(
+			ctx, tCtx,
+			md.Summary.Value,
+			md.Summary.Count,
+			defaultCount,
+		)
+		if err != nil {
+			return err
+		}
+		return a.aggregateSummary(md, resAttrs, srcAttrs, val, count)
 	case pmetric.MetricTypeGauge:
+		if md.DistinctCount != nil {
+			raw, err := md.DistinctCount.Value.Eval(ctx, tCtx)
+			if err != nil {
+				return fmt.Errorf("failed to execute OTTL value for distinct count: %w", err)
+			}
+			v, ok := distinctValue(raw)
+			if !ok {
+				return nil
+			}
+			return a.aggregateDistinctCount(md, resAttrs, srcAttrs, v)
+		}
 		raw, err := md.Gauge.Value.Eval(ctx, tCtx)
 		if err != nil {
 			if strings.Contains(err.Error(), "key not found in map") {
@@ -118,8 +161,18 @@ func (a *Aggregator[K]) Aggregate(
 // Finalize finalizes the aggregations performed by the aggregator so far into
 // the pmetric.Metrics used to create this instance of the aggregator. Finalize
 // should be called once per aggregator instance and the aggregator instance
-// should not be used after Finalize is called.
-func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) {
+// should not be used after Finalize is called. The errors merging the
+// datapoints into the cumulative state are returned after all the metrics
+// are finalized.
+func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) error {
+	var errs error
+	// The cumulative streams start when they are first observed, at the
+	// creation of the aggregator, and are reported as of now, so that their
+	// first datapoint covers the time it was aggregated over.
+	now := time.Now()
+	if a.cumulative != nil {
+		a.cumulative.Expire(now)
+	}
 	for _, md := range mds {
 		for resID, dpMap := range a.valueCounts[md.Key] {
 			metrics := a.smLookup[resID].Metrics()
@@ -134,7 +187,7 @@ func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) {
 				destMetric.SetUnit(md.Key.Unit)
 				destMetric.SetDescription(md.Key.Description)
 				destExpHist = destMetric.SetEmptyExponentialHistogram()
-				destExpHist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				destExpHist.SetAggregationTemporality(md.Temporality)
 				destExpHist.DataPoints().EnsureCapacity(len(dpMap))
 			case pmetric.MetricTypeHistogram:
 				destMetric := metrics.AppendEmpty()
@@ -142,11 +195,20 @@ func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) {
 				destMetric.SetUnit(md.Key.Unit)
 				destMetric.SetDescription(md.Key.Description)
 				destExplicitHist = destMetric.SetEmptyHistogram()
-				destExplicitHist.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+				destExplicitHist.SetAggregationTemporality(md.Temporality)
 				destExplicitHist.DataPoints().EnsureCapacity(len(dpMap))
 			}
+			if md.Temporality == pmetric.AggregationTemporalityCumulative && a.cumulative != nil {
+				if err := a.cumulative.mergeValueCounts(md.Key, resID, dpMap, a.timestamp, func(dp *valueCountDP, startTime time.Time) {
+					dp.Copy(startTime, now, destExpHist, destExplicitHist)
+				}); err != nil {
+					errs = errors.Join(errs, err)
+				}
+				continue
+			}
 			for _, dp := range dpMap {
 				dp.Copy(
+					time.Time{},
 					a.timestamp,
 					destExpHist,
 					destExplicitHist,
@@ -163,10 +225,18 @@ func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) {
 			destMetric.SetUnit(md.Key.Unit)
 			destMetric.SetDescription(md.Key.Description)
 			destCounter := destMetric.SetEmptySum()
-			destCounter.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+			destCounter.SetAggregationTemporality(md.Temporality)
 			destCounter.DataPoints().EnsureCapacity(len(dpMap))
+			if md.Temporality == pmetric.AggregationTemporalityCumulative && a.cumulative != nil {
+				if err := a.cumulative.mergeSums(md.Key, resID, dpMap, a.timestamp, func(dp *sumDP, startTime time.Time) {
+					dp.Copy(startTime, now, destCounter.DataPoints().AppendEmpty())
+				}); err != nil {
+					errs = errors.Join(errs, err)
+				}
+				continue
+			}
 			for _, dp := range dpMap {
-				dp.Copy(a.timestamp, destCounter.DataPoints().AppendEmpty())
+				dp.Copy(time.Time{}, a.timestamp, destCounter.DataPoints().AppendEmpty())
 			}
 		}
 		for resID, dpMap := range a.gauges[md.Key] {
@@ -184,13 +254,62 @@ func (a *Aggregator[K]) Finalize(mds []model.MetricDef[K]) {
 				dp.Copy(a.timestamp, destGauge.DataPoints().AppendEmpty())
 			}
 		}
+		for resID, dpMap := range a.summaries[md.Key] {
+			if md.Summary == nil {
+				continue
+			}
+			metrics := a.smLookup[resID].Metrics()
+			destMetric := metrics.AppendEmpty()
+			destMetric.SetName(md.Key.Name)
+			destMetric.SetUnit(md.Key.Unit)
+			destMetric.SetDescription(md.Key.Description)
+			destSummary := destMetric.SetEmptySummary()
+			destSummary.DataPoints().EnsureCapacity(len(dpMap))
+			if md.Temporality == pmetric.AggregationTemporalityCumulative && a.cumulative != nil {
+				if err := a.cumulative.mergeSummaries(md.Key, resID, dpMap, a.timestamp, func(dp *summaryDP, startTime time.Time) {
+					dp.Copy(startTime, now, destSummary.DataPoints().AppendEmpty())
+				}); err != nil {
+					errs = errors.Join(errs, err)
+				}
+				continue
+			}
+			for _, dp := range dpMap {
+				dp.Copy(time.Time{}, a.timestamp, destSummary.DataPoints().AppendEmpty())
+			}
+		}
+		for resID, dpMap := range a.distinctCounts[md.Key] {
+			if md.DistinctCount == nil {
+				continue
+			}
+			metrics := a.smLookup[resID].Metrics()
+			destMetric := metrics.AppendEmpty()
+			destMetric.SetName(md.Key.Name)
+			destMetric.SetUnit(md.Key.Unit)
+			destMetric.SetDescription(md.Key.Description)
+			destGauge := destMetric.SetEmptyGauge()
+			destGauge.DataPoints().EnsureCapacity(len(dpMap))
+			if md.Temporality == pmetric.AggregationTemporalityCumulative && a.cumulative != nil {
+				if err := a.cumulative.mergeDistinctCounts(md.Key, resID, dpMap, a.timestamp, func(dp *distinctCountDP, startTime time.Time) {
+					dp.Copy(startTime, now, destGauge.DataPoints().AppendEmpty())
+				}); err != nil {
+					errs = errors.Join(errs, err)
+				}
+				continue
+			}
+			for _, dp := range dpMap {
+				dp.Copy(time.Time{}, a.timestamp, destGauge.DataPoints().AppendEmpty())
+			}
+		}
 		// If there are two metric defined with the same key required by metricKey
 		// then they will be aggregated within the same metric and produced
 		// together. Deleting the key ensures this while preventing duplicates.
 		delete(a.valueCounts, md.Key)
 		delete(a.sums, md.Key)
 		delete(a.gauges, md.Key)
+		delete(a.summaries, md.Key)
+		delete(a.distinctCounts, md.Key)
 	}
+	return errs
 }
 
 func (a *Aggregator[K]) aggregateInt(
@@ -206,6 +325,7 @@ func (a *Aggregator[K]) aggregateInt(
 	if _, ok := a.sums[md.Key][resID]; !ok {
 		a.sums[md.Key][resID] = make(map[[16]byte]*sumDP)
 	}
+	srcAttrs, attrID = limitCardinality(a.sums[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
 	if _, ok := a.sums[md.Key][resID][attrID]; !ok {
 		a.sums[md.Key][resID][attrID] = newSumDP(srcAttrs, false)
 	}
@@ -226,6 +346,7 @@ func (a *Aggregator[K]) aggregateDouble(
 	if _, ok := a.sums[md.Key][resID]; !ok {
 		a.sums[md.Key][resID] = make(map[[16]byte]*sumDP)
 	}
+	srcAttrs, attrID = limitCardinality(a.sums[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
 	if _, ok := a.sums[md.Key][resID][attrID]; !ok {
 		a.sums[md.Key][resID][attrID] = newSumDP(srcAttrs, true)
 	}
@@ -246,6 +367,7 @@ func (a *Aggregator[K]) aggregateGauge(
 	if _, ok := a.gauges[md.Key][resID]; !ok {
 		a.gauges[md.Key][resID] = make(map[[16]byte]*gaugeDP)
 	}
+	srcAttrs, attrID = limitCardinality(a.gauges[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
 	if _, ok := a.gauges[md.Key][resID][attrID]; !ok {
 		a.gauges[md.Key][resID][attrID] = newGaugeDP(srcAttrs)
 	}
@@ -270,6 +392,7 @@ func (a *Aggregator[K]) aggregateValueCount(
 	if _, ok := a.valueCounts[md.Key][resID]; !ok {
 		a.valueCounts[md.Key][resID] = make(map[[16]byte]*valueCountDP)
 	}
+	srcAttrs, attrID = limitCardinality(a.valueCounts[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
 	if _, ok := a.valueCounts[md.Key][resID][attrID]; !ok {
 		a.valueCounts[md.Key][resID][attrID] = newValueCountDP(md, srcAttrs)
 	}
@@ -277,6 +400,59 @@ func (a *Aggregator[K]) aggregateValueCount(
 	return nil
 }
 
+func (a *Aggregator[K]) aggregateSummary(
+	md model.MetricDef[K],
+	resAttrs, srcAttrs pcommon.Map,
+	value float64, count int64,
+) error {
+	if count == 0 {
+		// Nothing to record as count is zero
+		return nil
+	}
+	resID := a.getResourceID(resAttrs)
+	attrID := pdatautil.MapHash(srcAttrs)
+	if _, ok := a.summaries[md.Key]; !ok {
+		a.summaries[md.Key] = make(map[[16]byte]map[[16]byte]*summaryDP)
+	}
+	if _, ok := a.summaries[md.Key][resID]; !ok {
+		a.summaries[md.Key][resID] = make(map[[16]byte]*summaryDP)
+	}
+	srcAttrs, attrID = limitCardinality(a.summaries[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
+	if _, ok := a.summaries[md.Key][resID][attrID]; !ok {
+		dp, err := newSummaryDP(srcAttrs, md.Summary.Quantiles, md.Summary.RelativeAccuracy)
+		if err != nil {
+			return err
+		}
+		a.summaries[md.Key][resID][attrID] = dp
+	}
+	return a.summaries[md.Key][resID][attrID].Aggregate(value, count)
+}
+
+func (a *Aggregator[K]) aggregateDistinctCount(
+	md model.MetricDef[K],
+	resAttrs, srcAttrs pcommon.Map,
+	v []byte,
+) error {
+	resID := a.getResourceID(resAttrs)
+	attrID := pdatautil.MapHash(srcAttrs)
+	if _, ok := a.distinctCounts[md.Key]; !ok {
+		a.distinctCounts[md.Key] = make(map[[16]byte]map[[16]byte]*distinctCountDP)
+	}
+	if _, ok := a.distinctCounts[md.Key][resID]; !ok {
+		a.distinctCounts[md.Key][resID] = make(map[[16]byte]*distinctCountDP)
+	}
+	srcAttrs, attrID = limitCardinality(a.distinctCounts[md.Key][resID], a.cardinalityLimit, srcAttrs, attrID)
+	if _, ok := a.distinctCounts[md.Key][resID][attrID]; !ok {
+		dp, err := newDistinctCountDP(srcAttrs, md.DistinctCount.Precision)
+		if err != nil {
+			return err
+		}
+		a.distinctCounts[md.Key][resID][attrID] = dp
+	}
+	a.distinctCounts[md.Key][resID][attrID].Aggregate(v)
+	return nil
+}
+
 func (a *Aggregator[K]) getResourceID(resourceAttrs pcommon.Map) [16]byte {
 	resID := pdatautil.MapHash(resourceAttrs)
 	if _, ok := a.smLookup[resID]; !ok {
@@ -363,3 +539,43 @@ func getDoubleFromOTTL[K any](
 		)
 	}
 }
+
+// distinctValue returns the bytes identifying a value evaluated by OTTL for
+// a distinct count. The bytes start with the type of the value, so that e.g.
+// the int 1 and the string "1" are counted as distinct values. Nil values are
+// not counted.
+func distinctValue(raw any) ([]byte, bool) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return append([]byte{'s'}, v...), true
+	case []byte:
+		return append([]byte{'b'}, v...), true
+	case int64:
+		return strconv.AppendInt([]byte{'i'}, v, 10), true
+	case float64:
+		return strconv.AppendFloat([]byte{'f'}, v, 'g', -1, 64), true
+	case bool:
+		return strconv.AppendBool([]byte{'t'}, v), true
+	case pcommon.Map:
+		hash := pdatautil.MapHash(v)
+		return append([]byte{'m'}, hash[:]...), true
+	case pcommon.Value:
+		switch v.Type() {
+		case pcommon.ValueTypeEmpty:
+			return nil, false
+		case pcommon.ValueTypeMap:
+			return distinctValue(v.Map())
+		case pcommon.ValueTypeBytes:
+			return distinctValue(v.Bytes().AsRaw())
+		case pcommon.ValueTypeSlice:
+			return append([]byte{'l'}, v.AsString()...), true
+		default:
+			// the scalar values are identified as their raw counterparts
+			return distinctValue(v.AsRaw())
+		}
+	default:
+		return append([]byte{'o'}, fmt.Sprint(v)...), true
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestDistinctValue(t *testing.T) {
+	values := []any{
+		"1",
+		[]byte("1"),
+		int64(1),
+		float64(1.5),
+		true,
+		"true",
+	}
+	seen := map[string]any{}
+	for _, v := range values {
+		key, ok := distinctValue(v)
+		require.True(t, ok)
+		assert.NotContains(t, seen, string(key), "%#v collides with %#v", v, seen[string(key)])
+		seen[string(key)] = v
+	}
+
+	// the values are identified as their raw counterparts
+	for _, tc := range []struct {
+		value pcommon.Value
+		raw   any
+	}{
+		{value: pcommon.NewValueStr("1"), raw: "1"},
+		{value: pcommon.NewValueInt(1), raw: int64(1)},
+		{value: pcommon.NewValueDouble(1.5), raw: float64(1.5)},
+		{value: pcommon.NewValueBool(true), raw: true},
+	} {
+		fromValue, ok := distinctValue(tc.value)
+		require.True(t, ok)
+		fromRaw, _ := distinctValue(tc.raw)
+		assert.Equal(t, fromRaw, fromValue)
+	}
+
+	_, ok := distinctValue(nil)
+	assert.False(t, ok)
+	_, ok = distinctValue(pcommon.NewValueEmpty())
+	assert.False(t, ok)
+}
+
+func TestMergeStreamsError(t *testing.T) {
+	attrs := pcommon.NewMap()
+	dp, err := newSummaryDP(attrs, []float64{0.5}, 0.01)
+	require.NoError(t, err)
+	require.NoError(t, dp.Aggregate(10, 1))
+	streams := map[[16]byte]*cumulativeStream[*summaryDP]{}
+	copied := 0
+	copyFn := func(*summaryDP, time.Time) {
+		copied++
+	}
+	require.NoError(t, mergeStreams(streams, map[[16]byte]*summaryDP{{}: dp}, 0, time.Now(), copyFn))
+
+	// the sketches with different index mappings can't be merged
+	other, err := newSummaryDP(attrs, []float64{0.5}, 0.05)
+	require.NoError(t, err)
+	require.NoError(t, other.Aggregate(20, 1))
+	err = mergeStreams(streams, map[[16]byte]*summaryDP{{}: other}, 0, time.Now(), copyFn)
+	assert.ErrorContains(t, err, "failed to merge summary")
+	// the stream keeps its previous value and is still reported
+	assert.Equal(t, 2, copied)
+	assert.Equal(t, float64(1), streams[[16]byte{}].dp.sketch.GetCount())
+}
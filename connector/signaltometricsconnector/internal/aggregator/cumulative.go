@@ -0,0 +1,245 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregator // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/aggregator"
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/model"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatautil"
+)
+
+// overflowKey is the attribute set on the datapoints aggregating the data
+// exceeding the cardinality limit.
+const overflowKey = "otel.metric.overflow"
+
+var overflowAttrID = pdatautil.MapHash(newOverflowAttrs())
+
+func newOverflowAttrs() pcommon.Map {
+	attrs := pcommon.NewMap()
+	attrs.PutBool(overflowKey, true)
+	return attrs
+}
+
+// limitCardinality returns the attributes and the attributes ID to use for
+// aggregating a datapoint in the datapoints of a metric and a resource. The
+// overflow attributes are returned if the datapoint would exceed the limit.
+func limitCardinality[V any](
+	dps map[[16]byte]V,
+	limit int,
+	attrs pcommon.Map,
+	attrID [16]byte,
+) (pcommon.Map, [16]byte) {
+	if limit <= 0 {
+		return attrs, attrID
+	}
+	if _, ok := dps[attrID]; ok || len(dps) < limit {
+		return attrs, attrID
+	}
+	return newOverflowAttrs(), overflowAttrID
+}
+
+// mergeableDP is a datapoint that can be accumulated across aggregations.
+type mergeableDP[DP any] interface {
+	Merge(other DP) error
+	setAttributes(attrs pcommon.Map)
+}
+
+// cumulativeStream is a cumulative datapoint with the timestamps required to
+// report it and to expire it.
+type cumulativeStream[DP mergeableDP[DP]] struct {
+	dp        DP
+	startTime time.Time
+	lastSeen  time.Time
+}
+
+// cumulativeState is the cumulative streams of a kind of datapoints keyed by
+// metric, resource and attributes.
+type cumulativeState[DP mergeableDP[DP]] map[model.MetricKey]map[[16]byte]map[[16]byte]*cumulativeStream[DP]
+
+// Cumulative keeps the datapoints of the metrics with cumulative temporality
+// across the aggregations of the consumed payloads. The datapoints are keyed
+// by metric, resource and attributes, as the datapoints of an aggregator.
+// Cumulative is safe for concurrent use by the aggregators.
+type Cumulative struct {
+	expiration       time.Duration
+	cardinalityLimit int
+
+	mu             sync.Mutex
+	valueCounts    cumulativeState[*valueCountDP]
+	sums           cumulativeState[*sumDP]
+	summaries      cumulativeState[*summaryDP]
+	distinctCounts cumulativeState[*distinctCountDP]
+	lastExpiry     time.Time
+}
+
+// NewCumulative creates a new instance of the cumulative state. The streams
+// that aren't updated for the expiration duration are dropped, unless the
+// expiration is 0. The cardinality limit applies to the number of streams
+// of a metric and a resource, unless the limit is 0.
+func NewCumulative(expiration time.Duration, cardinalityLimit int) *Cumulative {
+	return &Cumulative{
+		expiration:       expiration,
+		cardinalityLimit: cardinalityLimit,
+		valueCounts:      make(cumulativeState[*valueCountDP]),
+		sums:             make(cumulativeState[*sumDP]),
+		summaries:        make(cumulativeState[*summaryDP]),
+		distinctCounts:   make(cumulativeState[*distinctCountDP]),
+		lastExpiry:       time.Now(),
+	}
+}
+
+// mergeValueCounts merges the histogram datapoints of an aggregation, observed
+// at the given time, into the cumulative state and calls copyFn for each of the
+// updated streams.
+func (c *Cumulative) mergeValueCounts(
+	key model.MetricKey,
+	resID [16]byte,
+	dps map[[16]byte]*valueCountDP,
+	observed time.Time,
+	copyFn func(dp *valueCountDP, startTime time.Time),
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.valueCounts.merge(key, resID, dps, c.cardinalityLimit, observed, copyFn)
+}
+
+// mergeSums merges the sum datapoints of an aggregation, observed at the given
+// time, into the cumulative state and calls copyFn for each of the updated
+// streams.
+func (c *Cumulative) mergeSums(
+	key model.MetricKey,
+	resID [16]byte,
+	dps map[[16]byte]*sumDP,
+	observed time.Time,
+	copyFn func(dp *sumDP, startTime time.Time),
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sums.merge(key, resID, dps, c.cardinalityLimit, observed, copyFn)
+}
+
+// mergeSummaries merges the summary datapoints of an aggregation, observed at
+// the given time, into the cumulative state and calls copyFn for each of the
+// updated streams.
+func (c *Cumulative) mergeSummaries(
+	key model.MetricKey,
+	resID [16]byte,
+	dps map[[16]byte]*summaryDP,
+	observed time.Time,
+	copyFn func(dp *summaryDP, startTime time.Time),
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.summaries.merge(key, resID, dps, c.cardinalityLimit, observed, copyFn)
+}
+
+// mergeDistinctCounts merges the distinct count datapoints of an aggregation,
+// observed at the given time, into the cumulative state and calls copyFn for
+// each of the updated streams.
+func (c *Cumulative) mergeDistinctCounts(
+	key model.MetricKey,
+	resID [16]byte,
+	dps map[[16]byte]*distinctCountDP,
+	observed time.Time,
+	copyFn func(dp *distinctCountDP, startTime time.Time),
+) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.distinctCounts.merge(key, resID, dps, c.cardinalityLimit, observed, copyFn)
+}
+
+// Expire drops the streams that haven't been updated for the expiration
+// duration. The state is only scanned once per expiration duration, so the
+// streams are dropped at most twice the expiration duration after their
+// last update.
+func (c *Cumulative) Expire(now time.Time) {
+	if c.expiration <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now.Sub(c.lastExpiry) < c.expiration {
+		return
+	}
+	c.lastExpiry = now
+	c.valueCounts.expire(now, c.expiration)
+	c.sums.expire(now, c.expiration)
+	c.summaries.expire(now, c.expiration)
+	c.distinctCounts.expire(now, c.expiration)
+}
+
+func (s cumulativeState[DP]) merge(
+	key model.MetricKey,
+	resID [16]byte,
+	dps map[[16]byte]DP,
+	cardinalityLimit int,
+	observed time.Time,
+	copyFn func(dp DP, startTime time.Time),
+) error {
+	if _, ok := s[key]; !ok {
+		s[key] = make(map[[16]byte]map[[16]byte]*cumulativeStream[DP])
+	}
+	if _, ok := s[key][resID]; !ok {
+		s[key][resID] = make(map[[16]byte]*cumulativeStream[DP])
+	}
+	return mergeStreams(s[key][resID], dps, cardinalityLimit, observed, copyFn)
+}
+
+func (s cumulativeState[DP]) expire(now time.Time, expiration time.Duration) {
+	for key, resStreams := range s {
+		for resID, streams := range resStreams {
+			for attrID, stream := range streams {
+				if now.Sub(stream.lastSeen) >= expiration {
+					delete(streams, attrID)
+				}
+			}
+			if len(streams) == 0 {
+				delete(resStreams, resID)
+			}
+		}
+		if len(resStreams) == 0 {
+			delete(s, key)
+		}
+	}
+}
+
+func mergeStreams[DP mergeableDP[DP]](
+	streams map[[16]byte]*cumulativeStream[DP],
+	dps map[[16]byte]DP,
+	cardinalityLimit int,
+	observed time.Time,
+	copyFn func(dp DP, startTime time.Time),
+) error {
+	var errs error
+	updated := make(map[[16]byte]*cumulativeStream[DP], len(dps))
+	for attrID, dp := range dps {
+		if _, ok := streams[attrID]; !ok && cardinalityLimit > 0 && len(streams) >= cardinalityLimit {
+			attrID = overflowAttrID
+			dp.setAttributes(newOverflowAttrs())
+		}
+		stream, ok := streams[attrID]
+		if !ok {
+			// The aggregator doesn't use its datapoints after finalizing
+			// them, so the datapoint becomes the state of the stream.
+			stream = &cumulativeStream[DP]{dp: dp, startTime: observed}
+			streams[attrID] = stream
+		} else if err := stream.dp.Merge(dp); err != nil {
+			// the stream keeps its previous value
+			errs = errors.Join(errs, err)
+		}
+		stream.lastSeen = observed
+		updated[attrID] = stream
+	}
+	for _, stream := range updated {
+		copyFn(stream.dp, stream.startTime)
+	}
+	return errs
+}
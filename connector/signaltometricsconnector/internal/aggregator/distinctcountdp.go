@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregator // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/aggregator"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/axiomhq/hyperloglog"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// distinctCountDP estimates the number of distinct recorded values with a
+// HyperLogLog sketch.
+type distinctCountDP struct {
+	attrs  pcommon.Map
+	sketch *hyperloglog.Sketch
+}
+
+func newDistinctCountDP(attrs pcommon.Map, precision uint8) (*distinctCountDP, error) {
+	sketch, err := hyperloglog.NewSketch(precision, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sketch for distinct count: %w", err)
+	}
+	return &distinctCountDP{
+		attrs:  attrs,
+		sketch: sketch,
+	}, nil
+}
+
+func (dp *distinctCountDP) Aggregate(v []byte) {
+	dp.sketch.Insert(v)
+}
+
+// Merge adds the values of the other datapoint to the datapoint. Both the
+// datapoints are expected to be created from the same metric definition,
+// so the sketches always share the same precision.
+func (dp *distinctCountDP) Merge(other *distinctCountDP) error {
+	if err := dp.sketch.Merge(other.sketch); err != nil {
+		return fmt.Errorf("failed to merge distinct count: %w", err)
+	}
+	return nil
+}
+
+func (dp *distinctCountDP) setAttributes(attrs pcommon.Map) {
+	dp.attrs = attrs
+}
+
+func (dp *distinctCountDP) Copy(
+	startTimestamp, timestamp time.Time,
+	dest pmetric.NumberDataPoint,
+) {
+	dp.attrs.CopyTo(dest.Attributes())
+	dest.SetIntValue(int64(dp.sketch.Estimate()))
+	if !startTimestamp.IsZero() {
+		dest.SetStartTimestamp(pcommon.NewTimestampFromTime(startTimestamp))
+	}
+	dest.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+}
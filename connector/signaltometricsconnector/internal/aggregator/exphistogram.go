@@ -29,8 +29,13 @@ func (dp *exponentialHistogramDP) Aggregate(value float64, count int64) {
 	dp.data.UpdateByIncr(value, uint64(count))
 }
 
+// Merge adds the buckets of the other datapoint to the datapoint.
+func (dp *exponentialHistogramDP) Merge(other *exponentialHistogramDP) {
+	dp.data.MergeFrom(other.data)
+}
+
 func (dp *exponentialHistogramDP) Copy(
-	timestamp time.Time,
+	startTimestamp, timestamp time.Time,
 	dest pmetric.ExponentialHistogramDataPoint,
 ) {
 	dp.attrs.CopyTo(dest.Attributes())
@@ -42,7 +47,10 @@ func (dp *exponentialHistogramDP) Copy(
 		dest.SetMin(dp.data.Min())
 		dest.SetMax(dp.data.Max())
 	}
-	// TODO determine appropriate start time
+	// TODO determine appropriate start time for delta datapoints
+	if !startTimestamp.IsZero() {
+		dest.SetStartTimestamp(pcommon.NewTimestampFromTime(startTimestamp))
+	}
 	dest.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 
 	copyBucketRange(dp.data.Positive(), dest.Positive())
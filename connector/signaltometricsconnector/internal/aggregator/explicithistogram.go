@@ -47,8 +47,18 @@ func (dp *explicitHistogramDP) Aggregate(value float64, count int64) {
 	dp.counts[sort.SearchFloat64s(dp.bounds, value)] += uint64(count)
 }
 
+// Merge adds the buckets of the other datapoint to the datapoint. Both the
+// datapoints are expected to have the same bounds.
+func (dp *explicitHistogramDP) Merge(other *explicitHistogramDP) {
+	dp.sum += other.sum
+	dp.count += other.count
+	for i := range min(len(dp.counts), len(other.counts)) {
+		dp.counts[i] += other.counts[i]
+	}
+}
+
 func (dp *explicitHistogramDP) Copy(
-	timestamp time.Time,
+	startTimestamp, timestamp time.Time,
 	dest pmetric.HistogramDataPoint,
 ) {
 	dp.attrs.CopyTo(dest.Attributes())
@@ -56,6 +66,9 @@ func (dp *explicitHistogramDP) Copy(
 	dest.BucketCounts().FromRaw(dp.counts)
 	dest.SetCount(dp.count)
 	dest.SetSum(dp.sum)
-	// TODO determine appropriate start time
+	// TODO determine appropriate start time for delta datapoints
+	if !startTimestamp.IsZero() {
+		dest.SetStartTimestamp(pcommon.NewTimestampFromTime(startTimestamp))
+	}
 	dest.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 }
@@ -40,8 +40,28 @@ func (dp *sumDP) AggregateDouble(v float64) {
 	dp.dblVal += v
 }
 
+// Merge adds the value of the other datapoint to the datapoint. The value of
+// the other datapoint is converted to the value type of the datapoint.
+func (dp *sumDP) Merge(other *sumDP) error {
+	switch {
+	case dp.isDbl && other.isDbl:
+		dp.dblVal += other.dblVal
+	case dp.isDbl:
+		dp.dblVal += float64(other.intVal)
+	case other.isDbl:
+		dp.intVal += int64(other.dblVal)
+	default:
+		dp.intVal += other.intVal
+	}
+	return nil
+}
+
+func (dp *sumDP) setAttributes(attrs pcommon.Map) {
+	dp.attrs = attrs
+}
+
 func (dp *sumDP) Copy(
-	timestamp time.Time,
+	startTimestamp, timestamp time.Time,
 	dest pmetric.NumberDataPoint,
 ) {
 	dp.attrs.CopyTo(dest.Attributes())
@@ -50,6 +70,9 @@ func (dp *sumDP) Copy(
 	} else {
 		dest.SetIntValue(dp.intVal)
 	}
-	// TODO determine appropriate start time
+	// TODO determine appropriate start time for delta datapoints
+	if !startTimestamp.IsZero() {
+		dest.SetStartTimestamp(pcommon.NewTimestampFromTime(startTimestamp))
+	}
 	dest.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
 }
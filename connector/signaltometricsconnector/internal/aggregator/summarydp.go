@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package aggregator // import "github.com/open-telemetry/opentelemetry-collector-contrib/connector/signaltometricsconnector/internal/aggregator"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/DataDog/sketches-go/ddsketch"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+// summaryDP computes the quantiles of the recorded values with a DDSketch.
+// The sum and the count of the recorded values are exact.
+type summaryDP struct {
+	attrs pcommon.Map
+
+	quantiles []float64
+	sketch    *ddsketch.DDSketchWithExactSummaryStatistics
+}
+
+func newSummaryDP(
+	attrs pcommon.Map,
+	quantiles []float64,
+	relativeAccuracy float64,
+) (*summaryDP, error) {
+	sketch, err := ddsketch.NewDefaultDDSketchWithExactSummaryStatistics(relativeAccuracy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sketch for summary: %w", err)
+	}
+	return &summaryDP{
+		attrs:     attrs,
+		quantiles: quantiles,
+		sketch:    sketch,
+	}, nil
+}
+
+func (dp *summaryDP) Aggregate(value float64, count int64) error {
+	if err := dp.sketch.AddWithCount(value, float64(count)); err != nil {
+		return fmt.Errorf("failed to record value %v in summary: %w", value, err)
+	}
+	return nil
+}
+
+// Merge adds the values of the other datapoint to the datapoint. Both the
+// datapoints are expected to be created from the same metric definition,
+// so the sketches always share the same index mapping.
+func (dp *summaryDP) Merge(other *summaryDP) error {
+	if err := dp.sketch.MergeWith(other.sketch); err != nil {
+		return fmt.Errorf("failed to merge summary: %w", err)
+	}
+	return nil
+}
+
+func (dp *summaryDP) setAttributes(attrs pcommon.Map) {
+	dp.attrs = attrs
+}
+
+func (dp *summaryDP) Copy(
+	startTimestamp, timestamp time.Time,
+	dest pmetric.SummaryDataPoint,
+) {
+	dp.attrs.CopyTo(dest.Attributes())
+	dest.SetCount(uint64(dp.sketch.GetCount()))
+	dest.SetSum(dp.sketch.GetSum())
+	if values, err := dp.sketch.GetValuesAtQuantiles(dp.quantiles); err == nil {
+		dest.QuantileValues().EnsureCapacity(len(values))
+		for i, v := range values {
+			qv := dest.QuantileValues().AppendEmpty()
+			qv.SetQuantile(dp.quantiles[i])
+			qv.SetValue(v)
+		}
+	}
+	if !startTimestamp.IsZero() {
+		dest.SetStartTimestamp(pcommon.NewTimestampFromTime(startTimestamp))
+	}
+	dest.SetTimestamp(pcommon.NewTimestampFromTime(timestamp))
+}
@@ -45,15 +45,36 @@ func (dp *valueCountDP) Aggregate(value float64, count int64) {
 	}
 }
 
+// Merge adds the values of the other datapoint to the datapoint. Both the
+// datapoints are expected to be created from the same metric definition.
+func (dp *valueCountDP) Merge(other *valueCountDP) error {
+	if dp.expHistogramDP != nil && other.expHistogramDP != nil {
+		dp.expHistogramDP.Merge(other.expHistogramDP)
+	}
+	if dp.explicitHistogramDP != nil && other.explicitHistogramDP != nil {
+		dp.explicitHistogramDP.Merge(other.explicitHistogramDP)
+	}
+	return nil
+}
+
+func (dp *valueCountDP) setAttributes(attrs pcommon.Map) {
+	if dp.expHistogramDP != nil {
+		dp.expHistogramDP.attrs = attrs
+	}
+	if dp.explicitHistogramDP != nil {
+		dp.explicitHistogramDP.attrs = attrs
+	}
+}
+
 func (dp *valueCountDP) Copy(
-	timestamp time.Time,
+	startTimestamp, timestamp time.Time,
 	destExpHist pmetric.ExponentialHistogram,
 	destExplicitHist pmetric.Histogram,
 ) {
 	if dp.expHistogramDP != nil {
-		dp.expHistogramDP.Copy(timestamp, destExpHist.DataPoints().AppendEmpty())
+		dp.expHistogramDP.Copy(startTimestamp, timestamp, destExpHist.DataPoints().AppendEmpty())
 	}
 	if dp.explicitHistogramDP != nil {
-		dp.explicitHistogramDP.Copy(timestamp, destExplicitHist.DataPoints().AppendEmpty())
+		dp.explicitHistogramDP.Copy(startTimestamp, timestamp, destExplicitHist.DataPoints().AppendEmpty())
 	}
 }
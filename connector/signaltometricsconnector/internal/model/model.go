@@ -126,6 +126,59 @@ func (s *Gauge[K]) fromConfig(
 	return nil
 }
 
+type Summary[K any] struct {
+	Quantiles        []float64
+	RelativeAccuracy float64
+	Count            *ottl.ValueExpression[K]
+	Value            *ottl.ValueExpression[K]
+}
+
+func (s *Summary[K]) fromConfig(
+	mi *config.Summary,
+	parser ottl.Parser[K],
+) error {
+	if mi == nil {
+		return nil
+	}
+
+	var err error
+	s.Quantiles = mi.Quantiles
+	s.RelativeAccuracy = mi.RelativeAccuracy
+	if mi.Count != "" {
+		s.Count, err = parser.ParseValueExpression(mi.Count)
+		if err != nil {
+			return fmt.Errorf("failed to parse count OTTL expression for summary: %w", err)
+		}
+	}
+	s.Value, err = parser.ParseValueExpression(mi.Value)
+	if err != nil {
+		return fmt.Errorf("failed to parse value OTTL expression for summary: %w", err)
+	}
+	return nil
+}
+
+type DistinctCount[K any] struct {
+	Precision uint8
+	Value     *ottl.ValueExpression[K]
+}
+
+func (d *DistinctCount[K]) fromConfig(
+	mi *config.DistinctCount,
+	parser ottl.Parser[K],
+) error {
+	if mi == nil {
+		return nil
+	}
+
+	var err error
+	d.Precision = mi.Precision
+	d.Value, err = parser.ParseValueExpression(mi.Value)
+	if err != nil {
+		return fmt.Errorf("failed to parse value OTTL expression for distinct count: %w", err)
+	}
+	return nil
+}
+
 type MetricDef[K any] struct {
 	Key                       MetricKey
 	IncludeResourceAttributes []AttributeKeyValue
@@ -135,6 +188,13 @@ type MetricDef[K any] struct {
 	ExplicitHistogram         *ExplicitHistogram[K]
 	Sum                       *Sum[K]
 	Gauge                     *Gauge[K]
+	Summary                   *Summary[K]
+	DistinctCount             *DistinctCount[K]
+	// Temporality is the aggregation temporality of the metric, it is
+	// unspecified for the gauge metrics. The summary and distinct count
+	// metrics don't report it but are accumulated across the aggregations
+	// with the cumulative temporality.
+	Temporality pmetric.AggregationTemporality
 }
 
 func (md *MetricDef[K]) FromMetricInfo(
@@ -195,6 +255,27 @@ func (md *MetricDef[K]) FromMetricInfo(
 			return fmt.Errorf("failed to parse gauge config: %w", err)
 		}
 	}
+	if mi.Summary != nil {
+		md.Key.Type = pmetric.MetricTypeSummary
+		md.Summary = new(Summary[K])
+		if err := md.Summary.fromConfig(mi.Summary, parser); err != nil {
+			return fmt.Errorf("failed to parse summary config: %w", err)
+		}
+	}
+	if mi.DistinctCount != nil {
+		// Distinct counts are not additive, so they are produced as gauges.
+		md.Key.Type = pmetric.MetricTypeGauge
+		md.DistinctCount = new(DistinctCount[K])
+		if err := md.DistinctCount.fromConfig(mi.DistinctCount, parser); err != nil {
+			return fmt.Errorf("failed to parse distinct count config: %w", err)
+		}
+	}
+	if md.Gauge == nil {
+		md.Temporality = pmetric.AggregationTemporalityDelta
+		if mi.AggregationTemporality == config.AggregationTemporalityCumulative {
+			md.Temporality = pmetric.AggregationTemporalityCumulative
+		}
+	}
 	return nil
 }
 
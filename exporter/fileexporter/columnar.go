@@ -0,0 +1,481 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"bytes"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	conventions "go.opentelemetry.io/otel/semconv/v1.27.0"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/traceutil"
+)
+
+// The columnar formats flatten telemetry to one row per log record, span or metric data point.
+// Resource and scope fields are repeated on every row, and attributes are stored as maps of
+// strings, non-string values being converted with pcommon.Value.AsString.
+
+var (
+	attributesType = arrow.MapOf(arrow.BinaryTypes.String, arrow.BinaryTypes.String)
+	timestampType  = arrow.FixedWidthTypes.Timestamp_ns
+)
+
+func resourceScopeFields() []arrow.Field {
+	return []arrow.Field{
+		{Name: "service_name", Type: arrow.BinaryTypes.String, Nullable: true},
+		{Name: "resource_attributes", Type: attributesType},
+		{Name: "resource_schema_url", Type: arrow.BinaryTypes.String},
+		{Name: "scope_name", Type: arrow.BinaryTypes.String},
+		{Name: "scope_version", Type: arrow.BinaryTypes.String},
+		{Name: "scope_attributes", Type: attributesType},
+	}
+}
+
+var logsSchema = arrow.NewSchema(append(resourceScopeFields(),
+	arrow.Field{Name: "timestamp", Type: timestampType},
+	arrow.Field{Name: "observed_timestamp", Type: timestampType},
+	arrow.Field{Name: "trace_id", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "span_id", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+	arrow.Field{Name: "severity_text", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "severity_number", Type: arrow.PrimitiveTypes.Int32},
+	arrow.Field{Name: "event_name", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "body", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "attributes", Type: attributesType},
+), nil)
+
+var (
+	spanEventType = arrow.StructOf(
+		arrow.Field{Name: "timestamp", Type: timestampType},
+		arrow.Field{Name: "name", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "attributes", Type: attributesType},
+	)
+	spanLinkType = arrow.StructOf(
+		arrow.Field{Name: "trace_id", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "span_id", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "trace_state", Type: arrow.BinaryTypes.String},
+		arrow.Field{Name: "attributes", Type: attributesType},
+	)
+)
+
+var tracesSchema = arrow.NewSchema(append(resourceScopeFields(),
+	arrow.Field{Name: "trace_id", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "span_id", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "parent_span_id", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "trace_state", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "name", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "kind", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "start_timestamp", Type: timestampType},
+	arrow.Field{Name: "end_timestamp", Type: timestampType},
+	arrow.Field{Name: "duration_ns", Type: arrow.PrimitiveTypes.Int64},
+	arrow.Field{Name: "status_code", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "status_message", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "attributes", Type: attributesType},
+	arrow.Field{Name: "events", Type: arrow.ListOf(spanEventType)},
+	arrow.Field{Name: "links", Type: arrow.ListOf(spanLinkType)},
+), nil)
+
+var quantileValueType = arrow.StructOf(
+	arrow.Field{Name: "quantile", Type: arrow.PrimitiveTypes.Float64},
+	arrow.Field{Name: "value", Type: arrow.PrimitiveTypes.Float64},
+)
+
+var metricsSchema = arrow.NewSchema(append(resourceScopeFields(),
+	arrow.Field{Name: "metric_name", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "metric_description", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "metric_unit", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "metric_type", Type: arrow.BinaryTypes.String},
+	arrow.Field{Name: "aggregation_temporality", Type: arrow.BinaryTypes.String, Nullable: true},
+	arrow.Field{Name: "is_monotonic", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	arrow.Field{Name: "start_timestamp", Type: timestampType},
+	arrow.Field{Name: "timestamp", Type: timestampType},
+	arrow.Field{Name: "attributes", Type: attributesType},
+	arrow.Field{Name: "flags", Type: arrow.PrimitiveTypes.Uint32},
+	arrow.Field{Name: "value_double", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	arrow.Field{Name: "value_int", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+	arrow.Field{Name: "count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	arrow.Field{Name: "sum", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	arrow.Field{Name: "min", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	arrow.Field{Name: "max", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	arrow.Field{Name: "bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	arrow.Field{Name: "explicit_bounds", Type: arrow.ListOf(arrow.PrimitiveTypes.Float64), Nullable: true},
+	arrow.Field{Name: "scale", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	arrow.Field{Name: "zero_count", Type: arrow.PrimitiveTypes.Uint64, Nullable: true},
+	arrow.Field{Name: "zero_threshold", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+	arrow.Field{Name: "positive_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	arrow.Field{Name: "positive_bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	arrow.Field{Name: "negative_offset", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	arrow.Field{Name: "negative_bucket_counts", Type: arrow.ListOf(arrow.PrimitiveTypes.Uint64), Nullable: true},
+	arrow.Field{Name: "quantile_values", Type: arrow.ListOf(quantileValueType), Nullable: true},
+), nil)
+
+// columnarMarshaler converts telemetry to a record of the flattened schema of its signal,
+// encoded as an Arrow IPC stream. The stream is decoded by the columnarWriteCloser, which
+// appends the record to the Parquet or Arrow IPC file.
+type columnarMarshaler struct{}
+
+var (
+	_ ptrace.Marshaler  = columnarMarshaler{}
+	_ pmetric.Marshaler = columnarMarshaler{}
+	_ plog.Marshaler    = columnarMarshaler{}
+)
+
+func (columnarMarshaler) MarshalTraces(td ptrace.Traces) ([]byte, error) {
+	b := newRowBuilder(tracesSchema)
+	defer b.release()
+
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		rs := td.ResourceSpans().At(i)
+		for j := 0; j < rs.ScopeSpans().Len(); j++ {
+			ss := rs.ScopeSpans().At(j)
+			for k := 0; k < ss.Spans().Len(); k++ {
+				span := ss.Spans().At(k)
+				b.startRow(rs.Resource(), rs.SchemaUrl(), ss.Scope())
+				b.str(traceutil.TraceIDToHexOrEmptyString(span.TraceID()))
+				b.str(traceutil.SpanIDToHexOrEmptyString(span.SpanID()))
+				b.str(traceutil.SpanIDToHexOrEmptyString(span.ParentSpanID()))
+				b.str(span.TraceState().AsRaw())
+				b.str(span.Name())
+				b.str(traceutil.SpanKindStr(span.Kind()))
+				b.timestamp(span.StartTimestamp())
+				b.timestamp(span.EndTimestamp())
+				b.int64(int64(span.EndTimestamp()) - int64(span.StartTimestamp()))
+				b.str(traceutil.StatusCodeStr(span.Status().Code()))
+				b.str(span.Status().Message())
+				b.attributes(span.Attributes())
+				b.spanEvents(span.Events())
+				b.spanLinks(span.Links())
+			}
+		}
+	}
+	return b.marshal()
+}
+
+func (columnarMarshaler) MarshalLogs(ld plog.Logs) ([]byte, error) {
+	b := newRowBuilder(logsSchema)
+	defer b.release()
+
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rl := ld.ResourceLogs().At(i)
+		for j := 0; j < rl.ScopeLogs().Len(); j++ {
+			sl := rl.ScopeLogs().At(j)
+			for k := 0; k < sl.LogRecords().Len(); k++ {
+				lr := sl.LogRecords().At(k)
+				b.startRow(rl.Resource(), rl.SchemaUrl(), sl.Scope())
+				b.timestamp(lr.Timestamp())
+				b.timestamp(lr.ObservedTimestamp())
+				b.str(traceutil.TraceIDToHexOrEmptyString(lr.TraceID()))
+				b.str(traceutil.SpanIDToHexOrEmptyString(lr.SpanID()))
+				b.uint32(uint32(lr.Flags()))
+				b.str(lr.SeverityText())
+				b.int32(int32(lr.SeverityNumber()))
+				b.str(lr.EventName())
+				b.str(lr.Body().AsString())
+				b.attributes(lr.Attributes())
+			}
+		}
+	}
+	return b.marshal()
+}
+
+func (columnarMarshaler) MarshalMetrics(md pmetric.Metrics) ([]byte, error) {
+	b := newRowBuilder(metricsSchema)
+	defer b.release()
+
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		rm := md.ResourceMetrics().At(i)
+		for j := 0; j < rm.ScopeMetrics().Len(); j++ {
+			sm := rm.ScopeMetrics().At(j)
+			for k := 0; k < sm.Metrics().Len(); k++ {
+				b.metric(rm, sm, sm.Metrics().At(k))
+			}
+		}
+	}
+	return b.marshal()
+}
+
+// rowBuilder appends rows to a record, one column after the other in the order of the schema.
+type rowBuilder struct {
+	rb  *array.RecordBuilder
+	col int
+}
+
+func newRowBuilder(schema *arrow.Schema) *rowBuilder {
+	return &rowBuilder{rb: array.NewRecordBuilder(memory.DefaultAllocator, schema)}
+}
+
+func (b *rowBuilder) release() {
+	b.rb.Release()
+}
+
+// marshal encodes the rows appended so far as an Arrow IPC stream holding a single record.
+func (b *rowBuilder) marshal() ([]byte, error) {
+	rec := b.rb.NewRecord()
+	defer rec.Release()
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(rec.Schema()))
+	if err := w.Write(rec); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// startRow starts a new row and appends the resource and scope columns.
+func (b *rowBuilder) startRow(res pcommon.Resource, schemaURL string, scope pcommon.InstrumentationScope) {
+	b.col = 0
+	if serviceName, ok := res.Attributes().Get(string(conventions.ServiceNameKey)); ok {
+		b.str(serviceName.AsString())
+	} else {
+		b.null()
+	}
+	b.attributes(res.Attributes())
+	b.str(schemaURL)
+	b.str(scope.Name())
+	b.str(scope.Version())
+	b.attributes(scope.Attributes())
+}
+
+func (b *rowBuilder) next() array.Builder {
+	builder := b.rb.Field(b.col)
+	b.col++
+	return builder
+}
+
+func (b *rowBuilder) null() {
+	b.next().AppendNull()
+}
+
+func (b *rowBuilder) str(v string) {
+	b.next().(*array.StringBuilder).Append(v)
+}
+
+func (b *rowBuilder) boolean(v bool) {
+	b.next().(*array.BooleanBuilder).Append(v)
+}
+
+func (b *rowBuilder) int32(v int32) {
+	b.next().(*array.Int32Builder).Append(v)
+}
+
+func (b *rowBuilder) int64(v int64) {
+	b.next().(*array.Int64Builder).Append(v)
+}
+
+func (b *rowBuilder) uint32(v uint32) {
+	b.next().(*array.Uint32Builder).Append(v)
+}
+
+func (b *rowBuilder) uint64(v uint64) {
+	b.next().(*array.Uint64Builder).Append(v)
+}
+
+func (b *rowBuilder) float64(v float64) {
+	b.next().(*array.Float64Builder).Append(v)
+}
+
+func (b *rowBuilder) timestamp(v pcommon.Timestamp) {
+	b.next().(*array.TimestampBuilder).Append(arrow.Timestamp(v))
+}
+
+func (b *rowBuilder) attributes(m pcommon.Map) {
+	appendAttributes(b.next().(*array.MapBuilder), m)
+}
+
+func (b *rowBuilder) uint64s(v pcommon.UInt64Slice) {
+	lb := b.next().(*array.ListBuilder)
+	lb.Append(true)
+	vb := lb.ValueBuilder().(*array.Uint64Builder)
+	for i := 0; i < v.Len(); i++ {
+		vb.Append(v.At(i))
+	}
+}
+
+func (b *rowBuilder) float64s(v pcommon.Float64Slice) {
+	lb := b.next().(*array.ListBuilder)
+	lb.Append(true)
+	vb := lb.ValueBuilder().(*array.Float64Builder)
+	for i := 0; i < v.Len(); i++ {
+		vb.Append(v.At(i))
+	}
+}
+
+func (b *rowBuilder) spanEvents(events ptrace.SpanEventSlice) {
+	lb := b.next().(*array.ListBuilder)
+	lb.Append(true)
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < events.Len(); i++ {
+		event := events.At(i)
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.TimestampBuilder).Append(arrow.Timestamp(event.Timestamp()))
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(event.Name())
+		appendAttributes(sb.FieldBuilder(2).(*array.MapBuilder), event.Attributes())
+	}
+}
+
+func (b *rowBuilder) spanLinks(links ptrace.SpanLinkSlice) {
+	lb := b.next().(*array.ListBuilder)
+	lb.Append(true)
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < links.Len(); i++ {
+		link := links.At(i)
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.StringBuilder).Append(traceutil.TraceIDToHexOrEmptyString(link.TraceID()))
+		sb.FieldBuilder(1).(*array.StringBuilder).Append(traceutil.SpanIDToHexOrEmptyString(link.SpanID()))
+		sb.FieldBuilder(2).(*array.StringBuilder).Append(link.TraceState().AsRaw())
+		appendAttributes(sb.FieldBuilder(3).(*array.MapBuilder), link.Attributes())
+	}
+}
+
+func (b *rowBuilder) quantileValues(quantiles pmetric.SummaryDataPointValueAtQuantileSlice) {
+	lb := b.next().(*array.ListBuilder)
+	lb.Append(true)
+	sb := lb.ValueBuilder().(*array.StructBuilder)
+	for i := 0; i < quantiles.Len(); i++ {
+		q := quantiles.At(i)
+		sb.Append(true)
+		sb.FieldBuilder(0).(*array.Float64Builder).Append(q.Quantile())
+		sb.FieldBuilder(1).(*array.Float64Builder).Append(q.Value())
+	}
+}
+
+func appendAttributes(mb *array.MapBuilder, m pcommon.Map) {
+	mb.Append(true)
+	kb := mb.KeyBuilder().(*array.StringBuilder)
+	ib := mb.ItemBuilder().(*array.StringBuilder)
+	for k, v := range m.All() {
+		kb.Append(k)
+		ib.Append(v.AsString())
+	}
+}
+
+// metric appends a row per data point of metric.
+func (b *rowBuilder) metric(rm pmetric.ResourceMetrics, sm pmetric.ScopeMetrics, metric pmetric.Metric) {
+	// startMetric appends the columns shared by all the data point types, up to the flags.
+	startMetric := func(temporality *pmetric.AggregationTemporality, isMonotonic *bool, attrs pcommon.Map, start, ts pcommon.Timestamp, flags pmetric.DataPointFlags) {
+		b.startRow(rm.Resource(), rm.SchemaUrl(), sm.Scope())
+		b.str(metric.Name())
+		b.str(metric.Description())
+		b.str(metric.Unit())
+		b.str(metric.Type().String())
+		if temporality != nil {
+			b.str(temporality.String())
+		} else {
+			b.null()
+		}
+		if isMonotonic != nil {
+			b.boolean(*isMonotonic)
+		} else {
+			b.null()
+		}
+		b.timestamp(start)
+		b.timestamp(ts)
+		b.attributes(attrs)
+		b.uint32(uint32(flags))
+	}
+
+	switch metric.Type() {
+	case pmetric.MetricTypeGauge:
+		b.numberDataPoints(metric.Gauge().DataPoints(), func(dp pmetric.NumberDataPoint) {
+			startMetric(nil, nil, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+		})
+	case pmetric.MetricTypeSum:
+		temporality, isMonotonic := metric.Sum().AggregationTemporality(), metric.Sum().IsMonotonic()
+		b.numberDataPoints(metric.Sum().DataPoints(), func(dp pmetric.NumberDataPoint) {
+			startMetric(&temporality, &isMonotonic, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+		})
+	case pmetric.MetricTypeHistogram:
+		temporality := metric.Histogram().AggregationTemporality()
+		dps := metric.Histogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			startMetric(&temporality, nil, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+			b.null()
+			b.null()
+			b.uint64(dp.Count())
+			b.optionalFloat64(dp.Sum(), dp.HasSum())
+			b.optionalFloat64(dp.Min(), dp.HasMin())
+			b.optionalFloat64(dp.Max(), dp.HasMax())
+			b.uint64s(dp.BucketCounts())
+			b.float64s(dp.ExplicitBounds())
+			b.nulls(8)
+		}
+	case pmetric.MetricTypeExponentialHistogram:
+		temporality := metric.ExponentialHistogram().AggregationTemporality()
+		dps := metric.ExponentialHistogram().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			startMetric(&temporality, nil, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+			b.null()
+			b.null()
+			b.uint64(dp.Count())
+			b.optionalFloat64(dp.Sum(), dp.HasSum())
+			b.optionalFloat64(dp.Min(), dp.HasMin())
+			b.optionalFloat64(dp.Max(), dp.HasMax())
+			b.nulls(2)
+			b.int32(dp.Scale())
+			b.uint64(dp.ZeroCount())
+			b.float64(dp.ZeroThreshold())
+			b.int32(dp.Positive().Offset())
+			b.uint64s(dp.Positive().BucketCounts())
+			b.int32(dp.Negative().Offset())
+			b.uint64s(dp.Negative().BucketCounts())
+			b.null()
+		}
+	case pmetric.MetricTypeSummary:
+		dps := metric.Summary().DataPoints()
+		for i := 0; i < dps.Len(); i++ {
+			dp := dps.At(i)
+			startMetric(nil, nil, dp.Attributes(), dp.StartTimestamp(), dp.Timestamp(), dp.Flags())
+			b.null()
+			b.null()
+			b.uint64(dp.Count())
+			b.float64(dp.Sum())
+			b.nulls(11)
+			b.quantileValues(dp.QuantileValues())
+		}
+	}
+}
+
+func (b *rowBuilder) numberDataPoints(dps pmetric.NumberDataPointSlice, startMetric func(pmetric.NumberDataPoint)) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		startMetric(dp)
+		switch dp.ValueType() {
+		case pmetric.NumberDataPointValueTypeDouble:
+			b.float64(dp.DoubleValue())
+			b.null()
+		case pmetric.NumberDataPointValueTypeInt:
+			b.null()
+			b.int64(dp.IntValue())
+		default:
+			b.nulls(2)
+		}
+		b.nulls(14)
+	}
+}
+
+func (b *rowBuilder) optionalFloat64(v float64, ok bool) {
+	if ok {
+		b.float64(v)
+		return
+	}
+	b.null()
+}
+
+func (b *rowBuilder) nulls(n int) {
+	for i := 0; i < n; i++ {
+		b.null()
+	}
+}
@@ -0,0 +1,323 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet/file"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/coreinternal/testdata"
+)
+
+// readColumnarFile reads back a Parquet or Arrow IPC file written by the exporter.
+func readColumnarFile(t *testing.T, path, formatType string) arrow.Table {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	if formatType == formatTypeParquet {
+		rdr, err := file.NewParquetReader(f)
+		require.NoError(t, err)
+		defer rdr.Close()
+		fr, err := pqarrow.NewFileReader(rdr, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		require.NoError(t, err)
+		tbl, err := fr.ReadTable(context.Background())
+		require.NoError(t, err)
+		return tbl
+	}
+
+	rdr, err := ipc.NewFileReader(f)
+	require.NoError(t, err)
+	defer rdr.Close()
+	var recs []arrow.Record
+	for i := 0; i < rdr.NumRecords(); i++ {
+		rec, err := rdr.Record(i)
+		require.NoError(t, err)
+		rec.Retain()
+		recs = append(recs, rec)
+	}
+	return array.NewTableFromRecords(rdr.Schema(), recs)
+}
+
+func fieldNames(schema *arrow.Schema) []string {
+	names := make([]string, schema.NumFields())
+	for i, f := range schema.Fields() {
+		names[i] = f.Name
+	}
+	return names
+}
+
+func TestFileExporterColumnar(t *testing.T) {
+	for _, formatType := range []string{formatTypeParquet, formatTypeArrow} {
+		for _, compression := range []string{"", compressionZSTD} {
+			t.Run(formatType+"/"+compression, func(t *testing.T) {
+				tests := []struct {
+					name    string
+					consume func(fe *fileExporter) error
+					schema  *arrow.Schema
+					rows    int64
+				}{
+					{
+						name: "traces",
+						consume: func(fe *fileExporter) error {
+							return fe.consumeTraces(context.Background(), testdata.GenerateTracesTwoSpansSameResource())
+						},
+						schema: tracesSchema,
+						rows:   2,
+					},
+					{
+						name: "metrics",
+						consume: func(fe *fileExporter) error {
+							return fe.consumeMetrics(context.Background(), testdata.GenerateMetricsAllTypesEmptyDataPoint())
+						},
+						schema: metricsSchema,
+						rows:   int64(testdata.GenerateMetricsAllTypesEmptyDataPoint().DataPointCount()),
+					},
+					{
+						name: "logs",
+						consume: func(fe *fileExporter) error {
+							return fe.consumeLogs(context.Background(), testdata.GenerateLogsTwoLogRecordsSameResource())
+						},
+						schema: logsSchema,
+						rows:   2,
+					},
+				}
+				for _, tt := range tests {
+					t.Run(tt.name, func(t *testing.T) {
+						fe := &fileExporter{
+							conf: &Config{
+								Path:        tempFileName(t),
+								FormatType:  formatType,
+								Compression: compression,
+							},
+						}
+						require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+						require.NoError(t, tt.consume(fe))
+						require.NoError(t, tt.consume(fe))
+						path := fe.writer.path
+						require.NoError(t, fe.Shutdown(context.Background()))
+
+						tbl := readColumnarFile(t, path, formatType)
+						defer tbl.Release()
+						// Parquet adds field metadata when reading the file back, only compare the columns.
+						assert.Equal(t, fieldNames(tt.schema), fieldNames(tbl.Schema()))
+						assert.Equal(t, 2*tt.rows, tbl.NumRows())
+					})
+				}
+			})
+		}
+	}
+}
+
+func TestColumnarMarshalerLogs(t *testing.T) {
+	ld := plog.NewLogs()
+	rl := ld.ResourceLogs().AppendEmpty()
+	rl.Resource().Attributes().PutStr("service.name", "checkout")
+	lr := rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	lr.SetTimestamp(pcommon.Timestamp(1000))
+	lr.SetSeverityText("INFO")
+	lr.SetSeverityNumber(plog.SeverityNumberInfo)
+	lr.Body().SetStr("hello")
+	lr.Attributes().PutInt("http.status_code", 200)
+
+	buf, err := columnarMarshaler{}.MarshalLogs(ld)
+	require.NoError(t, err)
+
+	rdr, err := ipc.NewReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer rdr.Release()
+	require.True(t, rdr.Next())
+	rec := rdr.Record()
+	require.Equal(t, int64(1), rec.NumRows())
+
+	column := func(name string) arrow.Array {
+		return rec.Column(rec.Schema().FieldIndices(name)[0])
+	}
+	assert.Equal(t, "checkout", column("service_name").(*array.String).Value(0))
+	assert.Equal(t, arrow.Timestamp(1000), column("timestamp").(*array.Timestamp).Value(0))
+	assert.Equal(t, "INFO", column("severity_text").(*array.String).Value(0))
+	assert.Equal(t, int32(plog.SeverityNumberInfo), column("severity_number").(*array.Int32).Value(0))
+	assert.Equal(t, "hello", column("body").(*array.String).Value(0))
+
+	attrs := column("attributes").(*array.Map)
+	assert.Equal(t, "http.status_code", attrs.Keys().(*array.String).Value(0))
+	assert.Equal(t, "200", attrs.Items().(*array.String).Value(0))
+}
+
+func TestColumnarMarshalerMetricsNullColumns(t *testing.T) {
+	md := pmetric.NewMetrics()
+	metric := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty()
+	metric.SetName("requests")
+	metric.SetEmptySum().SetIsMonotonic(true)
+	metric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	metric.Sum().DataPoints().AppendEmpty().SetIntValue(5)
+
+	buf, err := columnarMarshaler{}.MarshalMetrics(md)
+	require.NoError(t, err)
+
+	rdr, err := ipc.NewReader(bytes.NewReader(buf))
+	require.NoError(t, err)
+	defer rdr.Release()
+	require.True(t, rdr.Next())
+	rec := rdr.Record()
+
+	column := func(name string) arrow.Array {
+		return rec.Column(rec.Schema().FieldIndices(name)[0])
+	}
+	assert.True(t, column("service_name").IsNull(0))
+	assert.Equal(t, "Sum", column("metric_type").(*array.String).Value(0))
+	assert.Equal(t, "Cumulative", column("aggregation_temporality").(*array.String).Value(0))
+	assert.True(t, column("is_monotonic").(*array.Boolean).Value(0))
+	assert.True(t, column("value_double").IsNull(0))
+	assert.Equal(t, int64(5), column("value_int").(*array.Int64).Value(0))
+	assert.True(t, column("count").IsNull(0))
+	assert.True(t, column("quantile_values").IsNull(0))
+}
+
+func TestColumnarWriteCloserRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.parquet")
+	// Write a row group and rotate after every record.
+	cwc := newColumnarWriteCloser(&lumberjack.Logger{Filename: path}, formatTypeParquet, "", 1).(*columnarWriteCloser)
+	cwc.maxBytes = 1
+
+	buf, err := columnarMarshaler{}.MarshalLogs(testdata.GenerateLogsTwoLogRecordsSameResource())
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = cwc.Write(buf)
+		require.NoError(t, err)
+		// lumberjack names the rotated files after the current time, in milliseconds.
+		time.Sleep(2 * time.Millisecond)
+	}
+	require.NoError(t, cwc.Close())
+
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(path), "logs*.parquet"))
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	for _, f := range files {
+		tbl := readColumnarFile(t, f, formatTypeParquet)
+		assert.Equal(t, int64(2), tbl.NumRows(), "file %s", f)
+		tbl.Release()
+	}
+}
+
+func TestColumnarWriteCloserRowGroups(t *testing.T) {
+	path := tempFileName(t)
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	cwc := newColumnarWriteCloser(newBufferedWriteCloser(f), formatTypeParquet, "", 3)
+
+	// Each batch has 2 rows, so the first row group holds 2 batches and the last one is written on Close.
+	buf, err := columnarMarshaler{}.MarshalLogs(testdata.GenerateLogsTwoLogRecordsSameResource())
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = cwc.Write(buf)
+		require.NoError(t, err)
+	}
+	require.NoError(t, cwc.Close())
+
+	rdr, err := file.OpenParquetFile(path, false)
+	require.NoError(t, err)
+	defer rdr.Close()
+	require.Equal(t, 2, rdr.NumRowGroups())
+	assert.Equal(t, int64(4), rdr.RowGroup(0).NumRows())
+	assert.Equal(t, int64(2), rdr.RowGroup(1).NumRows())
+}
+
+func TestColumnarWriteCloserMixedSignals(t *testing.T) {
+	f, err := os.Create(tempFileName(t))
+	require.NoError(t, err)
+	cwc := newColumnarWriteCloser(newBufferedWriteCloser(f), formatTypeArrow, "", defaultRowGroupSize)
+
+	logs, err := columnarMarshaler{}.MarshalLogs(testdata.GenerateLogsTwoLogRecordsSameResource())
+	require.NoError(t, err)
+	traces, err := columnarMarshaler{}.MarshalTraces(testdata.GenerateTracesTwoSpansSameResource())
+	require.NoError(t, err)
+
+	_, err = cwc.Write(logs)
+	require.NoError(t, err)
+	_, err = cwc.Write(traces)
+	assert.EqualError(t, err, "columnar formats can't write different signals to the same file")
+	assert.NoError(t, cwc.Close())
+}
+
+func TestGroupingFileExporterColumnar(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := &Config{
+		Path:       filepath.Join(tmpDir, "*.parquet"),
+		FormatType: formatTypeParquet,
+		GroupBy: &GroupBy{
+			Enabled:           true,
+			ResourceAttribute: "tenant",
+			MaxOpenFiles:      10,
+		},
+	}
+	fe := &groupingFileExporter{conf: cfg, logger: zap.NewNop()}
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	ld := plog.NewLogs()
+	for _, tenant := range []string{"a", "b"} {
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(tenant)
+	}
+	require.NoError(t, fe.consumeLogs(context.Background(), ld))
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	for _, tenant := range []string{"a", "b"} {
+		tbl := readColumnarFile(t, filepath.Join(tmpDir, tenant+".parquet"), formatTypeParquet)
+		assert.Equal(t, int64(1), tbl.NumRows())
+		tbl.Release()
+	}
+}
+
+func TestGroupingFileExporterColumnarEviction(t *testing.T) {
+	tmpDir := t.TempDir()
+	cfg := &Config{
+		Path:       filepath.Join(tmpDir, "*.parquet"),
+		FormatType: formatTypeParquet,
+		GroupBy: &GroupBy{
+			Enabled:           true,
+			ResourceAttribute: "tenant",
+			MaxOpenFiles:      1,
+		},
+	}
+	fe := &groupingFileExporter{conf: cfg, logger: zap.NewNop()}
+	require.NoError(t, fe.Start(context.Background(), componenttest.NewNopHost()))
+
+	// Each tenant evicts the file of the previous one.
+	for _, tenant := range []string{"a", "b", "a"} {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		rl.Resource().Attributes().PutStr("tenant", tenant)
+		rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr(tenant)
+		require.NoError(t, fe.consumeLogs(context.Background(), ld))
+	}
+	require.NoError(t, fe.Shutdown(context.Background()))
+
+	// The reopened partition is written to a new file instead of truncating the evicted one.
+	for _, name := range []string{"a.parquet", "b.parquet", "a-1.parquet"} {
+		tbl := readColumnarFile(t, filepath.Join(tmpDir, name), formatTypeParquet)
+		assert.Equal(t, int64(1), tbl.NumRows(), "file %s", name)
+		tbl.Release()
+	}
+}
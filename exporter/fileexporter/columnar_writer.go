@@ -0,0 +1,248 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+const megabyte = 1024 * 1024
+
+// recordWriter is implemented by the Parquet and Arrow IPC file writers.
+type recordWriter interface {
+	Write(rec arrow.Record) error
+	Close() error
+}
+
+// columnarWriteCloser appends the records of the Arrow IPC streams written to it to a Parquet
+// or Arrow IPC file, and writes the file footer on Close. The records are buffered until they
+// hold rowGroupSize rows, and then written as a single Parquet row group or Arrow record batch.
+//
+// When the wrapped writer rotates files, the file is finalized and rotated at the first row group
+// boundary after it exceeds the rotation size, so that every rotated file is a complete file.
+type columnarWriteCloser struct {
+	wrapped      io.WriteCloser
+	formatType   string
+	compression  string
+	rowGroupSize int64
+
+	// rotate, if set, moves the current file out of the way and opens a new one.
+	rotate   func() error
+	maxBytes int64
+
+	sink   *countingWriter
+	schema *arrow.Schema
+	writer recordWriter
+
+	// pending holds the records of the next row group, and pendingRows their number of rows.
+	pending     []arrow.Record
+	pendingRows int64
+}
+
+var _ io.WriteCloser = (*columnarWriteCloser)(nil)
+
+func newColumnarWriteCloser(wc io.WriteCloser, formatType, compression string, rowGroupSize int) io.WriteCloser {
+	cwc := &columnarWriteCloser{
+		wrapped:      wc,
+		formatType:   formatType,
+		compression:  compression,
+		rowGroupSize: int64(rowGroupSize),
+	}
+	if l, ok := wc.(*lumberjack.Logger); ok {
+		cwc.rotate = l.Rotate
+		cwc.maxBytes = int64(l.MaxSize) * megabyte
+		if cwc.maxBytes == 0 {
+			// Same default as lumberjack.
+			cwc.maxBytes = 100 * megabyte
+		}
+		// Rotation is driven by columnarWriteCloser, lumberjack must never split a file.
+		l.MaxSize = math.MaxInt32
+	}
+	return cwc
+}
+
+// Write decodes buf as an Arrow IPC stream and appends its records to the current file.
+func (c *columnarWriteCloser) Write(buf []byte) (int, error) {
+	rdr, err := ipc.NewReader(bytes.NewReader(buf))
+	if err != nil {
+		return 0, err
+	}
+	defer rdr.Release()
+
+	for rdr.Next() {
+		if err = c.writeRecord(rdr.Record()); err != nil {
+			return 0, err
+		}
+	}
+	if err = rdr.Err(); err != nil {
+		return 0, err
+	}
+
+	if c.maxBytes > 0 && c.sink != nil && c.sink.n >= c.maxBytes {
+		if err = c.closeFile(); err != nil {
+			return 0, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (c *columnarWriteCloser) writeRecord(rec arrow.Record) error {
+	if c.writer == nil {
+		if err := c.openFile(rec.Schema()); err != nil {
+			return err
+		}
+	} else if !rec.Schema().Equal(c.schema) {
+		return errors.New("columnar formats can't write different signals to the same file")
+	}
+	rec.Retain()
+	c.pending = append(c.pending, rec)
+	c.pendingRows += rec.NumRows()
+	if c.pendingRows < c.rowGroupSize {
+		return nil
+	}
+	return c.writeRowGroup()
+}
+
+// writeRowGroup writes the pending records as a single row group.
+func (c *columnarWriteCloser) writeRowGroup() error {
+	if len(c.pending) == 0 {
+		return nil
+	}
+	defer func() {
+		for _, rec := range c.pending {
+			rec.Release()
+		}
+		c.pending = c.pending[:0]
+		c.pendingRows = 0
+	}()
+
+	rec := c.pending[0]
+	if len(c.pending) > 1 {
+		var err error
+		if rec, err = concatRecords(c.schema, c.pending, c.pendingRows); err != nil {
+			return err
+		}
+		defer rec.Release()
+	}
+	return c.writer.Write(rec)
+}
+
+// concatRecords concatenates records of the same schema into a single record of numRows rows.
+func concatRecords(schema *arrow.Schema, records []arrow.Record, numRows int64) (arrow.Record, error) {
+	columns := make([]arrow.Array, schema.NumFields())
+	defer func() {
+		for _, col := range columns {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	chunks := make([]arrow.Array, len(records))
+	for i := range columns {
+		for j, rec := range records {
+			chunks[j] = rec.Column(i)
+		}
+		col, err := array.Concatenate(chunks, memory.DefaultAllocator)
+		if err != nil {
+			return nil, err
+		}
+		columns[i] = col
+	}
+	return array.NewRecord(schema, columns, numRows), nil
+}
+
+func (c *columnarWriteCloser) openFile(schema *arrow.Schema) error {
+	if c.rotate != nil {
+		// Never append to an existing file, as it already has a footer.
+		if err := c.rotate(); err != nil {
+			return err
+		}
+	}
+
+	// The sink hides the Close method of the wrapped writer, so that finalizing a file doesn't close it.
+	sink := &countingWriter{w: c.wrapped}
+	var (
+		writer recordWriter
+		err    error
+	)
+	switch c.formatType {
+	case formatTypeParquet:
+		codec := compress.Codecs.Uncompressed
+		if c.compression == compressionZSTD {
+			codec = compress.Codecs.Zstd
+		}
+		writer, err = pqarrow.NewFileWriter(schema, sink,
+			parquet.NewWriterProperties(parquet.WithCompression(codec)),
+			pqarrow.DefaultWriterProps())
+	case formatTypeArrow:
+		opts := []ipc.Option{ipc.WithSchema(schema)}
+		if c.compression == compressionZSTD {
+			opts = append(opts, ipc.WithZstd())
+		}
+		writer, err = ipc.NewFileWriter(sink, opts...)
+	default:
+		err = errors.New("format type is not a columnar format")
+	}
+	if err != nil {
+		return err
+	}
+
+	c.sink = sink
+	c.schema = schema
+	c.writer = writer
+	return nil
+}
+
+// closeFile writes the pending rows and the footer of the current file.
+func (c *columnarWriteCloser) closeFile() error {
+	if c.writer == nil {
+		return nil
+	}
+	err := errors.Join(
+		c.writeRowGroup(),
+		c.writer.Close(),
+	)
+	c.writer = nil
+	c.sink = nil
+	return err
+}
+
+func (c *columnarWriteCloser) Close() error {
+	return errors.Join(
+		c.closeFile(),
+		c.wrapped.Close(),
+	)
+}
+
+func (c *columnarWriteCloser) flush() error {
+	if ff, ok := c.wrapped.(interface{ flush() error }); ok {
+		return ff.flush()
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written to the current file.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
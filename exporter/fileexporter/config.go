@@ -36,6 +36,8 @@ type Config struct {
 	// Options:
 	// - json[default]:  OTLP json bytes.
 	// - proto:  OTLP binary protobuf bytes.
+	// - parquet:  Parquet file with a flattened schema per signal.
+	// - arrow:  Arrow IPC file with a flattened schema per signal.
 	FormatType string `mapstructure:"format"`
 
 	// Encoding defines the encoding of the telemetry data.
@@ -46,6 +48,10 @@ type Config struct {
 	// Supported compression algorithms:`zstd`
 	Compression string `mapstructure:"compression"`
 
+	// RowGroupSize is the number of rows buffered before they are written as a Parquet row group
+	// or an Arrow record batch. Only used with the parquet and arrow formats. Default is 10000.
+	RowGroupSize int `mapstructure:"row_group_size"`
+
 	// FlushInterval is the duration between flushes.
 	// See time.ParseDuration for valid values.
 	FlushInterval time.Duration `mapstructure:"flush_interval"`
@@ -84,12 +90,22 @@ type GroupBy struct {
 	// ResourceAttribute specifies the name of the resource attribute that
 	// contains the path segment of the file to write to. The final path will be
 	// the Path config value, with the * replaced with the value of this resource
-	// attribute. Default is "fileexporter.path_segment".
+	// attribute. Default is "fileexporter.path_segment". Ignored when Path is a
+	// template.
 	ResourceAttribute string `mapstructure:"resource_attribute"`
 
 	// MaxOpenFiles specifies the maximum number of open file descriptors for the output files.
 	// The default is 100.
 	MaxOpenFiles int `mapstructure:"max_open_files"`
+
+	// Manifest is the path of a file to which a JSON line is appended each time a file
+	// is closed, because its time partition ended or at shutdown. Only used when Path
+	// is a template.
+	Manifest string `mapstructure:"manifest"`
+
+	// LocalTime determines if the time directives of a path template are rendered
+	// with the computer's local time. The default is to use UTC time.
+	LocalTime bool `mapstructure:"localtime"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -105,17 +121,30 @@ func (cfg *Config) Validate() error {
 	if cfg.Append && cfg.Rotation != nil {
 		return errors.New("append and rotation enabled at the same time is not supported")
 	}
-	if cfg.FormatType != formatTypeJSON && cfg.FormatType != formatTypeProto {
+	switch cfg.FormatType {
+	case formatTypeJSON, formatTypeProto:
+	case formatTypeParquet, formatTypeArrow:
+		if cfg.Append {
+			return errors.New("append is not supported with columnar formats")
+		}
+	default:
 		return errors.New("format type is not supported")
 	}
 	if cfg.Compression != "" && cfg.Compression != compressionZSTD {
 		return errors.New("compression is not supported")
 	}
+	if cfg.RowGroupSize < 0 {
+		return errors.New("row_group_size must not be negative")
+	}
 	if cfg.FlushInterval < 0 {
 		return errors.New("flush_interval must be larger than zero")
 	}
 
-	if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
+	if cfg.GroupBy != nil && cfg.GroupBy.Enabled && isPathTemplate(cfg.Path) {
+		if _, err := parsePathTemplate(cfg.Path); err != nil {
+			return err
+		}
+	} else if cfg.GroupBy != nil && cfg.GroupBy.Enabled {
 		pathParts := strings.Split(cfg.Path, "*")
 		if len(pathParts) != 2 {
 			return errors.New("path must contain exactly one * when group_by is enabled")
@@ -156,3 +185,15 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	}
 	return nil
 }
+
+// isColumnar returns whether telemetry is written to Parquet or Arrow IPC files.
+func (cfg *Config) isColumnar() bool {
+	return cfg.Encoding == nil && (cfg.FormatType == formatTypeParquet || cfg.FormatType == formatTypeArrow)
+}
+
+func (cfg *Config) rowGroupSize() int {
+	if cfg.RowGroupSize > 0 {
+		return cfg.RowGroupSize
+	}
+	return defaultRowGroupSize
+}
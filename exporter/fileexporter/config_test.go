@@ -104,6 +104,14 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "format_error"),
 			errorMessage: "format type is not supported",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "columnar_append_error"),
+			errorMessage: "append is not supported with columnar formats",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "row_group_size_error"),
+			errorMessage: "row_group_size must not be negative",
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "flush_interval_5"),
 			expected: &Config{
@@ -186,6 +194,39 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "group_by_empty_resource_attribute"),
 			errorMessage: "resource_attribute must not be empty when group_by is enabled",
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "group_by_template"),
+			expected: &Config{
+				Path:          "./data/{service.name}/%Y/%m/%d/%H.jsonl",
+				FlushInterval: time.Second,
+				FormatType:    formatTypeJSON,
+				GroupBy: &GroupBy{
+					Enabled:           true,
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+					Manifest:          "./data/manifest.jsonl",
+					LocalTime:         true,
+				},
+			},
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "group_by_invalid_template"),
+			errorMessage: "path template has an unsupported time directive %S",
+		},
+		{
+			// the paths with a * are not templates, even with { or %
+			id: component.NewIDWithName(metadata.Type, "group_by_literal_percent"),
+			expected: &Config{
+				Path:          "./data/100%/{raw}/%Y/*.jsonl",
+				FlushInterval: time.Second,
+				FormatType:    formatTypeJSON,
+				GroupBy: &GroupBy{
+					Enabled:           true,
+					MaxOpenFiles:      defaultMaxOpenFiles,
+					ResourceAttribute: defaultResourceAttribute,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -5,6 +5,7 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"time"
@@ -31,14 +32,19 @@ const (
 	defaultMaxBackups = 100
 
 	// the format of encoded telemetry data
-	formatTypeJSON  = "json"
-	formatTypeProto = "proto"
+	formatTypeJSON    = "json"
+	formatTypeProto   = "proto"
+	formatTypeParquet = "parquet"
+	formatTypeArrow   = "arrow"
 
 	// the type of compression codec
 	compressionZSTD = "zstd"
 
 	defaultMaxOpenFiles = 100
 
+	// the number of rows written per Parquet row group or Arrow record batch
+	defaultRowGroupSize = 10000
+
 	defaultResourceAttribute = "fileexporter.path_segment"
 )
 
@@ -61,6 +67,8 @@ func NewFactory() exporter.Factory {
 		xexporter.WithProfiles(createProfilesExporter, metadata.ProfilesStability))
 }
 
+var errProfilesColumnar = errors.New("profiles are not supported with columnar formats")
+
 func createDefaultConfig() component.Config {
 	return &Config{
 		FormatType: formatTypeJSON,
@@ -128,6 +136,9 @@ func createProfilesExporter(
 	set exporter.Settings,
 	cfg component.Config,
 ) (xexporter.Profiles, error) {
+	if cfg.(*Config).isColumnar() {
+		return nil, errProfilesColumnar
+	}
 	fe := getOrCreateFileExporter(cfg, set.Logger)
 	return xexporterhelper.NewProfilesExporter(
 		ctx,
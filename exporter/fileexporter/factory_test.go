@@ -132,6 +132,18 @@ func TestCreateProfilesError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCreateProfilesColumnar(t *testing.T) {
+	cfg := &Config{
+		FormatType: formatTypeParquet,
+		Path:       tempFileName(t),
+	}
+	_, err := createProfilesExporter(
+		context.Background(),
+		exportertest.NewNopSettings(metadata.Type),
+		cfg)
+	assert.ErrorIs(t, err, errProfilesColumnar)
+}
+
 func TestNewFileWriter(t *testing.T) {
 	type args struct {
 		cfg *Config
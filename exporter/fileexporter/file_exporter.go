@@ -65,6 +65,9 @@ func (e *fileExporter) Start(_ context.Context, host component.Host) error {
 	if err != nil {
 		return err
 	}
+	if e.conf.isColumnar() {
+		e.writer.file = newColumnarWriteCloser(e.writer.file, e.conf.FormatType, e.conf.Compression, e.conf.rowGroupSize())
+	}
 	e.writer.start()
 	return nil
 }
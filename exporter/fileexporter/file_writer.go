@@ -5,11 +5,14 @@ package fileexporter // import "github.com/open-telemetry/opentelemetry-collecto
 
 import (
 	"encoding/binary"
+	"errors"
 	"io"
 	"sync"
 	"time"
 )
 
+var errWriterClosed = errors.New("file writer is closed")
+
 // exportFunc defines how to export encoded telemetry data.
 type exportFunc func(e *fileWriter, buf []byte) error
 
@@ -18,6 +21,10 @@ type fileWriter struct {
 	file  io.WriteCloser
 	mutex sync.Mutex
 
+	// closeMutex is held for reading while exporting, so that the file isn't closed during an export.
+	closeMutex sync.RWMutex
+	closed     bool
+
 	exporter exportFunc
 
 	flushInterval time.Duration
@@ -50,7 +57,21 @@ func exportMessageAsBuffer(w *fileWriter, buf []byte) error {
 	return binary.Write(w.file, binary.BigEndian, append(data, buf...))
 }
 
+// exportMessageAsRecords appends the records of buf, an Arrow IPC stream, to a columnar file.
+func exportMessageAsRecords(w *fileWriter, buf []byte) error {
+	// Ensure only one write operation happens at a time.
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	_, err := w.file.Write(buf)
+	return err
+}
+
 func (w *fileWriter) export(buf []byte) error {
+	w.closeMutex.RLock()
+	defer w.closeMutex.RUnlock()
+	if w.closed {
+		return errWriterClosed
+	}
 	return w.exporter(w, buf)
 }
 
@@ -95,6 +116,11 @@ func (w *fileWriter) start() {
 // Shutdown stops the exporter and is invoked during shutdown.
 // It stops the flush ticker if set.
 func (w *fileWriter) shutdown() error {
+	// Wait for the exports in progress, and fail the next ones.
+	w.closeMutex.Lock()
+	defer w.closeMutex.Unlock()
+	w.closed = true
+
 	// Stop the flush ticker.
 	if w.flushTicker != nil {
 		// Stop the go routine.
@@ -106,6 +132,9 @@ func (w *fileWriter) shutdown() error {
 }
 
 func buildExportFunc(cfg *Config) func(w *fileWriter, buf []byte) error {
+	if cfg.isColumnar() {
+		return exportMessageAsRecords
+	}
 	if cfg.FormatType == formatTypeProto {
 		return exportMessageAsBuffer
 	}
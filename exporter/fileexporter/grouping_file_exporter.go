@@ -4,13 +4,18 @@
 package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/golang-lru/v2/simplelru"
 	"go.opentelemetry.io/collector/component"
@@ -31,10 +36,45 @@ type groupingFileExporter struct {
 	pathSuffix    string
 	attribute     string
 	maxOpenFiles  int
-	newFileWriter func(path string) (*fileWriter, error)
+	newFileWriter func(path string, shouldAppend bool) (*fileWriter, error)
+
+	// template is set when the path is a template, in which case the files are grouped by the
+	// values of its attributes, and rolled at the end of each time partition.
+	template   *pathTemplate
+	now        func() time.Time
+	stopRoller chan struct{}
+	rollerDone sync.WaitGroup
 
 	mutex   sync.Mutex
 	writers *simplelru.LRU[string, *fileWriter]
+
+	// partitionStart and partitionEnd are the bounds of the current time partition, and
+	// partitionFiles the files written during it.
+	partitionStart time.Time
+	partitionEnd   time.Time
+	partitionFiles map[string]struct{}
+
+	// columnarParts counts the files opened per path with columnar formats. A columnar file
+	// can't be appended to once its footer is written, so a path whose file was evicted is
+	// written to a new file, suffixed with its part number.
+	columnarParts map[string]int
+}
+
+const (
+	// templateKeySeparator joins the attribute values of a path template into a group key.
+	templateKeySeparator = "\x00"
+
+	// partitionCheckInterval is how often the end of the current time partition is checked.
+	partitionCheckInterval = time.Second
+)
+
+// manifestEntry is written to the manifest each time a file of a path template is closed.
+type manifestEntry struct {
+	Path           string     `json:"path"`
+	PartitionStart *time.Time `json:"partition_start,omitempty"`
+	PartitionEnd   *time.Time `json:"partition_end,omitempty"`
+	ClosedAt       time.Time  `json:"closed_at"`
+	SizeBytes      int64      `json:"size_bytes"`
 }
 
 func (e *groupingFileExporter) consumeTraces(ctx context.Context, td ptrace.Traces) error {
@@ -190,47 +230,163 @@ func (e *groupingFileExporter) consumeProfiles(ctx context.Context, pd pprofile.
 }
 
 func (e *groupingFileExporter) write(_ context.Context, pathSegment string, buf []byte) error {
-	writer, err := e.getWriter(pathSegment)
-	if err != nil {
-		return err
-	}
+	for {
+		e.mutex.Lock()
+		writer, err := e.getWriter(pathSegment)
+		e.mutex.Unlock()
+		if err != nil {
+			return err
+		}
 
-	err = writer.export(buf)
-	if err != nil {
-		return err
+		// The file may be closed once the lock is released, when it is evicted or when its
+		// time partition ends, in which case the writer of the new file is looked up again.
+		err = writer.export(buf)
+		if !errors.Is(err, errWriterClosed) {
+			return err
+		}
 	}
-
-	return nil
 }
 
+// getWriter must be called with e.mutex held.
 func (e *groupingFileExporter) getWriter(pathSegment string) (*fileWriter, error) {
 	fullPath := e.fullPath(pathSegment)
-
-	e.mutex.Lock()
-	defer e.mutex.Unlock()
+	shouldAppend := e.conf.Append
+	if e.template != nil {
+		now := e.now()
+		e.rollPartition(now)
+		fullPath = e.template.render(strings.Split(pathSegment, templateKeySeparator), now)
+		if _, ok := e.partitionFiles[fullPath]; ok && !e.conf.isColumnar() {
+			// The file was evicted earlier in this partition, keep what was already written.
+			shouldAppend = true
+		}
+	}
 
 	writer, ok := e.writers.Get(fullPath)
 	if ok {
 		return writer, nil
 	}
 
-	err := os.MkdirAll(path.Dir(fullPath), 0o755)
+	filePath := fullPath
+	if e.conf.isColumnar() {
+		if part := e.columnarParts[fullPath]; part > 0 {
+			filePath = columnarPartPath(fullPath, part)
+		}
+		e.columnarParts[fullPath]++
+	}
+
+	err := os.MkdirAll(path.Dir(filePath), 0o755)
 	if err != nil {
 		return nil, err
 	}
 
-	writer, err = e.newFileWriter(fullPath)
+	writer, err = e.newFileWriter(filePath, shouldAppend)
 	if err != nil {
 		return nil, err
 	}
 
 	e.writers.Add(fullPath, writer)
+	if e.template != nil {
+		e.partitionFiles[filePath] = struct{}{}
+	}
 
 	writer.start()
 
 	return writer, nil
 }
 
+// rollPartition closes the files of the current time partition once now is past its end.
+// It must be called with e.mutex held.
+func (e *groupingFileExporter) rollPartition(now time.Time) {
+	if e.template.granularity == granularityNone {
+		return
+	}
+	if !e.partitionEnd.IsZero() {
+		if now.Before(e.partitionEnd) {
+			return
+		}
+		e.writers.Purge()
+		e.writeManifest()
+		e.partitionFiles = map[string]struct{}{}
+		e.columnarParts = map[string]int{}
+	}
+	e.partitionStart, e.partitionEnd = e.template.partitionBounds(now)
+}
+
+// writeManifest appends an entry per file of the current partition to the manifest.
+// It must be called with e.mutex held, after the files are closed.
+func (e *groupingFileExporter) writeManifest() {
+	if e.conf.GroupBy.Manifest == "" || len(e.partitionFiles) == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	closedAt := e.now()
+	for _, filePath := range slices.Sorted(maps.Keys(e.partitionFiles)) {
+		entry := manifestEntry{
+			Path:     filePath,
+			ClosedAt: closedAt,
+		}
+		if !e.partitionEnd.IsZero() {
+			start, end := e.partitionStart, e.partitionEnd
+			entry.PartitionStart, entry.PartitionEnd = &start, &end
+		}
+		if fi, err := os.Stat(filePath); err == nil {
+			entry.SizeBytes = fi.Size()
+		}
+		if err := enc.Encode(entry); err != nil {
+			e.logger.Warn("Failed to encode manifest entry", zap.Error(err), zap.String("path", filePath))
+		}
+	}
+
+	if err := appendToManifest(e.conf.GroupBy.Manifest, buf.Bytes()); err != nil {
+		e.logger.Warn("Failed to write manifest", zap.Error(err), zap.String("path", e.conf.GroupBy.Manifest))
+	}
+}
+
+// columnarPartPath inserts the part number before the extension of filePath,
+// e.g. "logs.parquet" becomes "logs-1.parquet".
+func columnarPartPath(filePath string, part int) string {
+	ext := path.Ext(filePath)
+	return fmt.Sprintf("%s-%d%s", strings.TrimSuffix(filePath, ext), part, ext)
+}
+
+func appendToManifest(manifestPath string, buf []byte) error {
+	if err := os.MkdirAll(path.Dir(manifestPath), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(manifestPath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(buf)
+	return errors.Join(err, f.Close())
+}
+
+// startRoller periodically closes the files of the current time partition once it has ended,
+// even if nothing is written to the next partition.
+func (e *groupingFileExporter) startRoller() {
+	e.stopRoller = make(chan struct{})
+	e.rollerDone.Add(1)
+	go func() {
+		defer e.rollerDone.Done()
+		ticker := time.NewTicker(partitionCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				e.mutex.Lock()
+				if e.writers != nil {
+					e.rollPartition(e.now())
+				}
+				e.mutex.Unlock()
+			case <-e.stopRoller:
+				return
+			}
+		}
+	}()
+}
+
 func cleanPathPrefix(pathPrefix string) string {
 	cleaned := path.Clean(pathPrefix)
 	if strings.HasSuffix(pathPrefix, "/") && !strings.HasSuffix(cleaned, "/") {
@@ -264,6 +420,17 @@ func (e *groupingFileExporter) onEvict(_ string, writer *fileWriter) {
 }
 
 func group[T any](e *groupingFileExporter, groups map[string][]T, resource pcommon.Resource, resourceEntries T) {
+	if e.template != nil {
+		values, missing := e.template.resolveAttributes(resource.Attributes())
+		if missing != "" {
+			e.logger.Debug(fmt.Sprintf("Resource does not contain %s attribute, dropping it", missing))
+			return
+		}
+		key := strings.Join(values, templateKeySeparator)
+		groups[key] = append(groups[key], resourceEntries)
+		return
+	}
+
 	var pathSegment string
 	v, ok := resource.Attributes().Get(e.attribute)
 	if ok {
@@ -291,14 +458,39 @@ func (e *groupingFileExporter) Start(_ context.Context, host component.Host) err
 	}
 	export := buildExportFunc(e.conf)
 
-	pathParts := strings.Split(e.conf.Path, "*")
+	if isPathTemplate(e.conf.Path) {
+		e.template, err = parsePathTemplate(e.conf.Path)
+		if err != nil {
+			return err
+		}
+		e.partitionFiles = map[string]struct{}{}
+		if e.now == nil {
+			e.now = func() time.Time {
+				if e.conf.GroupBy.LocalTime {
+					return time.Now()
+				}
+				return time.Now().UTC()
+			}
+		}
+	} else {
+		pathParts := strings.Split(e.conf.Path, "*")
+		e.pathPrefix = cleanPathPrefix(pathParts[0])
+		e.attribute = e.conf.GroupBy.ResourceAttribute
+		e.pathSuffix = pathParts[1]
+	}
 
-	e.pathPrefix = cleanPathPrefix(pathParts[0])
-	e.attribute = e.conf.GroupBy.ResourceAttribute
-	e.pathSuffix = pathParts[1]
 	e.maxOpenFiles = e.conf.GroupBy.MaxOpenFiles
-	e.newFileWriter = func(path string) (*fileWriter, error) {
-		return newFileWriter(path, e.conf.Append, nil, e.conf.FlushInterval, export)
+	e.columnarParts = map[string]int{}
+	e.newFileWriter = func(path string, shouldAppend bool) (*fileWriter, error) {
+		writer, err := newFileWriter(path, shouldAppend, nil, e.conf.FlushInterval, export)
+		if err != nil {
+			return nil, err
+		}
+		if e.conf.isColumnar() {
+			// Each resource partition gets its own columnar file.
+			writer.file = newColumnarWriteCloser(writer.file, e.conf.FormatType, e.conf.Compression, e.conf.rowGroupSize())
+		}
+		return writer, nil
 	}
 
 	writers, err := simplelru.NewLRU(e.conf.GroupBy.MaxOpenFiles, e.onEvict)
@@ -308,12 +500,22 @@ func (e *groupingFileExporter) Start(_ context.Context, host component.Host) err
 
 	e.writers = writers
 
+	if e.template != nil && e.template.granularity != granularityNone {
+		e.startRoller()
+	}
+
 	return nil
 }
 
 // Shutdown stops the exporter and is invoked during shutdown.
 // It stops flushes and closes all underlying writers.
 func (e *groupingFileExporter) Shutdown(context.Context) error {
+	if e.stopRoller != nil {
+		close(e.stopRoller)
+		e.rollerDone.Wait()
+		e.stopRoller = nil
+	}
+
 	e.mutex.Lock()
 	defer e.mutex.Unlock()
 
@@ -323,6 +525,10 @@ func (e *groupingFileExporter) Shutdown(context.Context) error {
 
 	e.writers.Purge()
 	e.writers = nil
+	if e.template != nil {
+		e.writeManifest()
+		e.partitionFiles = nil
+	}
 
 	return nil
 }
@@ -6,9 +6,13 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -414,6 +418,96 @@ func TestFullPath(t *testing.T) {
 	}
 }
 
+func TestGroupingFileExporterPathTemplate(t *testing.T) {
+	tmpDir := t.TempDir()
+	manifestPath := tmpDir + "/manifest.jsonl"
+	conf := &Config{
+		Path:       tmpDir + "/{service.name}/{tenant}/%Y/%m/%d/%H.log",
+		FormatType: formatTypeJSON,
+		GroupBy: &GroupBy{
+			Enabled:      true,
+			MaxOpenFiles: defaultMaxOpenFiles,
+			Manifest:     manifestPath,
+		},
+	}
+	zapCore, logs := observer.New(zap.DebugLevel)
+	feI := newFileExporter(conf, zap.New(zapCore))
+	require.IsType(t, &groupingFileExporter{}, feI)
+	gfe := feI.(*groupingFileExporter)
+
+	var now atomic.Int64
+	now.Store(time.Date(2024, time.March, 5, 10, 30, 0, 0, time.UTC).UnixNano())
+	gfe.now = func() time.Time {
+		return time.Unix(0, now.Load()).UTC()
+	}
+
+	newLogs := func(resources ...map[string]string) plog.Logs {
+		ld := plog.NewLogs()
+		for _, attrs := range resources {
+			rl := ld.ResourceLogs().AppendEmpty()
+			for k, v := range attrs {
+				rl.Resource().Attributes().PutStr(k, v)
+			}
+			rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("body")
+		}
+		return ld
+	}
+
+	require.NoError(t, gfe.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, gfe.consumeLogs(context.Background(), newLogs(
+		map[string]string{"service.name": "checkout", "tenant": "a"},
+		map[string]string{"service.name": "cart", "tenant": "b/c"},
+		map[string]string{"service.name": "cart"},
+	)))
+	assert.Equal(t, 1, logs.FilterMessage("Resource does not contain tenant attribute, dropping it").Len())
+
+	// Writing in the next hour closes the files of the previous one.
+	now.Store(time.Date(2024, time.March, 5, 11, 0, 0, 0, time.UTC).UnixNano())
+	require.NoError(t, gfe.consumeLogs(context.Background(), newLogs(
+		map[string]string{"service.name": "checkout", "tenant": "a"},
+	)))
+	assert.Equal(t, 1, gfe.writers.Len())
+	require.NoError(t, gfe.Shutdown(context.Background()))
+
+	for _, path := range []string{
+		tmpDir + "/checkout/a/2024/03/05/10.log",
+		tmpDir + "/cart/b_c/2024/03/05/10.log",
+		tmpDir + "/checkout/a/2024/03/05/11.log",
+	} {
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		got, err := (&plog.JSONUnmarshaler{}).UnmarshalLogs(bytes.TrimSpace(content))
+		require.NoError(t, err)
+		assert.Equal(t, 1, got.LogRecordCount())
+	}
+
+	manifest, err := os.ReadFile(manifestPath)
+	require.NoError(t, err)
+	var entries []manifestEntry
+	for _, line := range bytes.Split(bytes.TrimSpace(manifest), []byte("\n")) {
+		var entry manifestEntry
+		require.NoError(t, json.Unmarshal(line, &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	hour10 := time.Date(2024, time.March, 5, 10, 0, 0, 0, time.UTC)
+	hour11 := hour10.Add(time.Hour)
+	for i, want := range []struct {
+		path  string
+		start time.Time
+	}{
+		{path: tmpDir + "/cart/b_c/2024/03/05/10.log", start: hour10},
+		{path: tmpDir + "/checkout/a/2024/03/05/10.log", start: hour10},
+		{path: tmpDir + "/checkout/a/2024/03/05/11.log", start: hour11},
+	} {
+		assert.Equal(t, want.path, entries[i].Path)
+		require.NotNil(t, entries[i].PartitionStart)
+		assert.True(t, want.start.Equal(*entries[i].PartitionStart))
+		assert.True(t, want.start.Add(time.Hour).Equal(*entries[i].PartitionEnd))
+		assert.Positive(t, entries[i].SizeBytes)
+	}
+}
+
 func BenchmarkExporters(b *testing.B) {
 	tests := []struct {
 		name string
@@ -508,3 +602,50 @@ func BenchmarkExporters(b *testing.B) {
 		assert.NoError(b, fe.Shutdown(context.Background()))
 	}
 }
+
+func TestGroupingFileExporterConcurrentEviction(t *testing.T) {
+	tmpDir := t.TempDir()
+	conf := &Config{
+		Path:       tmpDir + "/*.log",
+		FormatType: formatTypeJSON,
+		Append:     true,
+		GroupBy: &GroupBy{
+			Enabled:           true,
+			ResourceAttribute: "tenant",
+			MaxOpenFiles:      1,
+		},
+	}
+	gfe := newFileExporter(conf, zap.NewNop()).(*groupingFileExporter)
+	require.NoError(t, gfe.Start(context.Background(), componenttest.NewNopHost()))
+
+	// The writers of the two tenants keep evicting each other while being written to.
+	const goroutines, writes = 8, 50
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < writes; j++ {
+				ld := plog.NewLogs()
+				rl := ld.ResourceLogs().AppendEmpty()
+				rl.Resource().Attributes().PutStr("tenant", fmt.Sprintf("t%d", (i+j)%2))
+				rl.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty().Body().SetStr("body")
+				assert.NoError(t, gfe.consumeLogs(context.Background(), ld))
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, gfe.Shutdown(context.Background()))
+
+	var lines int
+	for _, tenant := range []string{"t0", "t1"} {
+		f, err := os.Open(tmpDir + "/" + tenant + ".log")
+		require.NoError(t, err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			lines++
+		}
+		require.NoError(t, f.Close())
+	}
+	assert.Equal(t, goroutines*writes, lines)
+}
@@ -0,0 +1,214 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/fileexporter"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// timeGranularity is the duration of the time partitions of a path template, given by its finest time directive.
+type timeGranularity int
+
+const (
+	granularityNone timeGranularity = iota
+	granularityYear
+	granularityMonth
+	granularityDay
+	granularityHour
+	granularityMinute
+)
+
+var timeDirectives = map[byte]timeGranularity{
+	'Y': granularityYear,
+	'm': granularityMonth,
+	'd': granularityDay,
+	'H': granularityHour,
+	'M': granularityMinute,
+}
+
+type templatePartKind int
+
+const (
+	templatePartLiteral templatePartKind = iota
+	templatePartAttribute
+	templatePartTime
+)
+
+type templatePart struct {
+	kind templatePartKind
+	// value is the literal text, the resource attribute name or the time directive.
+	value string
+}
+
+// pathTemplate is a path with resource attribute placeholders, such as {service.name}, and
+// strftime-like time directives: %Y, %m, %d, %H, %M, and %% for a literal %.
+type pathTemplate struct {
+	parts       []templatePart
+	attributes  []string
+	granularity timeGranularity
+}
+
+// isPathTemplate returns whether path is a template: it has no *, which is the placeholder of the
+// group_by paths without template, and has at least one attribute placeholder or time directive.
+// The paths with a * or without any placeholder keep their literal { and % characters.
+func isPathTemplate(path string) bool {
+	if strings.Contains(path, "*") {
+		return false
+	}
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			if end := strings.IndexByte(path[i:], '}'); end > 1 {
+				return true
+			}
+		case '%':
+			if i+1 < len(path) && path[i+1] == '%' {
+				i++
+				continue
+			}
+			if i+1 < len(path) {
+				if _, ok := timeDirectives[path[i+1]]; ok {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func parsePathTemplate(path string) (*pathTemplate, error) {
+	t := &pathTemplate{}
+	var literal strings.Builder
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			t.parts = append(t.parts, templatePart{kind: templatePartLiteral, value: literal.String()})
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			end := strings.IndexByte(path[i:], '}')
+			if end < 0 {
+				return nil, errors.New("path template has an unterminated {")
+			}
+			name := path[i+1 : i+end]
+			if name == "" {
+				return nil, errors.New("path template has an empty attribute placeholder")
+			}
+			flushLiteral()
+			t.parts = append(t.parts, templatePart{kind: templatePartAttribute, value: name})
+			t.attributes = append(t.attributes, name)
+			i += end
+		case '%':
+			if i+1 >= len(path) {
+				return nil, errors.New("path template ends with %")
+			}
+			i++
+			if path[i] == '%' {
+				literal.WriteByte('%')
+				continue
+			}
+			granularity, ok := timeDirectives[path[i]]
+			if !ok {
+				return nil, fmt.Errorf("path template has an unsupported time directive %%%c", path[i])
+			}
+			flushLiteral()
+			t.parts = append(t.parts, templatePart{kind: templatePartTime, value: path[i : i+1]})
+			t.granularity = max(t.granularity, granularity)
+		default:
+			literal.WriteByte(path[i])
+		}
+	}
+	flushLiteral()
+
+	if len(t.parts) == 0 || t.parts[0].kind != templatePartLiteral {
+		return nil, errors.New("path must not start with a template placeholder when group_by is enabled")
+	}
+	return t, nil
+}
+
+// resolveAttributes returns the values of the attributes of the template, in order.
+// It returns the name of the first missing attribute, if any.
+func (t *pathTemplate) resolveAttributes(attrs pcommon.Map) ([]string, string) {
+	values := make([]string, len(t.attributes))
+	for i, name := range t.attributes {
+		v, ok := attrs.Get(name)
+		if !ok {
+			return nil, name
+		}
+		values[i] = sanitizePathValue(v.AsString())
+	}
+	return values, ""
+}
+
+// sanitizePathValue makes sure an attribute value is a single path element, so that it can't
+// change the directory structure given by the template.
+func sanitizePathValue(v string) string {
+	v = strings.NewReplacer("/", "_", "\\", "_", templateKeySeparator, "_").Replace(v)
+	if v == "" || v == "." || v == ".." {
+		return "_"
+	}
+	return v
+}
+
+// render returns the path for the attribute values returned by resolveAttributes, at time now.
+func (t *pathTemplate) render(values []string, now time.Time) string {
+	var sb strings.Builder
+	attr := 0
+	for _, part := range t.parts {
+		switch part.kind {
+		case templatePartLiteral:
+			sb.WriteString(part.value)
+		case templatePartAttribute:
+			sb.WriteString(values[attr])
+			attr++
+		case templatePartTime:
+			switch part.value {
+			case "Y":
+				fmt.Fprintf(&sb, "%04d", now.Year())
+			case "m":
+				fmt.Fprintf(&sb, "%02d", int(now.Month()))
+			case "d":
+				fmt.Fprintf(&sb, "%02d", now.Day())
+			case "H":
+				fmt.Fprintf(&sb, "%02d", now.Hour())
+			case "M":
+				fmt.Fprintf(&sb, "%02d", now.Minute())
+			}
+		}
+	}
+	return sb.String()
+}
+
+// partitionBounds returns the start and end of the time partition holding now.
+// Both are zero if the template has no time directive.
+func (t *pathTemplate) partitionBounds(now time.Time) (time.Time, time.Time) {
+	y, mo, d := now.Date()
+	h, mi, loc := now.Hour(), now.Minute(), now.Location()
+	switch t.granularity {
+	case granularityYear:
+		start := time.Date(y, 1, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(1, 0, 0)
+	case granularityMonth:
+		start := time.Date(y, mo, 1, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 1, 0)
+	case granularityDay:
+		start := time.Date(y, mo, d, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 0, 1)
+	case granularityHour:
+		start := time.Date(y, mo, d, h, 0, 0, 0, loc)
+		return start, start.Add(time.Hour)
+	case granularityMinute:
+		start := time.Date(y, mo, d, h, mi, 0, 0, loc)
+		return start, start.Add(time.Minute)
+	}
+	return time.Time{}, time.Time{}
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileexporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func TestIsPathTemplate(t *testing.T) {
+	for path, want := range map[string]bool{
+		"./data/{service.name}.jsonl":   true,
+		"./data/%Y/%m.jsonl":            true,
+		"./data/100%%/{a}.jsonl":        true,
+		"./data/{service.name}/*.jsonl": false,
+		"./data/100%/*.jsonl":           false,
+		"./data/%Y/*.jsonl":             false,
+		"./data/{}.jsonl":               false,
+		"./data/100%%Y.jsonl":           false,
+		"./data/100%.jsonl":             false,
+	} {
+		assert.Equal(t, want, isPathTemplate(path), path)
+	}
+}
+
+func TestParsePathTemplate(t *testing.T) {
+	tests := []struct {
+		path        string
+		granularity timeGranularity
+		attributes  []string
+		wantErr     string
+	}{
+		{
+			path:        "/data/{service.name}/%Y/%m/%d/%H.jsonl",
+			granularity: granularityHour,
+			attributes:  []string{"service.name"},
+		},
+		{
+			path:        "/data/{k8s.namespace.name}/{service.name}.jsonl",
+			granularity: granularityNone,
+			attributes:  []string{"k8s.namespace.name", "service.name"},
+		},
+		{
+			path:        "/data/100%%/%Y-%m.jsonl",
+			granularity: granularityMonth,
+		},
+		{
+			path:    "{service.name}/%Y.jsonl",
+			wantErr: "path must not start with a template placeholder when group_by is enabled",
+		},
+		{
+			path:    "/data/{service.name.jsonl",
+			wantErr: "path template has an unterminated {",
+		},
+		{
+			path:    "/data/{}.jsonl",
+			wantErr: "path template has an empty attribute placeholder",
+		},
+		{
+			path:    "/data/%S.jsonl",
+			wantErr: "path template has an unsupported time directive %S",
+		},
+		{
+			path:    "/data/%",
+			wantErr: "path template ends with %",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parsePathTemplate(tt.path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.granularity, got.granularity)
+			assert.Equal(t, tt.attributes, got.attributes)
+		})
+	}
+}
+
+func TestPathTemplateRender(t *testing.T) {
+	tmpl, err := parsePathTemplate("/data/{service.name}/{host.name}/%Y/%m/%d/%H%M-100%%.jsonl")
+	require.NoError(t, err)
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("service.name", "../etc")
+	attrs.PutInt("host.name", 42)
+	values, missing := tmpl.resolveAttributes(attrs)
+	require.Empty(t, missing)
+
+	now := time.Date(2024, time.March, 5, 7, 9, 30, 0, time.UTC)
+	assert.Equal(t, "/data/.._etc/42/2024/03/05/0709-100%.jsonl", tmpl.render(values, now))
+
+	attrs.Remove("host.name")
+	_, missing = tmpl.resolveAttributes(attrs)
+	assert.Equal(t, "host.name", missing)
+}
+
+func TestSanitizePathValue(t *testing.T) {
+	assert.Equal(t, "a_b_c", sanitizePathValue("a/b\\c"))
+	assert.Equal(t, "_", sanitizePathValue(".."))
+	assert.Equal(t, "_", sanitizePathValue("."))
+	assert.Equal(t, "_", sanitizePathValue(""))
+	assert.Equal(t, "..a", sanitizePathValue("..a"))
+}
+
+func TestPathTemplatePartitionBounds(t *testing.T) {
+	now := time.Date(2024, time.December, 31, 23, 59, 30, 0, time.UTC)
+	tests := []struct {
+		path  string
+		start time.Time
+		end   time.Time
+	}{
+		{
+			path:  "/data/%Y.jsonl",
+			start: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path:  "/data/%Y/%m.jsonl",
+			start: time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path:  "/data/%d.jsonl",
+			start: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path:  "/data/%H.jsonl",
+			start: time.Date(2024, time.December, 31, 23, 0, 0, 0, time.UTC),
+			end:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path:  "/data/%H%M.jsonl",
+			start: time.Date(2024, time.December, 31, 23, 59, 0, 0, time.UTC),
+			end:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			path: "/data/{service.name}.jsonl",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			tmpl, err := parsePathTemplate(tt.path)
+			require.NoError(t, err)
+			start, end := tmpl.partitionBounds(now)
+			assert.Equal(t, tt.start, start)
+			assert.Equal(t, tt.end, end)
+		})
+	}
+}
@@ -57,6 +57,10 @@ type Config struct {
 
 	// RemoteWriteProtoMsg controls whether prometheus remote write v1 or v2 is sent.
 	RemoteWriteProtoMsg config.RemoteWriteProtoMsg `mapstructure:"protobuf_message,omitempty"`
+
+	// ConvertHistogramsToNHCB controls whether explicit-bucket histograms are sent as native histograms
+	// with custom buckets instead of classic histogram series, this option is only supported when using PRW 2.0.
+	ConvertHistogramsToNHCB bool `mapstructure:"convert_histograms_to_nhcb"`
 }
 
 type TargetInfo struct {
@@ -133,5 +137,13 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("remote write v2 is only supported with the feature gate %s", enableSendingRW2FeatureGate.ID())
 	}
 
+	if cfg.ConvertHistogramsToNHCB && cfg.RemoteWriteProtoMsg != config.RemoteWriteProtoMsgV2 {
+		return errors.New("convert_histograms_to_nhcb is only supported with remote write v2")
+	}
+
+	if cfg.WAL != nil && cfg.WAL.Sharding != nil && cfg.RemoteWriteProtoMsg == config.RemoteWriteProtoMsgV2 {
+		return errors.New("wal sharding is only supported with remote write v1")
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/collector/exporter/exporterhelper"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/testutil"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/resourcetotelemetry"
 )
 
@@ -105,6 +106,14 @@ func TestLoadConfig(t *testing.T) {
 			id:           component.NewIDWithName(metadata.Type, "unknown_protobuf_message"),
 			errorMessage: "unknown remote write protobuf message io.prometheus.write.v4.Request, supported: prometheus.WriteRequest, io.prometheus.write.v2.Request",
 		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "nhcb_without_rw2"),
+			errorMessage: "convert_histograms_to_nhcb is only supported with remote write v2",
+		},
+		{
+			id:           component.NewIDWithName(metadata.Type, "wal_sharding_without_attribute"),
+			errorMessage: "wal sharding requires a resource_attribute",
+		},
 	}
 
 	for _, tt := range tests {
@@ -126,6 +135,19 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+func TestWALShardingWithRW2(t *testing.T) {
+	t.Cleanup(testutil.SetFeatureGateForTest(t, enableSendingRW2FeatureGate, true))
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+	cfg := NewFactory().CreateDefaultConfig()
+	sub, err := cm.Sub(component.NewIDWithName(metadata.Type, "wal_sharding_with_rw2").String())
+	require.NoError(t, err)
+	require.NoError(t, sub.Unmarshal(cfg))
+
+	assert.ErrorContains(t, xconfmap.Validate(cfg), "wal sharding is only supported with remote write v1")
+}
+
 func TestDisabledQueue(t *testing.T) {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
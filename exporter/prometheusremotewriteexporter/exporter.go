@@ -94,6 +94,7 @@ type prwExporter struct {
 	retrySettings       configretry.BackOffConfig
 	retryOnHTTP429      bool
 	wal                 *prweWAL
+	walShards           *walShards
 	exporterSettings    prometheusremotewrite.Settings
 	telemetry           prwTelemetry
 	RemoteWriteProtoMsg config.RemoteWriteProtoMsg
@@ -171,6 +172,8 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 			DisableTargetInfo: !cfg.TargetInfo.Enabled,
 			AddMetricSuffixes: cfg.AddMetricSuffixes,
 			SendMetadata:      cfg.SendMetadata,
+
+			ConvertHistogramsToNHCB: cfg.ConvertHistogramsToNHCB,
 		},
 		telemetry:      telemetry,
 		batchStatePool: sync.Pool{New: func() any { return newBatchTimeServicesState() }},
@@ -182,6 +185,7 @@ func newPRWExporter(cfg *Config, set exporter.Settings) (*prwExporter, error) {
 	if err != nil {
 		return nil, err
 	}
+	prwe.walShards = newWALShards(cfg.WAL, set, prwe.export)
 	return prwe, nil
 }
 
@@ -198,7 +202,11 @@ func (prwe *prwExporter) shutdownWALIfEnabled() error {
 	if !prwe.walEnabled() {
 		return nil
 	}
-	return prwe.wal.stop()
+	err := prwe.wal.stop()
+	if prwe.walShards != nil {
+		err = multierr.Append(err, prwe.walShards.stop())
+	}
+	return err
 }
 
 // Shutdown stops the exporter from accepting incoming calls(and return error), and wait for current export operations
@@ -215,6 +223,29 @@ func (prwe *prwExporter) Shutdown(context.Context) error {
 }
 
 func (prwe *prwExporter) pushMetricsV1(ctx context.Context, md pmetric.Metrics) error {
+	if prwe.walShards == nil {
+		return prwe.translateAndExportV1(ctx, md, prwe.wal)
+	}
+
+	var errs error
+	for shard, shardMetrics := range prwe.walShards.split(md) {
+		wal := prwe.wal
+		if shard != "" {
+			shardWAL, err := prwe.walShards.get(shard)
+			if err != nil {
+				errs = multierr.Append(errs, consumererror.NewPermanent(err))
+				continue
+			}
+			if shardWAL != nil {
+				wal = shardWAL
+			}
+		}
+		errs = multierr.Append(errs, prwe.translateAndExportV1(ctx, shardMetrics, wal))
+	}
+	return errs
+}
+
+func (prwe *prwExporter) translateAndExportV1(ctx context.Context, md pmetric.Metrics, wal *prweWAL) error {
 	tsMap, err := prometheusremotewrite.FromMetrics(md, prwe.exporterSettings)
 
 	prwe.telemetry.recordTranslatedTimeSeries(ctx, len(tsMap))
@@ -228,7 +259,7 @@ func (prwe *prwExporter) pushMetricsV1(ctx context.Context, md pmetric.Metrics)
 		prwe.settings.Logger.Debug("failed to translate metrics, exporting remaining metrics", zap.Error(err), zap.Int("translated", len(tsMap)))
 	}
 	// Call export even if a conversion error, since there may be points that were successfully converted.
-	return prwe.handleExport(ctx, tsMap, m)
+	return prwe.handleExportToWAL(ctx, tsMap, m, wal)
 }
 
 // PushMetrics converts metrics to Prometheus remote write TimeSeries and send to remote endpoint. It maintain a map of
@@ -273,6 +304,12 @@ func validateAndSanitizeExternalLabels(cfg *Config) (map[string]string, error) {
 }
 
 func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*prompb.TimeSeries, m []*prompb.MetricMetadata) error {
+	return prwe.handleExportToWAL(ctx, tsMap, m, prwe.wal)
+}
+
+// handleExportToWAL batches tsMap and m into write requests and persists them to wal, or exports
+// them directly if wal is nil.
+func (prwe *prwExporter) handleExportToWAL(ctx context.Context, tsMap map[string]*prompb.TimeSeries, m []*prompb.MetricMetadata, wal *prweWAL) error {
 	// There are no metrics to export, so return.
 	if len(tsMap) == 0 {
 		return nil
@@ -285,19 +322,19 @@ func (prwe *prwExporter) handleExport(ctx context.Context, tsMap map[string]*pro
 	if err != nil {
 		return err
 	}
-	if !prwe.walEnabled() {
+	if wal == nil {
 		// Perform a direct export otherwise.
 		return prwe.export(ctx, requests)
 	}
 
 	// Otherwise the WAL is enabled, and just persist the requests to the WAL
-	prwe.wal.telemetry.recordWALWrites(ctx)
+	wal.telemetry.recordWALWrites(ctx)
 	start := time.Now()
-	err = prwe.wal.persistToWAL(requests)
+	err = wal.persistToWAL(requests)
 	duration := time.Since(start)
-	prwe.wal.telemetry.recordWALWriteLatency(ctx, duration.Milliseconds())
+	wal.telemetry.recordWALWriteLatency(ctx, duration.Milliseconds())
 	if err != nil {
-		prwe.wal.telemetry.recordWALWritesFailures(ctx)
+		wal.telemetry.recordWALWritesFailures(ctx)
 		return consumererror.NewPermanent(err)
 	}
 	return nil
@@ -487,5 +524,11 @@ func (prwe *prwExporter) turnOnWALIfEnabled(ctx context.Context) error {
 		<-prwe.closeChan
 		cancel()
 	}()
-	return prwe.wal.run(cancelCtx)
+	if err := prwe.wal.run(cancelCtx); err != nil {
+		return err
+	}
+	if prwe.walShards != nil {
+		return prwe.walShards.start(cancelCtx)
+	}
+	return nil
 }
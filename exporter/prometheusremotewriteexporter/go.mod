@@ -14,6 +14,7 @@ require (
 	github.com/prometheus/prometheus v0.304.3-0.20250703114031-419d436a447a
 	github.com/stretchr/testify v1.10.0
 	github.com/tidwall/wal v1.1.8
+	go.opentelemetry.io/collector/client v1.35.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/component v1.35.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/component/componenttest v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/config/confighttp v0.129.1-0.20250703115036-26a1aed9c04b
@@ -106,7 +107,6 @@ require (
 	github.com/tidwall/pretty v1.2.0 // indirect
 	github.com/tidwall/tinylru v1.1.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
-	go.opentelemetry.io/collector/client v1.35.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/config/configauth v0.129.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/config/configcompression v1.35.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/config/configmiddleware v0.129.1-0.20250703115036-26a1aed9c04b // indirect
@@ -5,11 +5,15 @@ package prometheusremotewriteexporter // import "github.com/open-telemetry/opent
 
 import (
 	"errors"
+	"slices"
 	"sort"
 
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 )
 
+// batchTimeSeriesV2 splits series into multiple batch write requests. Each request carries its own
+// symbols table, holding only the symbols referenced by the series of that request, so that the
+// shared symbols table of a large translation is not repeated in every request.
 func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev2.SymbolsTable, maxBatchByteSize int, state *batchTimeSeriesState) ([]*writev2.Request, error) {
 	if len(tsMap) == 0 {
 		return nil, errors.New("invalid tsMap: cannot be empty map")
@@ -17,36 +21,33 @@ func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev
 
 	requests := make([]*writev2.Request, 0, max(10, state.nextRequestBufferSize))
 	tsArray := make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)))
+	symbols := symbolsTable.Symbols()
+	requestSymbols := newRequestSymbolsV2()
 
-	// Calculate symbols table size once since it's shared across batches
-	symbolsSize := 0
-	for _, symbol := range symbolsTable.Symbols() {
-		symbolsSize += len(symbol)
-	}
-
-	sizeOfCurrentBatch := symbolsSize // Initialize with symbols table size
+	sizeOfCurrentBatch := 0
 	i := 0
 
 	for _, v := range tsMap {
-		sizeOfSeries := v.Size()
+		sizeOfSeries := v.Size() + requestSymbols.sizeOfNewSymbols(v, symbols)
 
-		if sizeOfCurrentBatch+sizeOfSeries >= maxBatchByteSize {
+		if sizeOfCurrentBatch+sizeOfSeries >= maxBatchByteSize && len(tsArray) != 0 {
 			state.nextTimeSeriesBufferSize = max(10, 2*len(tsArray))
-			wrapped := convertTimeseriesToRequestV2(tsArray, symbolsTable)
+			wrapped := convertTimeseriesToRequestV2(tsArray, requestSymbols.symbols)
 			requests = append(requests, wrapped)
 
 			tsArray = make([]writev2.TimeSeries, 0, min(state.nextTimeSeriesBufferSize, len(tsMap)-i))
-			sizeOfCurrentBatch = symbolsSize // Reset to symbols table size for new batch
+			requestSymbols = newRequestSymbolsV2()
+			sizeOfSeries = v.Size() + requestSymbols.sizeOfNewSymbols(v, symbols)
+			sizeOfCurrentBatch = 0
 		}
 
-		tsArray = append(tsArray, *v)
+		tsArray = append(tsArray, requestSymbols.remap(v, symbols))
 		sizeOfCurrentBatch += sizeOfSeries
 		i++
 	}
 
 	if len(tsArray) != 0 {
-		// TODO only sent necessary part of the symbolsTable
-		wrapped := convertTimeseriesToRequestV2(tsArray, symbolsTable)
+		wrapped := convertTimeseriesToRequestV2(tsArray, requestSymbols.symbols)
 		requests = append(requests, wrapped)
 	}
 
@@ -54,7 +55,7 @@ func batchTimeSeriesV2(tsMap map[string]*writev2.TimeSeries, symbolsTable writev
 	return requests, nil
 }
 
-func convertTimeseriesToRequestV2(tsArray []writev2.TimeSeries, symbolsTable writev2.SymbolsTable) *writev2.Request {
+func convertTimeseriesToRequestV2(tsArray []writev2.TimeSeries, symbols []string) *writev2.Request {
 	return &writev2.Request{
 		// Prometheus requires time series to be sorted by Timestamp to avoid out of order problems.
 		// See:
@@ -62,7 +63,71 @@ func convertTimeseriesToRequestV2(tsArray []writev2.TimeSeries, symbolsTable wri
 		// * https://github.com/open-telemetry/opentelemetry-collector/issues/2315
 		// TODO: try to sort while batching?
 		Timeseries: orderBySampleTimestampV2(tsArray),
-		Symbols:    symbolsTable.Symbols(),
+		Symbols:    symbols,
+	}
+}
+
+// requestSymbolsV2 interns the symbols used by the series of a single request.
+type requestSymbolsV2 struct {
+	symbols []string
+	// refs maps references in the translation symbols table to references in symbols.
+	refs map[uint32]uint32
+}
+
+func newRequestSymbolsV2() *requestSymbolsV2 {
+	return &requestSymbolsV2{
+		// Empty string is required as a first element.
+		symbols: []string{""},
+		refs:    map[uint32]uint32{0: 0},
+	}
+}
+
+// sizeOfNewSymbols returns the size in bytes of the symbols referenced by ts that are not yet part of the request.
+// Symbols referenced more than once by ts are counted each time, which only overestimates the request size.
+func (r *requestSymbolsV2) sizeOfNewSymbols(ts *writev2.TimeSeries, symbols []string) int {
+	size := 0
+	forEachSymbolRefV2(ts, func(ref *uint32) {
+		if _, ok := r.refs[*ref]; !ok {
+			size += len(symbols[*ref])
+		}
+	})
+	return size
+}
+
+// remap returns a copy of ts whose symbol references point into the request symbols, interning them as needed.
+func (r *requestSymbolsV2) remap(ts *writev2.TimeSeries, symbols []string) writev2.TimeSeries {
+	out := *ts
+	out.LabelsRefs = slices.Clone(ts.LabelsRefs)
+	if len(ts.Exemplars) > 0 {
+		out.Exemplars = make([]writev2.Exemplar, len(ts.Exemplars))
+		for i, e := range ts.Exemplars {
+			out.Exemplars[i] = e
+			out.Exemplars[i].LabelsRefs = slices.Clone(e.LabelsRefs)
+		}
+	}
+	forEachSymbolRefV2(&out, func(ref *uint32) {
+		newRef, ok := r.refs[*ref]
+		if !ok {
+			newRef = uint32(len(r.symbols))
+			r.symbols = append(r.symbols, symbols[*ref])
+			r.refs[*ref] = newRef
+		}
+		*ref = newRef
+	})
+	return out
+}
+
+// forEachSymbolRefV2 calls fn with a pointer to every symbol reference held by ts.
+func forEachSymbolRefV2(ts *writev2.TimeSeries, fn func(ref *uint32)) {
+	for i := range ts.LabelsRefs {
+		fn(&ts.LabelsRefs[i])
+	}
+	fn(&ts.Metadata.HelpRef)
+	fn(&ts.Metadata.UnitRef)
+	for i := range ts.Exemplars {
+		for j := range ts.Exemplars[i].LabelsRefs {
+			fn(&ts.Exemplars[i].LabelsRefs[j])
+		}
 	}
 }
 
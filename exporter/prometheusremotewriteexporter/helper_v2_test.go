@@ -9,6 +9,7 @@ import (
 
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test_batchTimeSeriesV2 checks batchTimeSeriesV2 return the correct number of requests
@@ -111,6 +112,42 @@ func Test_batchTimeSeriesV2UpdatesStateForLargeBatches(t *testing.T) {
 	assert.Equal(t, 14, state.nextRequestBufferSize)
 }
 
+// Test_batchTimeSeriesV2OnlySendsReferencedSymbols checks that each request carries only the symbols
+// referenced by its own series, with references remapped accordingly.
+func Test_batchTimeSeriesV2OnlySendsReferencedSymbols(t *testing.T) {
+	symbolsTable := writev2.NewSymbolTable()
+	ts1 := &writev2.TimeSeries{
+		LabelsRefs: []uint32{symbolsTable.Symbolize(label11), symbolsTable.Symbolize(value11)},
+		Samples:    []writev2.Sample{getSampleV2(floatVal1, msTime1)},
+		Metadata:   writev2.Metadata{HelpRef: symbolsTable.Symbolize("help 1")},
+	}
+	ts2 := &writev2.TimeSeries{
+		LabelsRefs: []uint32{symbolsTable.Symbolize(label21), symbolsTable.Symbolize(value21)},
+		Samples:    []writev2.Sample{getSampleV2(floatVal2, msTime2)},
+		Exemplars:  []writev2.Exemplar{{LabelsRefs: []uint32{symbolsTable.Symbolize(label22), symbolsTable.Symbolize(value22)}}},
+	}
+
+	state := newBatchTimeServicesState()
+	requests, err := batchTimeSeriesV2(getTimeseriesMapV2([]*writev2.TimeSeries{ts1, ts2}), symbolsTable, ts1.Size()+10, state)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+
+	// Requests are built from map iteration, so their order is not deterministic.
+	if requests[0].Symbols[1] != label11 {
+		requests[0], requests[1] = requests[1], requests[0]
+	}
+	assert.Equal(t, []string{"", label11, value11, "help 1"}, requests[0].Symbols)
+	assert.Equal(t, []uint32{1, 2}, requests[0].Timeseries[0].LabelsRefs)
+	assert.Equal(t, uint32(3), requests[0].Timeseries[0].Metadata.HelpRef)
+	assert.Equal(t, []string{"", label21, value21, label22, value22}, requests[1].Symbols)
+	assert.Equal(t, []uint32{1, 2}, requests[1].Timeseries[0].LabelsRefs)
+	assert.Equal(t, []uint32{3, 4}, requests[1].Timeseries[0].Exemplars[0].LabelsRefs)
+
+	// The translation symbols table and series must be left untouched.
+	assert.Equal(t, []uint32{1, 2}, ts1.LabelsRefs)
+	assert.Equal(t, []uint32{4, 5}, ts2.LabelsRefs)
+}
+
 // Ensure that before a writev2.Request is created, that the points per TimeSeries
 // are sorted by Timestamp value, to prevent Prometheus from barfing when it gets poorly
 // sorted values. See issues:
@@ -31,6 +31,7 @@ type prwWalTelemetry interface {
 	recordWALReadLatency(ctx context.Context, durationMs int64)
 	recordWALReads(ctx context.Context)
 	recordWALReadsFailures(ctx context.Context)
+	recordWALLag(ctx context.Context, lag int64)
 }
 
 type prwWalTelemetryOTel struct {
@@ -62,14 +63,18 @@ func (p *prwWalTelemetryOTel) recordWALReadsFailures(ctx context.Context) {
 	p.telemetryBuilder.ExporterPrometheusremotewriteWalReadsFailures.Add(ctx, 1, metric.WithAttributes(p.otelAttrs...))
 }
 
-func newPRWWalTelemetry(set exporter.Settings) (prwWalTelemetry, error) {
+func (p *prwWalTelemetryOTel) recordWALLag(ctx context.Context, lag int64) {
+	p.telemetryBuilder.ExporterPrometheusremotewriteWalLag.Record(ctx, lag, metric.WithAttributes(p.otelAttrs...))
+}
+
+func newPRWWalTelemetry(set exporter.Settings, otelAttrs ...attribute.KeyValue) (prwWalTelemetry, error) {
 	telemetryBuilder, err := metadata.NewTelemetryBuilder(set.TelemetrySettings)
 	if err != nil {
 		return nil, err
 	}
 	return &prwWalTelemetryOTel{
 		telemetryBuilder: telemetryBuilder,
-		otelAttrs:        []attribute.KeyValue{},
+		otelAttrs:        otelAttrs,
 	}, nil
 }
 
@@ -87,6 +92,8 @@ type prweWAL struct {
 	rNotify   chan struct{}
 	rWALIndex *atomic.Uint64
 	wWALIndex *atomic.Uint64
+	// exportedWALIndex is the index of the last WAL entry that was exported and committed.
+	exportedWALIndex atomic.Uint64
 
 	telemetry prwWalTelemetry
 }
@@ -100,6 +107,10 @@ type WALConfig struct {
 	Directory         string        `mapstructure:"directory"`
 	BufferSize        int           `mapstructure:"buffer_size"`
 	TruncateFrequency time.Duration `mapstructure:"truncate_frequency"`
+
+	// Sharding splits the WAL into one WAL per value of a resource attribute,
+	// each of them replayed and exported independently.
+	Sharding *WALShardingConfig `mapstructure:"sharding"`
 }
 
 func (wc *WALConfig) bufferSize() int {
@@ -131,6 +142,7 @@ func newWAL(walConfig *WALConfig, set exporter.Settings, exportSink func(context
 	return &prweWAL{
 		exportSink: exportSink,
 		walConfig:  walConfig,
+		walPath:    walConfig.path(),
 		stopChan:   make(chan struct{}),
 		rNotify:    make(chan struct{}),
 		rWALIndex:  &atomic.Uint64{},
@@ -139,16 +151,28 @@ func newWAL(walConfig *WALConfig, set exporter.Settings, exportSink func(context
 	}, nil
 }
 
+func (wc *WALConfig) path() string {
+	return filepath.Join(wc.Directory, "prom_remotewrite")
+}
+
 func (wc *WALConfig) createWAL() (*wal.Log, string, error) {
-	walPath := filepath.Join(wc.Directory, "prom_remotewrite")
+	walPath := wc.path()
+	log, err := wc.openWAL(walPath)
+	if err != nil {
+		return nil, "", err
+	}
+	return log, walPath, nil
+}
+
+func (wc *WALConfig) openWAL(walPath string) (*wal.Log, error) {
 	log, err := wal.Open(walPath, &wal.Options{
 		SegmentCacheSize: wc.bufferSize(),
 		NoCopy:           true,
 	})
 	if err != nil {
-		return nil, "", fmt.Errorf("prometheusremotewriteexporter: failed to open WAL: %w", err)
+		return nil, fmt.Errorf("prometheusremotewriteexporter: failed to open WAL: %w", err)
 	}
-	return log, walPath, nil
+	return log, nil
 }
 
 var (
@@ -166,13 +190,12 @@ func (prweWAL *prweWAL) retrieveWALIndices() (err error) {
 		return err
 	}
 
-	log, walPath, err := prweWAL.walConfig.createWAL()
+	log, err := prweWAL.walConfig.openWAL(prweWAL.walPath)
 	if err != nil {
 		return err
 	}
 
 	prweWAL.wal = log
-	prweWAL.walPath = walPath
 
 	rIndex, err := prweWAL.wal.FirstIndex()
 	if err != nil {
@@ -185,9 +208,22 @@ func (prweWAL *prweWAL) retrieveWALIndices() (err error) {
 		return fmt.Errorf("prometheusremotewriteexporter: failed to retrieve the last WAL index: %w", err)
 	}
 	prweWAL.wWALIndex.Store(wIndex)
+
+	var exported uint64
+	if rIndex > 0 {
+		exported = rIndex - 1
+	}
+	prweWAL.exportedWALIndex.Store(exported)
+	prweWAL.recordLag()
 	return nil
 }
 
+// recordLag records the number of requests in the WAL that were not exported yet.
+func (prweWAL *prweWAL) recordLag() {
+	lag := int64(prweWAL.wWALIndex.Load()) - int64(prweWAL.exportedWALIndex.Load())
+	prweWAL.telemetry.recordWALLag(context.Background(), max(lag, 0))
+}
+
 func (prweWAL *prweWAL) stop() error {
 	err := errAlreadyClosed
 	prweWAL.stopOnce.Do(func() {
@@ -355,11 +391,21 @@ func (prweWAL *prweWAL) exportThenFrontTruncateWAL(ctx context.Context, reqL []*
 	if errL := prweWAL.exportSink(ctx, reqL); errL != nil {
 		return errL
 	}
+	exported := prweWAL.rWALIndex.Load() - 1
 	if err := prweWAL.syncAndTruncateFront(); err != nil {
 		return err
 	}
 	// Reset by retrieving the respective read and write WAL indices.
-	return prweWAL.retrieveWALIndices()
+	if err := prweWAL.retrieveWALIndices(); err != nil {
+		return err
+	}
+	// The front of the WAL can't be truncated past its last entry, so the retrieved
+	// indices may still count the requests that were just exported.
+	if exported > prweWAL.exportedWALIndex.Load() {
+		prweWAL.exportedWALIndex.Store(exported)
+		prweWAL.recordLag()
+	}
+	return nil
 }
 
 // persistToWAL is the routine that'll be hooked into the exporter's receiving side and it'll
@@ -386,7 +432,11 @@ func (prweWAL *prweWAL) persistToWAL(requests []*prompb.WriteRequest) error {
 	default:
 	}
 
-	return prweWAL.wal.WriteBatch(batch)
+	if err := prweWAL.wal.WriteBatch(batch); err != nil {
+		return err
+	}
+	prweWAL.recordLag()
+	return nil
 }
 
 func (prweWAL *prweWAL) readPrompbFromWAL(ctx context.Context, index uint64) (wreq *prompb.WriteRequest, err error) {
@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter // import "github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter"
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/prometheus/prompb"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/exporter"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWALMaxShards = 100
+	walShardDirPrefix   = "shard_"
+)
+
+// WALShardingConfig defines how the WAL is split into independent shards.
+type WALShardingConfig struct {
+	// ResourceAttribute is the resource attribute whose value selects the shard, e.g. a tenant ID.
+	// Metrics without this attribute are written to the default, unsharded, WAL.
+	ResourceAttribute string `mapstructure:"resource_attribute"`
+
+	// MetadataKey, if set, is the client metadata key under which the shard value is added to the
+	// context of the remote write requests of the shard. This allows extensions such as headers_setter
+	// to set per-tenant headers when the WAL is replayed.
+	MetadataKey string `mapstructure:"metadata_key"`
+
+	// MaxShards is the maximum number of shards. Metrics for additional shard values are written to
+	// the default WAL. Defaults to 100.
+	MaxShards int `mapstructure:"max_shards"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
+func (sc *WALShardingConfig) Validate() error {
+	if sc.ResourceAttribute == "" {
+		return errors.New("wal sharding requires a resource_attribute")
+	}
+	if sc.MaxShards < 0 {
+		return errors.New("wal max_shards can't be negative")
+	}
+	return nil
+}
+
+func (sc *WALShardingConfig) maxShards() int {
+	if sc.MaxShards > 0 {
+		return sc.MaxShards
+	}
+	return defaultWALMaxShards
+}
+
+// shardsPath returns the directory holding one sub-directory per WAL shard.
+func (wc *WALConfig) shardsPath() string {
+	return filepath.Join(wc.Directory, "prom_remotewrite_shards")
+}
+
+// shardDirName encodes a shard value into a directory name that can be decoded back by shardFromDirName.
+func shardDirName(shard string) string {
+	return walShardDirPrefix + url.PathEscape(shard)
+}
+
+func shardFromDirName(name string) (string, bool) {
+	if !strings.HasPrefix(name, walShardDirPrefix) {
+		return "", false
+	}
+	shard, err := url.PathUnescape(strings.TrimPrefix(name, walShardDirPrefix))
+	if err != nil || shard == "" {
+		return "", false
+	}
+	return shard, true
+}
+
+// walShards holds a WAL per shard value. Each shard has its own reader, export workers and retries,
+// so that a shard with a slow or failing backend doesn't delay the replay of the other shards.
+type walShards struct {
+	cfg        *WALConfig
+	set        exporter.Settings
+	exportSink func(ctx context.Context, reqL []*prompb.WriteRequest) error
+
+	mu      sync.Mutex // mu protects the fields below.
+	shards  map[string]*prweWAL
+	runCtx  context.Context
+	started bool
+	// full is set once maxShards is reached, so that the limit is only logged once.
+	full atomic.Bool
+}
+
+func newWALShards(walConfig *WALConfig, set exporter.Settings, exportSink func(context.Context, []*prompb.WriteRequest) error) *walShards {
+	if walConfig == nil || walConfig.Sharding == nil {
+		return nil
+	}
+	return &walShards{
+		cfg:        walConfig,
+		set:        set,
+		exportSink: exportSink,
+		shards:     map[string]*prweWAL{},
+	}
+}
+
+func newShardWAL(walConfig *WALConfig, set exporter.Settings, shard string, exportSink func(context.Context, []*prompb.WriteRequest) error) (*prweWAL, error) {
+	telemetryPRWWal, err := newPRWWalTelemetry(set, attribute.String("shard", shard))
+	if err != nil {
+		return nil, err
+	}
+
+	return &prweWAL{
+		exportSink: exportSink,
+		walConfig:  walConfig,
+		walPath:    filepath.Join(walConfig.shardsPath(), shardDirName(shard)),
+		stopChan:   make(chan struct{}),
+		rNotify:    make(chan struct{}),
+		rWALIndex:  &atomic.Uint64{},
+		wWALIndex:  &atomic.Uint64{},
+		telemetry:  telemetryPRWWal,
+	}, nil
+}
+
+// start replays the shards persisted by a previous run, and makes shards created from now on start right away.
+func (ws *walShards) start(ctx context.Context) error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.runCtx = ctx
+	ws.started = true
+
+	entries, err := os.ReadDir(ws.cfg.shardsPath())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	var errs error
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		shard, ok := shardFromDirName(entry.Name())
+		if !ok {
+			continue
+		}
+		if _, err := ws.getOrCreateLocked(shard); err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// get returns the WAL for shard, creating and starting it if needed. It returns nil if the maximum
+// number of shards is reached, in which case the default WAL should be used.
+func (ws *walShards) get(shard string) (*prweWAL, error) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.getOrCreateLocked(shard)
+}
+
+func (ws *walShards) getOrCreateLocked(shard string) (*prweWAL, error) {
+	if w, ok := ws.shards[shard]; ok {
+		return w, nil
+	}
+	if len(ws.shards) >= ws.cfg.Sharding.maxShards() {
+		if !ws.full.Swap(true) {
+			ws.set.Logger.Warn("maximum number of WAL shards reached, writing additional shards to the default WAL",
+				zap.Int("max_shards", ws.cfg.Sharding.maxShards()))
+		}
+		return nil, nil
+	}
+
+	w, err := newShardWAL(ws.cfg, ws.set, shard, func(ctx context.Context, reqL []*prompb.WriteRequest) error {
+		return ws.exportSink(ws.shardContext(ctx, shard), reqL)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ws.started {
+		if err := w.run(ws.runCtx); err != nil {
+			return nil, err
+		}
+	}
+	ws.shards[shard] = w
+	return w, nil
+}
+
+// shardContext adds the shard value to the client metadata of ctx, if a metadata key is configured.
+func (ws *walShards) shardContext(ctx context.Context, shard string) context.Context {
+	if ws.cfg.Sharding.MetadataKey == "" {
+		return ctx
+	}
+	info := client.FromContext(ctx)
+	md := map[string][]string{}
+	for key := range info.Metadata.Keys() {
+		md[key] = info.Metadata.Get(key)
+	}
+	md[ws.cfg.Sharding.MetadataKey] = []string{shard}
+	info.Metadata = client.NewMetadata(md)
+	return client.NewContext(ctx, info)
+}
+
+func (ws *walShards) stop() error {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	var errs error
+	for _, w := range ws.shards {
+		if err := w.stop(); err != nil && !errors.Is(err, errAlreadyClosed) {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// split groups the resource metrics of md by the value of the sharding resource attribute.
+// Resource metrics without the attribute are grouped under the empty shard.
+func (ws *walShards) split(md pmetric.Metrics) map[string]pmetric.Metrics {
+	out := map[string]pmetric.Metrics{}
+	rms := md.ResourceMetrics()
+	for i := 0; i < rms.Len(); i++ {
+		rm := rms.At(i)
+		var shard string
+		if v, ok := rm.Resource().Attributes().Get(ws.cfg.Sharding.ResourceAttribute); ok {
+			shard = v.AsString()
+		}
+		shardMetrics, ok := out[shard]
+		if !ok {
+			shardMetrics = pmetric.NewMetrics()
+			out[shard] = shardMetrics
+		}
+		rm.CopyTo(shardMetrics.ResourceMetrics().AppendEmpty())
+	}
+	return out
+}
@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewriteexporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/client"
+	"go.opentelemetry.io/collector/exporter/exportertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/exporter/prometheusremotewriteexporter/internal/metadata"
+)
+
+func TestShardDirName(t *testing.T) {
+	for _, shard := range []string{"tenant-a", "tenant/a", "..", "a b"} {
+		name := shardDirName(shard)
+		assert.Equal(t, name, filepath.Base(name), "shard %q must map to a single path element", shard)
+		got, ok := shardFromDirName(name)
+		assert.True(t, ok)
+		assert.Equal(t, shard, got)
+	}
+
+	_, ok := shardFromDirName("prom_remotewrite")
+	assert.False(t, ok)
+}
+
+func TestWALShardsSplit(t *testing.T) {
+	ws := newWALShards(&WALConfig{Sharding: &WALShardingConfig{ResourceAttribute: "tenant.id"}}, exportertest.NewNopSettings(metadata.Type), nil)
+	require.NotNil(t, ws)
+
+	md := pmetric.NewMetrics()
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "a")
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "b")
+	md.ResourceMetrics().AppendEmpty().Resource().Attributes().PutStr("tenant.id", "a")
+	md.ResourceMetrics().AppendEmpty()
+
+	got := ws.split(md)
+	require.Len(t, got, 3)
+	assert.Equal(t, 2, got["a"].ResourceMetrics().Len())
+	assert.Equal(t, 1, got["b"].ResourceMetrics().Len())
+	assert.Equal(t, 1, got[""].ResourceMetrics().Len())
+}
+
+func TestWALShardsDisabled(t *testing.T) {
+	set := exportertest.NewNopSettings(metadata.Type)
+	assert.Nil(t, newWALShards(nil, set, nil))
+	assert.Nil(t, newWALShards(&WALConfig{Directory: t.TempDir()}, set, nil))
+}
+
+func TestWALShardsMaxShards(t *testing.T) {
+	cfg := &WALConfig{
+		Directory: t.TempDir(),
+		Sharding:  &WALShardingConfig{ResourceAttribute: "tenant.id", MaxShards: 1},
+	}
+	ws := newWALShards(cfg, exportertest.NewNopSettings(metadata.Type), doNothingExportSink)
+	t.Cleanup(func() {
+		assert.NoError(t, ws.stop())
+	})
+
+	first, err := ws.get("a")
+	require.NoError(t, err)
+	require.NotNil(t, first)
+
+	again, err := ws.get("a")
+	require.NoError(t, err)
+	assert.Same(t, first, again)
+
+	over, err := ws.get("b")
+	require.NoError(t, err)
+	assert.Nil(t, over)
+}
+
+func TestWALShardsExportAndReplay(t *testing.T) {
+	type exported struct {
+		tenant []string
+		reqL   []*prompb.WriteRequest
+	}
+	exportedCh := make(chan exported, 10)
+	sink := func(ctx context.Context, reqL []*prompb.WriteRequest) error {
+		if len(reqL) == 0 {
+			return nil
+		}
+		exportedCh <- exported{tenant: client.FromContext(ctx).Metadata.Get("X-Scope-OrgID"), reqL: reqL}
+		return nil
+	}
+
+	cfg := &WALConfig{
+		Directory:         t.TempDir(),
+		BufferSize:        1,
+		TruncateFrequency: 10 * time.Millisecond,
+		Sharding: &WALShardingConfig{
+			ResourceAttribute: "tenant.id",
+			MetadataKey:       "X-Scope-OrgID",
+		},
+	}
+	set := exportertest.NewNopSettings(metadata.Type)
+	ctx := contextWithLogger(context.Background(), zap.NewNop())
+
+	// Persist to a shard before starting, as done when a previous run left a backlog.
+	ws := newWALShards(cfg, set, sink)
+	w, err := ws.get("tenant/a")
+	require.NoError(t, err)
+	require.NoError(t, w.retrieveWALIndices())
+	req := &prompb.WriteRequest{
+		Timeseries: []prompb.TimeSeries{
+			{
+				Labels:  []prompb.Label{{Name: "__name__", Value: "test_metric"}},
+				Samples: []prompb.Sample{{Value: 1, Timestamp: 100}},
+			},
+		},
+	}
+	require.NoError(t, w.persistToWAL([]*prompb.WriteRequest{req}))
+	require.NoError(t, ws.stop())
+
+	_, err = os.Stat(filepath.Join(cfg.shardsPath(), shardDirName("tenant/a")))
+	require.NoError(t, err)
+
+	// A new instance replays the shard found on disk.
+	ws = newWALShards(cfg, set, sink)
+	require.NoError(t, ws.start(ctx))
+	t.Cleanup(func() {
+		assert.NoError(t, ws.stop())
+	})
+
+	select {
+	case got := <-exportedCh:
+		assert.Equal(t, []string{"tenant/a"}, got.tenant)
+		require.Len(t, got.reqL, 1)
+		assert.Equal(t, req.Timeseries, got.reqL[0].Timeseries)
+	case <-time.After(5 * time.Second):
+		t.Fatal("shard WAL was not replayed")
+	}
+}
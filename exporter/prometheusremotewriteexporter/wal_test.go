@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -297,6 +298,62 @@ func TestWALWrite_Telemetry(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestWALLag_Telemetry(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() {
+		require.NoError(t, tel.Shutdown(context.Background()))
+	})
+	set := metadatatest.NewSettings(tel)
+
+	cfg := &Config{
+		WAL: &WALConfig{
+			Directory:  t.TempDir(),
+			BufferSize: 1,
+		},
+		RemoteWriteQueue:    RemoteWriteQueue{NumConsumers: 1},
+		TargetInfo:          &TargetInfo{}, // Declared just to avoid nil pointer dereference.
+		RemoteWriteProtoMsg: config.RemoteWriteProtoMsgV1,
+	}
+
+	var exported atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		exported.Add(1)
+	}))
+	defer server.Close()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Endpoint = server.URL
+	cfg.ClientConfig = clientConfig
+
+	prw, err := newPRWExporter(cfg, set)
+	require.NoError(t, err)
+	require.NoError(t, prw.Start(context.Background(), componenttest.NewNopHost()))
+	t.Cleanup(func() {
+		assert.NoError(t, prw.Shutdown(context.Background()))
+	})
+
+	metrics := map[string]*prompb.TimeSeries{
+		"test_metric": {
+			Labels:  []prompb.Label{{Name: "__name__", Value: "test_metric"}},
+			Samples: []prompb.Sample{{Value: 1, Timestamp: 100}},
+		},
+	}
+	require.NoError(t, prw.handleExport(context.Background(), metrics, nil))
+
+	// The lag goes back to 0 once the request is exported and committed.
+	require.Eventually(t, func() bool {
+		if exported.Load() == 0 {
+			return false
+		}
+		m, err := tel.GetMetric("otelcol_exporter_prometheusremotewrite_wal_lag")
+		if err != nil {
+			return false
+		}
+		dps := m.Data.(metricdata.Gauge[int64]).DataPoints
+		return len(dps) == 1 && dps[0].Value == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
 func TestWALRead_Telemetry(t *testing.T) {
 	// Skip flaky test in CI, because it's flaky and hard to reliably test; still useful for local testing.
 	t.Skip("Skipping in CI: test is flaky;still useful for local testing")
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
+
+import (
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// Config is the configuration for the metadata cache extension.
+type Config struct {
+	k8sconfig.APIConfig `mapstructure:",squash"`
+
+	makeClient        func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
+	makeDynamicClient func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
+}
+
+func (cfg *Config) getK8sClient() (kubernetes.Interface, error) {
+	if cfg.makeClient == nil {
+		cfg.makeClient = k8sconfig.MakeClient
+	}
+	return cfg.makeClient(cfg.APIConfig)
+}
+
+func (cfg *Config) getDynamicClient() (dynamic.Interface, error) {
+	if cfg.makeDynamicClient == nil {
+		cfg.makeDynamicClient = k8sconfig.MakeDynamicClient
+	}
+	return cfg.makeDynamicClient(cfg.APIConfig)
+}
+
+// Validate checks if the extension configuration is valid
+func (cfg *Config) Validate() error {
+	return cfg.APIConfig.Validate()
+}
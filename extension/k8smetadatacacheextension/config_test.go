@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap/confmaptest"
+	"go.opentelemetry.io/collector/confmap/xconfmap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestLoadConfig(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		id             component.ID
+		expectedConfig component.Config
+	}{
+		{
+			id: component.NewID(metadata.Type),
+			expectedConfig: &Config{
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: "serviceAccount",
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "kubeconfig"),
+			expectedConfig: &Config{
+				APIConfig: k8sconfig.APIConfig{
+					AuthType: "kubeConfig",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id.String(), func(t *testing.T) {
+			factory := NewFactory()
+			cfg := factory.CreateDefaultConfig()
+
+			sub, err := cm.Sub(tt.id.String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+			require.NoError(t, xconfmap.Validate(cfg))
+
+			require.Equal(t, tt.expectedConfig, cfg)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.AuthType = "unknown"
+	require.Error(t, cfg.Validate())
+}
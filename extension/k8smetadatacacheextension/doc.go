@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+//go:generate mdatagen metadata.yaml
+
+// Package k8smetadatacacheextension provides an extension owning kubernetes informers shared by
+// the components of a collector, so that each resource is watched once per collector.
+package k8smetadatacacheextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
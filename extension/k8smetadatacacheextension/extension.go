@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InformerKey identifies a shared informer. The components requesting an informer with the same key
+// share the same informer, and the kubernetes API server is watched once for all of them.
+type InformerKey struct {
+	// Resource is the watched resource, e.g. {Version: "v1", Resource: "pods"}.
+	Resource schema.GroupVersionResource
+	// Namespace is the watched namespace. It is empty when all the namespaces are watched.
+	Namespace string
+	// LabelSelector and FieldSelector are the string representations of the selectors
+	// used to list and watch the objects.
+	LabelSelector string
+	FieldSelector string
+	// Transform identifies the transform the objects of the informer are stripped with. It is empty
+	// when the objects are kept whole. Components stripping the objects differently get different
+	// informers, as the stripped objects are shared.
+	Transform string
+}
+
+// InformerProvider allows components to share the kubernetes informers owned by the extension.
+type InformerProvider interface {
+	extension.Extension
+	// Client returns the kubernetes client the shared informers are expected to be created with.
+	Client() kubernetes.Interface
+	// DynamicClient returns the dynamic kubernetes client the shared informers of arbitrary
+	// resources are expected to be created with.
+	DynamicClient() dynamic.Interface
+	// Informer returns the informer identified by the key. The informer is created with newInformer
+	// and started on the first request for the key, after setting its transform to transform, if not
+	// nil, and the following requests return the same informer. The transform must be the one that
+	// key.Transform identifies. The returned informer is run and stopped by the extension: running it
+	// is a no-op, and so is setting its transform, as its objects are shared. Callers must remove the
+	// event handlers they added when they stop using the informer.
+	Informer(key InformerKey, newInformer func() cache.SharedInformer, transform cache.TransformFunc) cache.SharedInformer
+}
+
+var _ InformerProvider = (*metadataCache)(nil)
+
+// metadataCache is the main struct implementing the extension's behavior.
+type metadataCache struct {
+	logger        *zap.Logger
+	client        kubernetes.Interface
+	dynamicClient dynamic.Interface
+
+	mu        sync.Mutex
+	informers map[InformerKey]*sharedInformer
+	stopCh    chan struct{}
+	stopOnce  sync.Once
+}
+
+func newMetadataCache(logger *zap.Logger, client kubernetes.Interface, dynamicClient dynamic.Interface) *metadataCache {
+	return &metadataCache{
+		logger:        logger,
+		client:        client,
+		dynamicClient: dynamicClient,
+		informers:     map[InformerKey]*sharedInformer{},
+		stopCh:        make(chan struct{}),
+	}
+}
+
+// Start begins the extension's processing. The informers are started when they are first requested.
+func (*metadataCache) Start(context.Context, component.Host) error {
+	return nil
+}
+
+// Shutdown stops all the shared informers.
+func (mc *metadataCache) Shutdown(context.Context) error {
+	mc.stopOnce.Do(func() {
+		close(mc.stopCh)
+	})
+	return nil
+}
+
+func (mc *metadataCache) Client() kubernetes.Interface {
+	return mc.client
+}
+
+func (mc *metadataCache) DynamicClient() dynamic.Interface {
+	return mc.dynamicClient
+}
+
+func (mc *metadataCache) Informer(key InformerKey, newInformer func() cache.SharedInformer, transform cache.TransformFunc) cache.SharedInformer {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if informer, ok := mc.informers[key]; ok {
+		return informer
+	}
+
+	mc.logger.Debug("creating shared informer",
+		zap.String("resource", key.Resource.String()),
+		zap.String("namespace", key.Namespace),
+		zap.String("labelSelector", key.LabelSelector),
+		zap.String("fieldSelector", key.FieldSelector),
+		zap.String("transform", key.Transform))
+	informer := newInformer()
+	if err := informer.SetTransform(withoutManagedFields(transform)); err != nil {
+		mc.logger.Warn("failed to set the transform of the shared informer", zap.Error(err))
+	}
+	go informer.Run(mc.stopCh)
+
+	shared := &sharedInformer{SharedInformer: informer}
+	mc.informers[key] = shared
+	return shared
+}
+
+// sharedInformer is an informer shared by several components. It is run and stopped by the extension,
+// so that a component can't stop it while it is used by the others.
+type sharedInformer struct {
+	cache.SharedInformer
+}
+
+// Run does nothing, as the informer is run by the extension.
+func (*sharedInformer) Run(<-chan struct{}) {}
+
+// SetTransform does nothing, as the objects of the informer are shared by components that may need
+// different parts of them.
+func (*sharedInformer) SetTransform(cache.TransformFunc) error {
+	return nil
+}
+
+// withoutManagedFields returns a transform removing the managed fields of the objects, then applying
+// transform, if not nil.
+func withoutManagedFields(transform cache.TransformFunc) cache.TransformFunc {
+	if transform == nil {
+		return removeManagedFields
+	}
+	return func(object any) (any, error) {
+		object, err := removeManagedFields(object)
+		if err != nil {
+			return nil, err
+		}
+		return transform(object)
+	}
+}
+
+// removeManagedFields removes the managed fields, which are never needed by the components and
+// account for a large part of the size of the objects.
+func removeManagedFields(object any) (any, error) {
+	if accessor, err := meta.Accessor(object); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return object, nil
+}
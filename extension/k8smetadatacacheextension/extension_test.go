@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+var podsKey = InformerKey{Resource: schema.GroupVersionResource{Version: "v1", Resource: "pods"}}
+
+func newPodInformer(t *testing.T, mc *metadataCache, created *atomic.Int32) func() cache.SharedInformer {
+	return func() cache.SharedInformer {
+		created.Add(1)
+		return informers.NewSharedInformerFactory(mc.Client(), 0).Core().V1().Pods().Informer()
+	}
+}
+
+func TestSharedInformer(t *testing.T) {
+	client := fake.NewClientset(&api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:          "pod-1",
+			Namespace:     "default",
+			ManagedFields: []meta_v1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+	})
+	mc := newMetadataCache(zap.NewNop(), client, nil)
+	require.NoError(t, mc.Start(context.Background(), componenttest.NewNopHost()))
+
+	var created atomic.Int32
+	first := mc.Informer(podsKey, newPodInformer(t, mc, &created), nil)
+	second := mc.Informer(podsKey, newPodInformer(t, mc, &created), nil)
+	assert.Same(t, first, second)
+	other := mc.Informer(InformerKey{Resource: podsKey.Resource, Namespace: "kube-system"}, newPodInformer(t, mc, &created), nil)
+	assert.NotSame(t, first, other)
+	assert.Equal(t, int32(2), created.Load())
+
+	// running the informer and setting its transform are no-ops for the callers
+	stopCh := make(chan struct{})
+	first.Run(stopCh)
+	close(stopCh)
+	require.NoError(t, first.SetTransform(func(any) (any, error) {
+		return nil, nil
+	}))
+
+	var received []*api_v1.Pod
+	var receivedCount atomic.Int32
+	reg, err := second.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			received = append(received, obj.(*api_v1.Pod))
+			receivedCount.Add(1)
+		},
+	})
+	require.NoError(t, err)
+	require.Eventually(t, reg.HasSynced, 10*time.Second, 10*time.Millisecond)
+	require.Equal(t, int32(1), receivedCount.Load())
+	assert.Equal(t, "pod-1", received[0].Name)
+	assert.Empty(t, received[0].ManagedFields)
+	assert.False(t, first.IsStopped())
+	require.NoError(t, second.RemoveEventHandler(reg))
+
+	require.NoError(t, mc.Shutdown(context.Background()))
+	require.NoError(t, mc.Shutdown(context.Background()))
+	assert.Eventually(t, func() bool {
+		return first.IsStopped() && other.IsStopped()
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestSharedInformerTransform(t *testing.T) {
+	client := fake.NewClientset(&api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:          "pod-1",
+			Namespace:     "default",
+			Labels:        map[string]string{"app": "checkout"},
+			ManagedFields: []meta_v1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec: api_v1.PodSpec{NodeName: "node-1"},
+	})
+	mc := newMetadataCache(zap.NewNop(), client, nil)
+	require.NoError(t, mc.Start(context.Background(), componenttest.NewNopHost()))
+	defer func() {
+		require.NoError(t, mc.Shutdown(context.Background()))
+	}()
+
+	var created atomic.Int32
+	key := InformerKey{Resource: podsKey.Resource, Transform: "without-labels"}
+	informer := mc.Informer(key, newPodInformer(t, mc, &created), func(object any) (any, error) {
+		pod, ok := object.(*api_v1.Pod)
+		if !ok {
+			return object, nil
+		}
+		// the managed fields are removed before the transform is applied
+		assert.Empty(t, pod.ManagedFields)
+		return &api_v1.Pod{ObjectMeta: meta_v1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}, nil
+	})
+	// the informer of the objects kept whole isn't shared
+	assert.NotSame(t, informer, mc.Informer(podsKey, newPodInformer(t, mc, &created), nil))
+	assert.Equal(t, int32(2), created.Load())
+
+	require.Eventually(t, informer.HasSynced, 10*time.Second, 10*time.Millisecond)
+	cached := informer.GetStore().List()
+	require.Len(t, cached, 1)
+	pod := cached[0].(*api_v1.Pod)
+	assert.Equal(t, "pod-1", pod.Name)
+	assert.Empty(t, pod.Labels)
+	assert.Empty(t, pod.Spec.NodeName)
+}
+
+func TestRemoveManagedFields(t *testing.T) {
+	pod := &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:          "pod-1",
+			ManagedFields: []meta_v1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+	}
+	transformed, err := removeManagedFields(pod)
+	require.NoError(t, err)
+	assert.Empty(t, transformed.(*api_v1.Pod).ManagedFields)
+	assert.Equal(t, "pod-1", transformed.(*api_v1.Pod).Name)
+
+	deleted := cache.DeletedFinalStateUnknown{Key: "default/pod-1"}
+	transformed, err = removeManagedFields(deleted)
+	require.NoError(t, err)
+	assert.Equal(t, deleted, transformed)
+}
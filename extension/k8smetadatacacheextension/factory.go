@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+// createDefaultConfig returns the default configuration for the extension.
+func createDefaultConfig() component.Config {
+	return &Config{
+		APIConfig: k8sconfig.APIConfig{
+			AuthType: k8sconfig.AuthTypeServiceAccount,
+		},
+	}
+}
+
+// createExtension creates the extension instance based on the configuration.
+func createExtension(
+	_ context.Context,
+	set extension.Settings,
+	cfg component.Config,
+) (extension.Extension, error) {
+	baseCfg := cfg.(*Config)
+
+	// Initialize the k8s clients in factory as doing it in extension.Start()
+	// should cause race condition as http Proxy gets shared.
+	client, err := baseCfg.getK8sClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s client: %w", err)
+	}
+	dynamicClient, err := baseCfg.getDynamicClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create k8s dynamic client: %w", err)
+	}
+
+	return newMetadataCache(set.Logger, client, dynamicClient), nil
+}
+
+// NewFactory creates a new factory for the metadata cache extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		createExtension,
+		metadata.ExtensionStability,
+	)
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package k8smetadatacacheextension
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/extension/extensiontest"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func TestCreateExtension(t *testing.T) {
+	client := fake.NewClientset()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.makeClient = func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return client, nil
+	}
+	cfg.makeDynamicClient = func(k8sconfig.APIConfig) (dynamic.Interface, error) {
+		return dynamicClient, nil
+	}
+
+	f := NewFactory()
+	ext, err := f.Create(context.Background(), extensiontest.NewNopSettings(f.Type()), cfg)
+	require.NoError(t, err)
+	provider, ok := ext.(InformerProvider)
+	require.True(t, ok)
+	assert.Same(t, client, provider.Client())
+	assert.Equal(t, dynamicClient, provider.DynamicClient())
+	require.NoError(t, ext.Shutdown(context.Background()))
+}
+
+func TestCreateExtensionClientError(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.makeClient = func(k8sconfig.APIConfig) (kubernetes.Interface, error) {
+		return nil, errors.New("no cluster")
+	}
+
+	f := NewFactory()
+	_, err := f.Create(context.Background(), extensiontest.NewNopSettings(f.Type()), cfg)
+	assert.ErrorContains(t, err, "failed to create k8s client")
+}
@@ -6,6 +6,8 @@ package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"errors"
 
+	"go.opentelemetry.io/collector/component"
+
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
@@ -40,6 +42,9 @@ type Config struct {
 	ObserveIngresses bool `mapstructure:"observe_ingresses"`
 	// Namespaces limits the namespaces for the observed resources. By default, all namespaces will be observed.
 	Namespaces []string `mapstructure:"namespaces"`
+	// MetadataCache is the ID of a k8s_metadata_cache extension. When set, the informers are obtained from
+	// the extension and shared with the other components using it, such as the k8sattributes processors.
+	MetadataCache *component.ID `mapstructure:"metadata_cache"`
 }
 
 // Validate checks if the extension configuration is valid
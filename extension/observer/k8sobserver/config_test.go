@@ -19,6 +19,7 @@ import (
 
 func TestLoadConfig(t *testing.T) {
 	t.Parallel()
+	metadataCacheID := component.MustNewID("k8s_metadata_cache")
 
 	tests := []struct {
 		id          component.ID
@@ -48,6 +49,14 @@ func TestLoadConfig(t *testing.T) {
 				ObserveIngresses: true,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "metadata-cache"),
+			expected: &Config{
+				APIConfig:     k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				ObservePods:   true,
+				MetadataCache: &metadataCacheID,
+			},
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "invalid_auth"),
 			expectedErr: "invalid authType for kubernetes: not a real auth type",
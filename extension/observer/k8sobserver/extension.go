@@ -6,6 +6,7 @@ package k8sobserver // import "github.com/open-telemetry/opentelemetry-collector
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -14,9 +15,15 @@ import (
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/endpointswatcher"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -34,14 +41,24 @@ type k8sObserver struct {
 	serviceListerWatchers []cache.ListerWatcher
 	ingressListerWatchers []cache.ListerWatcher
 	nodeListerWatcher     cache.ListerWatcher
-	handler               *handler
-	once                  *sync.Once
-	stop                  chan struct{}
-	config                *Config
+	// podInformerKeys, serviceInformerKeys, ingressInformerKeys and nodeInformerKey identify the informers
+	// fed by the lister watchers of the same index in the metadata cache, when one is configured.
+	podInformerKeys     []k8smetadatacacheextension.InformerKey
+	serviceInformerKeys []k8smetadatacacheextension.InformerKey
+	ingressInformerKeys []k8smetadatacacheextension.InformerKey
+	nodeInformerKey     k8smetadatacacheextension.InformerKey
+	// shared is the metadata cache the informers are obtained from, if any.
+	shared k8smetadatacacheextension.InformerProvider
+	// registrations are the event handlers registered on the shared informers, removed on shutdown.
+	registrations map[cache.SharedInformer]cache.ResourceEventHandlerRegistration
+	handler       *handler
+	once          *sync.Once
+	stop          chan struct{}
+	config        *Config
 }
 
 // Start will populate the cache.SharedInformers for pods and nodes as configured and run them as goroutines.
-func (k *k8sObserver) Start(_ context.Context, _ component.Host) error {
+func (k *k8sObserver) Start(_ context.Context, host component.Host) error {
 	if k.once == nil {
 		return errors.New("cannot Start() partial k8sObserver (nil *sync.Once)")
 	}
@@ -49,52 +66,81 @@ func (k *k8sObserver) Start(_ context.Context, _ component.Host) error {
 		return errors.New("cannot Start() partial k8sObserver (nil *handler)")
 	}
 
+	if k.config.MetadataCache != nil && k.shared == nil {
+		metadataCache := host.GetExtensions()[*k.config.MetadataCache]
+		if metadataCache == nil {
+			return fmt.Errorf("unknown k8s metadata cache %q", k.config.MetadataCache)
+		}
+		shared, ok := metadataCache.(k8smetadatacacheextension.InformerProvider)
+		if !ok {
+			return fmt.Errorf("the extension %T does not implement k8smetadatacacheextension.InformerProvider", metadataCache)
+		}
+		k.shared = shared
+	}
+
 	k.once.Do(func() {
 		if k.podListerWatchers != nil {
-			for _, podListerWatcher := range k.podListerWatchers {
+			for i, podListerWatcher := range k.podListerWatchers {
 				k.telemetry.Logger.Debug("creating and starting pod informer")
-				podInformer := cache.NewSharedInformer(podListerWatcher, &v1.Pod{}, 0)
-				if _, err := podInformer.AddEventHandler(k.handler); err != nil {
-					k.telemetry.Logger.Error("error adding event handler to pod informer", zap.Error(err))
-				}
-				go podInformer.Run(k.stop)
+				k.startInformer(k.podInformerKeys, i, podListerWatcher, &v1.Pod{}, "pod")
 			}
 		}
 		if k.serviceListerWatchers != nil {
-			for _, serviceListerWatcher := range k.serviceListerWatchers {
+			for i, serviceListerWatcher := range k.serviceListerWatchers {
 				k.telemetry.Logger.Debug("creating and starting service informer")
-				serviceInformer := cache.NewSharedInformer(serviceListerWatcher, &v1.Service{}, 0)
-				if _, err := serviceInformer.AddEventHandler(k.handler); err != nil {
-					k.telemetry.Logger.Error("error adding event handler to service informer", zap.Error(err))
-				}
-				go serviceInformer.Run(k.stop)
+				k.startInformer(k.serviceInformerKeys, i, serviceListerWatcher, &v1.Service{}, "service")
 			}
 		}
 		if k.nodeListerWatcher != nil {
 			k.telemetry.Logger.Debug("creating and starting node informer")
-			nodeInformer := cache.NewSharedInformer(k.nodeListerWatcher, &v1.Node{}, 0)
-			go nodeInformer.Run(k.stop)
-			if _, err := nodeInformer.AddEventHandler(k.handler); err != nil {
-				k.telemetry.Logger.Error("error adding event handler to node informer", zap.Error(err))
-			}
+			k.startInformer([]k8smetadatacacheextension.InformerKey{k.nodeInformerKey}, 0, k.nodeListerWatcher, &v1.Node{}, "node")
 		}
 		if k.ingressListerWatchers != nil {
-			for _, ingressListerWatcher := range k.ingressListerWatchers {
+			for i, ingressListerWatcher := range k.ingressListerWatchers {
 				k.telemetry.Logger.Debug("creating and starting ingress informer")
-				ingressInformer := cache.NewSharedInformer(ingressListerWatcher, &networkingv1.Ingress{}, 0)
-				go ingressInformer.Run(k.stop)
-				if _, err := ingressInformer.AddEventHandler(k.handler); err != nil {
-					k.telemetry.Logger.Error("error adding event handler to ingress informer", zap.Error(err))
-				}
+				k.startInformer(k.ingressInformerKeys, i, ingressListerWatcher, &networkingv1.Ingress{}, "ingress")
 			}
 		}
 	})
 	return nil
 }
 
+// startInformer creates the informer fed by the lister watcher, or obtains the informer identified by keys[i]
+// from the metadata cache when one is configured, and runs it with the event handler of the observer.
+// The informers of the metadata cache are created with its client, as they are shared with the other
+// components using it.
+func (k *k8sObserver) startInformer(keys []k8smetadatacacheextension.InformerKey, i int, listerWatcher cache.ListerWatcher, objType runtime.Object, kind string) {
+	if k.shared == nil {
+		informer := cache.NewSharedInformer(listerWatcher, objType, 0)
+		if _, err := informer.AddEventHandler(k.handler); err != nil {
+			k.telemetry.Logger.Error("error adding event handler to "+kind+" informer", zap.Error(err))
+		}
+		go informer.Run(k.stop)
+		return
+	}
+
+	// the shared informer is run by the metadata cache
+	newInformer := func() cache.SharedInformer {
+		return cache.NewSharedInformer(sharedListerWatcher(k.shared.Client(), keys[i]), objType, 0)
+	}
+	informer := k.shared.Informer(keys[i], newInformer, nil)
+	registration, err := informer.AddEventHandler(k.handler)
+	if err != nil {
+		k.telemetry.Logger.Error("error adding event handler to "+kind+" informer", zap.Error(err))
+		return
+	}
+	k.registrations[informer] = registration
+}
+
 // Shutdown tells any cache.SharedInformers to stop running.
 func (k *k8sObserver) Shutdown(_ context.Context) error {
 	close(k.stop)
+	// the shared informers keep running for the other components of the metadata cache
+	for informer, registration := range k.registrations {
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			k.telemetry.Logger.Warn("error removing event handler from shared informer", zap.Error(err))
+		}
+	}
 	return nil
 }
 
@@ -107,6 +153,7 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 	restClient := client.CoreV1().RESTClient()
 
 	var podListerWatchers []cache.ListerWatcher
+	var podInformerKeys []k8smetadatacacheextension.InformerKey
 	if config.ObservePods {
 		var podSelector fields.Selector
 
@@ -124,9 +171,11 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 				podListerWatchers[i] = cache.NewListWatchFromClient(restClient, "pods", namespace, podSelector)
 			}
 		}
+		podInformerKeys = informerKeys(v1.SchemeGroupVersion.WithResource("pods"), config.Namespaces, podSelector)
 	}
 
 	var serviceListerWatchers []cache.ListerWatcher
+	var serviceInformerKeys []k8smetadatacacheextension.InformerKey
 	if config.ObserveServices {
 		serviceSelector := fields.Everything()
 		set.Logger.Debug("observing services")
@@ -139,9 +188,11 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 				serviceListerWatchers[i] = cache.NewListWatchFromClient(restClient, "services", namespace, serviceSelector)
 			}
 		}
+		serviceInformerKeys = informerKeys(v1.SchemeGroupVersion.WithResource("services"), config.Namespaces, serviceSelector)
 	}
 
 	var nodeListerWatcher cache.ListerWatcher
+	var nodeInformerKey k8smetadatacacheextension.InformerKey
 	if config.ObserveNodes {
 		var nodeSelector fields.Selector
 		if config.Node == "" {
@@ -151,9 +202,11 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 		}
 		set.Logger.Debug("observing nodes")
 		nodeListerWatcher = cache.NewListWatchFromClient(restClient, "nodes", v1.NamespaceAll, nodeSelector)
+		nodeInformerKey = informerKeys(v1.SchemeGroupVersion.WithResource("nodes"), nil, nodeSelector)[0]
 	}
 
 	var ingressListerWatchers []cache.ListerWatcher
+	var ingressInformerKeys []k8smetadatacacheextension.InformerKey
 	if config.ObserveIngresses {
 		ingressSelector := fields.Everything()
 		set.Logger.Debug("observing ingresses")
@@ -166,6 +219,7 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 				ingressListerWatchers[i] = cache.NewListWatchFromClient(client.NetworkingV1().RESTClient(), "ingresses", namespace, ingressSelector)
 			}
 		}
+		ingressInformerKeys = informerKeys(networkingv1.SchemeGroupVersion.WithResource("ingresses"), config.Namespaces, ingressSelector)
 	}
 	h := &handler{idNamespace: set.ID.String(), endpoints: &sync.Map{}, logger: set.Logger}
 	obs := &k8sObserver{
@@ -175,6 +229,11 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 		serviceListerWatchers: serviceListerWatchers,
 		nodeListerWatcher:     nodeListerWatcher,
 		ingressListerWatchers: ingressListerWatchers,
+		podInformerKeys:       podInformerKeys,
+		serviceInformerKeys:   serviceInformerKeys,
+		ingressInformerKeys:   ingressInformerKeys,
+		nodeInformerKey:       nodeInformerKey,
+		registrations:         map[cache.SharedInformer]cache.ResourceEventHandlerRegistration{},
 		stop:                  make(chan struct{}),
 		config:                config,
 		handler:               h,
@@ -183,3 +242,74 @@ func newObserver(config *Config, set extension.Settings) (extension.Extension, e
 
 	return obs, nil
 }
+
+// informerKeys returns the keys identifying the informers of the resource in the given namespaces, in the
+// same order as the lister watchers. A single key watching all the namespaces is returned when none are given.
+func informerKeys(resource schema.GroupVersionResource, namespaces []string, selector fields.Selector) []k8smetadatacacheextension.InformerKey {
+	if len(namespaces) == 0 {
+		namespaces = []string{v1.NamespaceAll}
+	}
+	keys := make([]k8smetadatacacheextension.InformerKey, len(namespaces))
+	for i, namespace := range namespaces {
+		keys[i] = k8smetadatacacheextension.InformerKey{
+			Resource:      resource,
+			Namespace:     namespace,
+			FieldSelector: selector.String(),
+		}
+	}
+	return keys
+}
+
+// sharedListerWatcher returns the lister watcher of the informer identified by the key, using the client
+// of the metadata cache rather than the one built from the API config of the observer.
+func sharedListerWatcher(client kubernetes.Interface, key k8smetadatacacheextension.InformerKey) cache.ListerWatcher {
+	withSelector := func(options *metav1.ListOptions) {
+		options.FieldSelector = key.FieldSelector
+	}
+	switch key.Resource.Resource {
+	case "pods":
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				withSelector(&options)
+				return client.CoreV1().Pods(key.Namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				withSelector(&options)
+				return client.CoreV1().Pods(key.Namespace).Watch(context.Background(), options)
+			},
+		}
+	case "services":
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				withSelector(&options)
+				return client.CoreV1().Services(key.Namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				withSelector(&options)
+				return client.CoreV1().Services(key.Namespace).Watch(context.Background(), options)
+			},
+		}
+	case "nodes":
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				withSelector(&options)
+				return client.CoreV1().Nodes().List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				withSelector(&options)
+				return client.CoreV1().Nodes().Watch(context.Background(), options)
+			},
+		}
+	default:
+		return &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				withSelector(&options)
+				return client.NetworkingV1().Ingresses(key.Namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				withSelector(&options)
+				return client.NetworkingV1().Ingresses(key.Namespace).Watch(context.Background(), options)
+			},
+		}
+	}
+}
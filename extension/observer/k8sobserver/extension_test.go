@@ -5,6 +5,7 @@ package k8sobserver
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,9 +13,14 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/extension/extensiontest"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/k8sobserver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -240,6 +246,130 @@ func TestExtensionInitNamespacedListWatchers(t *testing.T) {
 	require.Len(t, obs.serviceListerWatchers, 2)
 }
 
+// fakeMetadataCache is a k8s metadata cache extension running the informers requested by the observer.
+type fakeMetadataCache struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client    kubernetes.Interface
+	stop      chan struct{}
+	informers map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer
+}
+
+func (f *fakeMetadataCache) Client() kubernetes.Interface {
+	return f.client
+}
+
+func (*fakeMetadataCache) DynamicClient() dynamic.Interface {
+	return nil
+}
+
+func (f *fakeMetadataCache) Informer(key k8smetadatacacheextension.InformerKey, newInformer func() cache.SharedInformer, _ cache.TransformFunc) cache.SharedInformer {
+	if informer, ok := f.informers[key]; ok {
+		return informer
+	}
+	informer := &handlerCountingInformer{SharedInformer: newInformer()}
+	go informer.Run(f.stop)
+	f.informers[key] = informer
+	return informer
+}
+
+// handlerCountingInformer counts the event handlers registered on an informer.
+type handlerCountingInformer struct {
+	cache.SharedInformer
+	handlers atomic.Int32
+}
+
+func (i *handlerCountingInformer) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	i.handlers.Add(1)
+	return i.SharedInformer.AddEventHandler(handler)
+}
+
+func (i *handlerCountingInformer) RemoveEventHandler(reg cache.ResourceEventHandlerRegistration) error {
+	i.handlers.Add(-1)
+	return i.SharedInformer.RemoveEventHandler(reg)
+}
+
+type extensionsHost map[component.ID]component.Component
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h
+}
+
+func TestExtensionMetadataCache(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	config.ObservePods = false
+	config.ObserveServices = true
+	config.Namespaces = []string{"default"}
+	cacheID := component.MustNewID("k8s_metadata_cache")
+	config.MetadataCache = &cacheID
+	mockServiceHost(t, config)
+
+	set := extensiontest.NewNopSettings(factory.Type())
+	set.ID = component.NewID(metadata.Type)
+	ext, err := newObserver(config, set)
+	require.NoError(t, err)
+
+	obs := ext.(*k8sObserver)
+	// the shared informer is created with the client of the metadata cache, not the lister watchers of the observer
+	obs.serviceListerWatchers = []cache.ListerWatcher{framework.NewFakeControllerSource()}
+
+	metadataCache := &fakeMetadataCache{
+		client:    fake.NewClientset(serviceWithClusterIP),
+		stop:      make(chan struct{}),
+		informers: map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer{},
+	}
+	defer close(metadataCache.stop)
+	require.NoError(t, ext.Start(context.Background(), extensionsHost{cacheID: metadataCache}))
+
+	serviceKey := k8smetadatacacheextension.InformerKey{
+		Resource:  v1.SchemeGroupVersion.WithResource("services"),
+		Namespace: "default",
+	}
+	require.Len(t, metadataCache.informers, 1)
+	require.Contains(t, metadataCache.informers, serviceKey)
+	informer := metadataCache.informers[serviceKey]
+	assert.Equal(t, int32(1), informer.handlers.Load())
+
+	sink := &endpointSink{}
+	obs.ListAndWatch(sink)
+	requireSink(t, sink, func() bool {
+		return len(sink.added) == 1
+	})
+	assert.Equal(t, "k8s_observer/service-1-UID", string(sink.added[0].ID))
+
+	// the shared informer keeps running once the observer is shut down
+	require.NoError(t, ext.Shutdown(context.Background()))
+	obs.StopListAndWatch()
+	assert.Equal(t, int32(0), informer.handlers.Load())
+	assert.False(t, informer.IsStopped())
+}
+
+func TestExtensionMetadataCacheErrors(t *testing.T) {
+	factory := NewFactory()
+	config := factory.CreateDefaultConfig().(*Config)
+	mockServiceHost(t, config)
+	host := extensionsHost{component.MustNewID("nop"): &nopExtension{}}
+
+	unknownID := component.MustNewIDWithName("k8s_metadata_cache", "unknown")
+	config.MetadataCache = &unknownID
+	ext, err := newObserver(config, extensiontest.NewNopSettings(factory.Type()))
+	require.NoError(t, err)
+	require.EqualError(t, ext.Start(context.Background(), host), `unknown k8s metadata cache "k8s_metadata_cache/unknown"`)
+
+	nopID := component.MustNewID("nop")
+	config.MetadataCache = &nopID
+	ext, err = newObserver(config, extensiontest.NewNopSettings(factory.Type()))
+	require.NoError(t, err)
+	require.EqualError(t, ext.Start(context.Background(), host), "the extension *k8sobserver.nopExtension does not implement k8smetadatacacheextension.InformerProvider")
+}
+
+// nopExtension is an extension which is not a k8s metadata cache.
+type nopExtension struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
 func TestExtensionObserveNodes(t *testing.T) {
 	factory := NewFactory()
 	config := factory.CreateDefaultConfig().(*Config)
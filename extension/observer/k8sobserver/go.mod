@@ -4,6 +4,7 @@ go 1.23.0
 
 require (
 	github.com/google/uuid v1.6.0
+	github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension v0.129.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer v0.129.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig v0.129.0
 	github.com/open-telemetry/opentelemetry-collector-contrib/pkg/xk8stest v0.129.0
@@ -161,3 +162,5 @@ retract (
 replace google.golang.org/genproto => google.golang.org/genproto v0.0.0-20250218202821-56aae31c358a
 
 exclude github.com/envoyproxy/go-control-plane/envoy v1.32.3
+
+replace github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension => ../../k8smetadatacacheextension
@@ -15,6 +15,7 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlresource"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlscope"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
@@ -339,6 +340,59 @@ func Test_NewBoolExprForProfileWithOptions(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_NewBoolExprForProfileSample(t *testing.T) {
+	tests := []struct {
+		name           string
+		conditions     []string
+		expectedResult bool
+	}{
+		{
+			name: "basic",
+			conditions: []string{
+				"true == true",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "multiple",
+			conditions: []string{
+				"false == true",
+				"true == true",
+			},
+			expectedResult: true,
+		},
+		{
+			name: "With Converter",
+			conditions: []string{
+				`IsMatch("test", "pass")`,
+			},
+			expectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampleBoolExpr, err := NewBoolExprForProfileSample(tt.conditions, StandardProfileSampleFuncs(), ottl.PropagateError, componenttest.NewNopTelemetrySettings())
+			assert.NoError(t, err)
+			assert.NotNil(t, sampleBoolExpr)
+			result, err := sampleBoolExpr.Eval(context.Background(), ottlprofilesample.TransformContext{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func Test_NewBoolExprForProfileSampleWithOptions(t *testing.T) {
+	_, err := NewBoolExprForProfileSampleWithOptions(
+		[]string{`profilesample.attributes["thread.name"] == "main" and profile.duration_unix_nano > 0`},
+		StandardProfileSampleFuncs(),
+		ottl.PropagateError,
+		componenttest.NewNopTelemetrySettings(),
+		[]ottl.Option[ottlprofilesample.TransformContext]{ottlprofilesample.EnablePathContextNames()},
+	)
+	assert.NoError(t, err)
+}
+
 func Test_NewBoolExprForResource(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -40,6 +40,9 @@ func alwaysFalse[K any](context.Context, K) (bool, error) {
 // builds a function that returns a short-circuited result of ANDing
 // boolExpressionEvaluator funcs
 func andFuncs[K any](funcs []BoolExpr[K]) BoolExpr[K] {
+	if len(funcs) == 1 {
+		return funcs[0]
+	}
 	return BoolExpr[K]{func(ctx context.Context, tCtx K) (bool, error) {
 		for _, f := range funcs {
 			result, err := f.Eval(ctx, tCtx)
@@ -57,6 +60,9 @@ func andFuncs[K any](funcs []BoolExpr[K]) BoolExpr[K] {
 // builds a function that returns a short-circuited result of ORing
 // boolExpressionEvaluator funcs
 func orFuncs[K any](funcs []BoolExpr[K]) BoolExpr[K] {
+	if len(funcs) == 1 {
+		return funcs[0]
+	}
 	return BoolExpr[K]{func(ctx context.Context, tCtx K) (bool, error) {
 		for _, f := range funcs {
 			result, err := f.Eval(ctx, tCtx)
@@ -21,6 +21,7 @@ var defaultContextInferPriority = []string{
 	"metric",
 	"spanevent",
 	"span",
+	"profilesample",
 	"profile",
 	"scope",
 	"instrumentation_scope",
@@ -283,15 +284,17 @@ func (s *priorityContextInferrer) getConditionsHints(conditions []string) ([]pri
 // select a context in which the function/enum are supported.
 func (s *priorityContextInferrer) getStatementsHints(statements []string) ([]priorityContextInferrerHints, error) {
 	hints := make([]priorityContextInferrerHints, 0, len(statements))
+	var variables []string
 	for _, statement := range statements {
 		parsed, err := parseStatement(statement)
 		if err != nil {
 			return nil, err
 		}
 		visitor := newGrammarContextInferrerVisitor()
-		parsed.Editor.accept(&visitor)
-		if parsed.WhereClause != nil {
-			parsed.WhereClause.accept(&visitor)
+		// The variables declared by the previous statements aren't telemetry paths.
+		parsed.accept(&scopeVisitor{grammarVisitor: &visitor, names: variables})
+		if parsed.Let != nil {
+			variables = append(variables, parsed.Let.Name)
 		}
 		hints = append(hints, visitor)
 	}
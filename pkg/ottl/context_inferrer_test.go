@@ -131,6 +131,14 @@ func Test_NewPriorityContextInferrer_InferStatements(t *testing.T) {
 			},
 			expected: "bar",
 		},
+		{
+			name:       "variables are ignored",
+			statements: []string{`let x = 1`, `let y = x`, `set(log.body, y)`},
+			candidates: map[string]*priorityContextInferrerCandidate{
+				"log": defaultDummyPriorityContextInferrerCandidate,
+			},
+			expected: "log",
+		},
 		{
 			name:       "unknown context candidate inferred from paths",
 			priority:   []string{"unknown"},
@@ -296,6 +304,7 @@ func Test_NewPriorityContextInferrer_DefaultPriorityList(t *testing.T) {
 		"metric",
 		"spanevent",
 		"span",
+		"profilesample",
 		"profile",
 		"scope",
 		"instrumentation_scope",
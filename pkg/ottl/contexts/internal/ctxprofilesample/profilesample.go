@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxerror"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxutil"
+)
+
+func PathGetSetter[K Context](path ottl.Path[K]) (ottl.GetSetter[K], error) {
+	if path == nil {
+		return nil, ctxerror.New("nil", "nil", Name, DocRef)
+	}
+	switch path.Name() {
+	case "values":
+		if path.Keys() == nil {
+			return accessValues[K](), nil
+		}
+		return accessValuesKey(path.Keys()), nil
+	case "timestamps_unix_nano":
+		return accessTimestampsUnixNano[K](), nil
+	case "locations_start_index":
+		return accessLocationsStartIndex[K](), nil
+	case "locations_length":
+		return accessLocationsLength[K](), nil
+	case "link_index":
+		return accessLinkIndex[K](), nil
+	case "attribute_indices":
+		return accessAttributeIndices[K](), nil
+	case "attributes":
+		if path.Keys() == nil {
+			return accessAttributes[K](), nil
+		}
+		return accessAttributesKey(path.Keys()), nil
+	default:
+		return nil, ctxerror.New(path.Name(), path.String(), Name, DocRef)
+	}
+}
+
+func accessValues[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return ctxutil.GetCommonIntSliceValues[int64](tCtx.GetProfileSample().Value()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			return ctxutil.SetCommonIntSliceValues[int64](tCtx.GetProfileSample().Value(), val)
+		},
+	}
+}
+
+func accessValuesKey[K Context](keys []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			return ctxutil.GetCommonIntSliceValue[K, int64](ctx, tCtx, tCtx.GetProfileSample().Value(), keys)
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			return ctxutil.SetCommonIntSliceValue[K, int64](ctx, tCtx, tCtx.GetProfileSample().Value(), keys, val)
+		},
+	}
+}
+
+func accessTimestampsUnixNano[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return ctxutil.GetCommonIntSliceValues[uint64](tCtx.GetProfileSample().TimestampsUnixNano()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			return ctxutil.SetCommonIntSliceValues[uint64](tCtx.GetProfileSample().TimestampsUnixNano(), val)
+		},
+	}
+}
+
+func accessLocationsStartIndex[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return int64(tCtx.GetProfileSample().LocationsStartIndex()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			if i, ok := val.(int64); ok {
+				tCtx.GetProfileSample().SetLocationsStartIndex(int32(i))
+			}
+			return nil
+		},
+	}
+}
+
+func accessLocationsLength[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return int64(tCtx.GetProfileSample().LocationsLength()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			if i, ok := val.(int64); ok {
+				tCtx.GetProfileSample().SetLocationsLength(int32(i))
+			}
+			return nil
+		},
+	}
+}
+
+func accessLinkIndex[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			if !tCtx.GetProfileSample().HasLinkIndex() {
+				return nil, nil
+			}
+			return int64(tCtx.GetProfileSample().LinkIndex()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			switch v := val.(type) {
+			case int64:
+				tCtx.GetProfileSample().SetLinkIndex(int32(v))
+			case nil:
+				tCtx.GetProfileSample().RemoveLinkIndex()
+			}
+			return nil
+		},
+	}
+}
+
+func accessAttributeIndices[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return ctxutil.GetCommonIntSliceValues[int32](tCtx.GetProfileSample().AttributeIndices()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			return ctxutil.SetCommonIntSliceValues[int32](tCtx.GetProfileSample().AttributeIndices(), val)
+		},
+	}
+}
+
+func accessAttributes[K Context]() ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(_ context.Context, tCtx K) (any, error) {
+			return pprofile.FromAttributeIndices(tCtx.GetProfilesDictionary().AttributeTable(), tCtx.GetProfileSample()), nil
+		},
+		Setter: func(_ context.Context, tCtx K, val any) error {
+			m, err := ctxutil.GetMap(val)
+			if err != nil {
+				return err
+			}
+			tCtx.GetProfileSample().AttributeIndices().FromRaw([]int32{})
+			for k, v := range m.All() {
+				if err := pprofile.PutAttribute(tCtx.GetProfilesDictionary().AttributeTable(), tCtx.GetProfileSample(), k, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func accessAttributesKey[K Context](key []ottl.Key[K]) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx context.Context, tCtx K) (any, error) {
+			return ctxutil.GetMapValue[K](ctx, tCtx, pprofile.FromAttributeIndices(tCtx.GetProfilesDictionary().AttributeTable(), tCtx.GetProfileSample()), key)
+		},
+		Setter: func(ctx context.Context, tCtx K, val any) error {
+			newKey, err := ctxutil.GetMapKeyName(ctx, tCtx, key[0])
+			if err != nil {
+				return err
+			}
+			v := pcommon.NewValueEmpty()
+			if err = ctxutil.SetIndexableValue[K](ctx, tCtx, v, val, key[1:]); err != nil {
+				return err
+			}
+			return pprofile.PutAttribute(tCtx.GetProfilesDictionary().AttributeTable(), tCtx.GetProfileSample(), *newKey, v)
+		},
+	}
+}
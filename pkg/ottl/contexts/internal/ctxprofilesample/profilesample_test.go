@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ctxprofilesample // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func TestPathGetSetter(t *testing.T) {
+	tests := []struct {
+		path     string
+		val      any
+		keys     []ottl.Key[*sampleContext]
+		setFails bool
+	}{
+		{
+			path: "values",
+			val:  []int64{10, 20},
+		},
+		{
+			path:     "values",
+			val:      []string{"x"},
+			setFails: true,
+		},
+		{
+			path: "timestamps_unix_nano",
+			val:  []int64{123, 456},
+		},
+		{
+			path: "locations_start_index",
+			val:  int64(3),
+		},
+		{
+			path: "locations_length",
+			val:  int64(4),
+		},
+		{
+			path: "link_index",
+			val:  int64(5),
+		},
+		{
+			path: "link_index",
+			val:  nil,
+		},
+		{
+			path: "attribute_indices",
+			val:  []int64{1},
+		},
+		{
+			path: "attributes",
+			val: func() pcommon.Map {
+				m := pcommon.NewMap()
+				m.PutStr("akey", "val")
+				return m
+			}(),
+		},
+		{
+			path: "attributes",
+			keys: []ottl.Key[*sampleContext]{
+				&pathtest.Key[*sampleContext]{
+					S: ottltest.Strp("akey"),
+				},
+			},
+			val: "val",
+		},
+		{
+			path: "attributes",
+			keys: []ottl.Key[*sampleContext]{
+				&pathtest.Key[*sampleContext]{
+					S: ottltest.Strp("akey"),
+				},
+				&pathtest.Key[*sampleContext]{
+					S: ottltest.Strp("bkey"),
+				},
+			},
+			val: "val",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path := &pathtest.Path[*sampleContext]{N: tt.path, KeySlice: tt.keys}
+
+			sample := pprofile.NewSample()
+			dictionary := pprofile.NewProfilesDictionary()
+
+			accessor, err := PathGetSetter(path)
+			require.NoError(t, err)
+
+			err = accessor.Set(context.Background(), newSampleContext(sample, dictionary), tt.val)
+			if tt.setFails {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := accessor.Get(context.Background(), newSampleContext(sample, dictionary))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.val, got)
+		})
+	}
+}
+
+func TestPathGetSetter_valuesIndex(t *testing.T) {
+	sample := pprofile.NewSample()
+	sample.Value().FromRaw([]int64{10, 20})
+	tCtx := newSampleContext(sample, pprofile.NewProfilesDictionary())
+
+	accessor, err := PathGetSetter(&pathtest.Path[*sampleContext]{
+		N:        "values",
+		KeySlice: []ottl.Key[*sampleContext]{&pathtest.Key[*sampleContext]{I: ottltest.Intp(1)}},
+	})
+	require.NoError(t, err)
+
+	got, err := accessor.Get(context.Background(), tCtx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), got)
+
+	require.NoError(t, accessor.Set(context.Background(), tCtx, int64(30)))
+	assert.Equal(t, []int64{10, 30}, sample.Value().AsRaw())
+
+	outOfRange, err := PathGetSetter(&pathtest.Path[*sampleContext]{
+		N:        "values",
+		KeySlice: []ottl.Key[*sampleContext]{&pathtest.Key[*sampleContext]{I: ottltest.Intp(2)}},
+	})
+	require.NoError(t, err)
+	_, err = outOfRange.Get(context.Background(), tCtx)
+	assert.Error(t, err)
+}
+
+func TestPathGetSetter_attributesSharedWithDictionary(t *testing.T) {
+	dictionary := pprofile.NewProfilesDictionary()
+	first := newSampleContext(pprofile.NewSample(), dictionary)
+	second := newSampleContext(pprofile.NewSample(), dictionary)
+
+	accessor, err := PathGetSetter(&pathtest.Path[*sampleContext]{
+		N:        "attributes",
+		KeySlice: []ottl.Key[*sampleContext]{&pathtest.Key[*sampleContext]{S: ottltest.Strp("thread.name")}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, accessor.Set(context.Background(), first, "main"))
+	require.NoError(t, accessor.Set(context.Background(), second, "worker"))
+
+	got, err := accessor.Get(context.Background(), first)
+	require.NoError(t, err)
+	assert.Equal(t, "main", got)
+	got, err = accessor.Get(context.Background(), second)
+	require.NoError(t, err)
+	assert.Equal(t, "worker", got)
+	assert.Equal(t, 2, dictionary.AttributeTable().Len())
+}
+
+type sampleContext struct {
+	sample     pprofile.Sample
+	dictionary pprofile.ProfilesDictionary
+}
+
+func (s *sampleContext) GetProfilesDictionary() pprofile.ProfilesDictionary {
+	return s.dictionary
+}
+
+func (s *sampleContext) GetProfileSample() pprofile.Sample {
+	return s.sample
+}
+
+func newSampleContext(sample pprofile.Sample, dictionary pprofile.ProfilesDictionary) *sampleContext {
+	return &sampleContext{sample: sample, dictionary: dictionary}
+}
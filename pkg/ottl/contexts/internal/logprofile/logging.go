@@ -142,6 +142,17 @@ func newSamples(p Profile, sampleSlice pprofile.SampleSlice) (samples, error) {
 	return ss, joinedErr
 }
 
+// Sample serializes a sample of a profile, resolving its references to the profile's dictionary.
+type Sample struct {
+	pprofile.Sample
+	Profile Profile
+}
+
+func (s Sample) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
+	ps, err := newSample(s.Profile, s.Sample)
+	return errors.Join(err, ps.MarshalLogObject(encoder))
+}
+
 type sample struct {
 	timestamps timestamps
 	attributes attributes
@@ -0,0 +1,210 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlprofilesample
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ctxprofilesample"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/pathtest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+func Test_newPathGetSetter(t *testing.T) {
+	newCache := pcommon.NewMap()
+	newCache.PutStr("temp", "value")
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[TransformContext]
+		orig     any
+		newVal   any
+		modified func(sample pprofile.Sample, cache pcommon.Map)
+	}{
+		{
+			name: "values",
+			path: &pathtest.Path[TransformContext]{
+				N: "values",
+			},
+			orig:   []int64{10},
+			newVal: []int64{20, 30},
+			modified: func(sample pprofile.Sample, _ pcommon.Map) {
+				sample.Value().FromRaw([]int64{20, 30})
+			},
+		},
+		{
+			name: "locations_length",
+			path: &pathtest.Path[TransformContext]{
+				N: "locations_length",
+			},
+			orig:   int64(2),
+			newVal: int64(3),
+			modified: func(sample pprofile.Sample, _ pcommon.Map) {
+				sample.SetLocationsLength(3)
+			},
+		},
+		{
+			name: "cache",
+			path: &pathtest.Path[TransformContext]{
+				N: "cache",
+			},
+			orig:   pcommon.NewMap(),
+			newVal: newCache,
+			modified: func(_ pprofile.Sample, cache pcommon.Map) {
+				newCache.CopyTo(cache)
+			},
+		},
+		{
+			name: "cache access",
+			path: &pathtest.Path[TransformContext]{
+				N: "cache",
+				KeySlice: []ottl.Key[TransformContext]{
+					&pathtest.Key[TransformContext]{
+						S: ottltest.Strp("temp"),
+					},
+				},
+			},
+			orig:   nil,
+			newVal: "new value",
+			modified: func(_ pprofile.Sample, cache pcommon.Map) {
+				cache.PutStr("temp", "new value")
+			},
+		},
+	}
+	// Copy all tests cases and sets the path.Context value to the generated ones.
+	// It ensures all exiting field access also work when the path context is set.
+	for _, tt := range slices.Clone(tests) {
+		testWithContext := tt
+		testWithContext.name = "with_path_context:" + tt.name
+		pathWithContext := *tt.path.(*pathtest.Path[TransformContext])
+		pathWithContext.C = ctxprofilesample.Name
+		testWithContext.path = &pathWithContext
+		tests = append(tests, testWithContext)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCache := pcommon.NewMap()
+			cacheGetter := func(_ TransformContext) pcommon.Map {
+				return testCache
+			}
+			accessor, err := pathExpressionParser(cacheGetter)(tt.path)
+			assert.NoError(t, err)
+
+			sample := createSampleTelemetry()
+
+			tCtx := NewTransformContext(sample, pprofile.NewProfile(), pprofile.NewProfilesDictionary(), pcommon.NewInstrumentationScope(), pcommon.NewResource(), pprofile.NewScopeProfiles(), pprofile.NewResourceProfiles())
+			got, err := accessor.Get(context.Background(), tCtx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(context.Background(), tCtx, tt.newVal)
+			assert.NoError(t, err)
+
+			exSample := createSampleTelemetry()
+			exCache := pcommon.NewMap()
+			tt.modified(exSample, exCache)
+
+			assert.Equal(t, exSample, sample)
+			assert.Equal(t, exCache, testCache)
+		})
+	}
+}
+
+func Test_newPathGetSetter_higherContextPath(t *testing.T) {
+	resource := pcommon.NewResource()
+	resource.Attributes().PutStr("foo", "bar")
+
+	instrumentationScope := pcommon.NewInstrumentationScope()
+	instrumentationScope.SetName("instrumentation_scope")
+
+	profile := pprofile.NewProfile()
+	profile.SetPeriod(100)
+
+	ctx := NewTransformContext(createSampleTelemetry(), profile, pprofile.NewProfilesDictionary(), instrumentationScope, resource, pprofile.NewScopeProfiles(), pprofile.NewResourceProfiles())
+
+	tests := []struct {
+		name     string
+		path     ottl.Path[TransformContext]
+		expected any
+	}{
+		{
+			name: "resource",
+			path: &pathtest.Path[TransformContext]{N: "resource", NextPath: &pathtest.Path[TransformContext]{
+				N: "attributes",
+				KeySlice: []ottl.Key[TransformContext]{
+					&pathtest.Key[TransformContext]{
+						S: ottltest.Strp("foo"),
+					},
+				},
+			}},
+			expected: "bar",
+		},
+		{
+			name:     "instrumentation_scope with context",
+			path:     &pathtest.Path[TransformContext]{C: "instrumentation_scope", N: "name"},
+			expected: instrumentationScope.Name(),
+		},
+		{
+			name:     "profile",
+			path:     &pathtest.Path[TransformContext]{N: "profile", NextPath: &pathtest.Path[TransformContext]{N: "period"}},
+			expected: int64(100),
+		},
+		{
+			name:     "profile with context",
+			path:     &pathtest.Path[TransformContext]{C: "profile", N: "period"},
+			expected: int64(100),
+		},
+	}
+
+	testCache := pcommon.NewMap()
+	cacheGetter := func(_ TransformContext) pcommon.Map {
+		return testCache
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := pathExpressionParser(cacheGetter)(tt.path)
+			assert.NoError(t, err)
+
+			got, err := accessor.Get(context.Background(), ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_sampleAttributes(t *testing.T) {
+	parser, err := NewParser(nil, componenttest.NewNopTelemetrySettings(), EnablePathContextNames())
+	require.NoError(t, err)
+	condition, err := parser.ParseCondition(`profilesample.attributes["thread.name"] == "main" and profile.period == 100`)
+	require.NoError(t, err)
+
+	dictionary := pprofile.NewProfilesDictionary()
+	profile := pprofile.NewProfile()
+	profile.SetPeriod(100)
+	sample := profile.Sample().AppendEmpty()
+	value := pcommon.NewValueStr("main")
+	require.NoError(t, pprofile.PutAttribute(dictionary.AttributeTable(), sample, "thread.name", value))
+
+	tCtx := NewTransformContext(sample, profile, dictionary, pcommon.NewInstrumentationScope(), pcommon.NewResource(), pprofile.NewScopeProfiles(), pprofile.NewResourceProfiles())
+	matched, err := condition.Eval(context.Background(), tCtx)
+	require.NoError(t, err)
+	assert.True(t, matched)
+}
+
+func createSampleTelemetry() pprofile.Sample {
+	sample := pprofile.NewSample()
+	sample.Value().Append(10)
+	sample.SetLocationsLength(2)
+	return sample
+}
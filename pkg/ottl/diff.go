@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// snapshotTransformContext returns the fields logged for a TransformContext, or nil if it
+// can't be logged.
+func snapshotTransformContext(tCtx any) map[string]any {
+	marshaler, ok := tCtx.(zapcore.ObjectMarshaler)
+	if !ok {
+		return nil
+	}
+	enc := zapcore.NewMapObjectEncoder()
+	if err := marshaler.MarshalLogObject(enc); err != nil {
+		return nil
+	}
+	return enc.Fields
+}
+
+// fieldChange is a field of a TransformContext changed by a statement.
+type fieldChange struct {
+	path          string
+	before, after any
+}
+
+func (c fieldChange) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("path", c.path)
+	if err := enc.AddReflected("before", c.before); err != nil {
+		return err
+	}
+	return enc.AddReflected("after", c.after)
+}
+
+type fieldChanges []fieldChange
+
+func (c fieldChanges) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, change := range c {
+		if err := enc.AppendObject(change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffSnapshots returns the fields that differ between two snapshots of a TransformContext,
+// sorted by path. Nested objects are compared field by field, and lists as a whole.
+func diffSnapshots(before, after map[string]any) fieldChanges {
+	return appendChanges(nil, "", before, after)
+}
+
+func appendChanges(changes fieldChanges, prefix string, before, after map[string]any) fieldChanges {
+	keys := slices.Collect(maps.Keys(before))
+	for k := range after {
+		if _, ok := before[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		b, inBefore := before[k]
+		a, inAfter := after[k]
+		bm, bIsMap := b.(map[string]any)
+		am, aIsMap := a.(map[string]any)
+		if bIsMap && aIsMap {
+			changes = appendChanges(changes, path, bm, am)
+			continue
+		}
+		if inBefore == inAfter && reflect.DeepEqual(b, a) {
+			continue
+		}
+		changes = append(changes, fieldChange{path: path, before: b, after: a})
+	}
+	return changes
+}
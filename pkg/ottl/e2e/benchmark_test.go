@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+func benchmarkStatements(b *testing.B, statements []string, options ...ottl.Option[ottllog.TransformContext]) {
+	settings := componenttest.NewNopTelemetrySettings()
+	logParser, err := ottllog.NewParser(ottlfuncs.StandardFuncs[ottllog.TransformContext](), settings, options...)
+	require.NoError(b, err)
+	parsed, err := logParser.ParseStatements(statements)
+	require.NoError(b, err)
+	sequence := ottllog.NewStatementSequence(parsed, settings)
+
+	tCtx := constructLogTransformContext()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		require.NoError(b, sequence.Execute(context.Background(), tCtx))
+	}
+}
+
+func Benchmark_ConstantFolding(b *testing.B) {
+	benchmarkStatements(b, []string{
+		`set(attributes["size"], 1024 * 1024 * 8) where (1 + 1 == 2 or attributes["http.method"] == "get") and "a" != "b"`,
+	})
+}
+
+func Benchmark_ShortCircuit(b *testing.B) {
+	benchmarkStatements(b, []string{
+		`set(attributes["matched"], true) where attributes["http.method"] == "post" and IsMatch(body, "operation[AC]")`,
+	})
+}
+
+func Benchmark_ExpressionDeduplication(b *testing.B) {
+	statements := []string{
+		`set(attributes["route"], "post") where IsMatch(Concat([attributes["http.method"], body], " "), "^post .*")`,
+		`set(attributes["route"], "put") where IsMatch(Concat([attributes["http.method"], body], " "), "^put .*")`,
+		`set(attributes["route"], "delete") where IsMatch(Concat([attributes["http.method"], body], " "), "^delete .*")`,
+		`set(attributes["route"], "get") where IsMatch(Concat([attributes["http.method"], body], " "), "^get .*")`,
+	}
+	b.Run("disabled", func(b *testing.B) {
+		benchmarkStatements(b, statements)
+	})
+	b.Run("enabled", func(b *testing.B) {
+		benchmarkStatements(b, statements, ottl.WithExpressionDeduplication[ottllog.TransformContext]())
+	})
+}
@@ -299,6 +299,21 @@ func Test_e2e_editors(t *testing.T) {
 				v.Map().PutStr("test", "pass")
 			},
 		},
+		{
+			statement: `set_path(attributes, "$.things[?(@.value > 3)].name", "baz")`,
+			want: func(tCtx ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("things")
+				v.Slice().At(1).Map().PutStr("name", "baz")
+			},
+		},
+		{
+			statement: `set_path(attributes, "$.foo.nested['http.route'].template", "/health")`,
+			want: func(tCtx ottllog.TransformContext) {
+				v, _ := tCtx.GetLogRecord().Attributes().Get("foo")
+				nested, _ := v.Map().Get("nested")
+				nested.Map().PutEmptyMap("http.route").PutStr("template", "/health")
+			},
+		},
 		{
 			statement: `truncate_all(attributes, 100)`,
 			want:      func(_ ottllog.TransformContext) {},
@@ -486,6 +501,42 @@ func Test_e2e_converters(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
 			},
 		},
+		{
+			statement: `set(attributes["test"], Decode(Decompress(Decode("H4sIAAAAAAACAytILC4GACTUcM4EAAAA", "base64"), "gzip"), "utf-8"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Encode("pass", "base64"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "cGFzcw==")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Decode(Encode("pass", "hex"), "hex"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Encode("a b&c", "url"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "a+b%26c")
+			},
+		},
+		{
+			statement: `set(attributes["test"], URLDecode("a+b%26c"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "a b&c")
+			},
+		},
+		{
+			statement: `set(attributes["test"], CommunityID("128.232.110.120", "66.35.250.204", 34855, 80, 6))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "1:LQU9qZlK+B5F3KDmev6m5PMibrg=")
+			},
+		},
 		{
 			statement: `set(attributes["test"], Concat(["A","B"], ":"))`,
 			want: func(tCtx ottllog.TransformContext) {
@@ -637,6 +688,40 @@ func Test_e2e_converters(t *testing.T) {
 				tCtx.GetLogRecord().Attributes().PutStr("test", "<a><b></b></a>")
 			},
 		},
+		{
+			statement: `set(attributes["test"], IPToInt("10.0.0.1"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutInt("test", 167772161)
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsInCIDR("10.1.2.3", ["192.168.0.0/16", "10.0.0.0/8"])`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where IsInCIDR("10.1.2.3", ["192.168.0.0/16"])`,
+			want:      func(_ ottllog.TransformContext) {},
+		},
+		{
+			statement: `set(attributes["test"], ParseIP("fd00::1")["private"])`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutBool("test", true)
+			},
+		},
+		{
+			statement: `set(severity_number, ParseSeverity("Warning", {"error": ["oops", "5xx"]}))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetSeverityNumber(plog.SeverityNumberWarn)
+			},
+		},
+		{
+			statement: `set(severity_number, ParseSeverity(503, {"error": ["oops", "5xx"]}, "none"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().SetSeverityNumber(plog.SeverityNumberError)
+			},
+		},
 		{
 			statement: `set(attributes["test"], Int(1.0))`,
 			want: func(tCtx ottllog.TransformContext) {
@@ -905,6 +990,27 @@ func Test_e2e_converters(t *testing.T) {
 				message.PutStr("content", "This is a log message!")
 			},
 		},
+		{
+			statement: `set(attributes["test"], Query(attributes, "$.things[?(@.value > 3)].name"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("bar")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Query(attributes, "foo.nested.test"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Query(ParseJSON("{\"a\":[{\"b\":1},{\"b\":2}]}"), "$.a[*].b"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetDouble(1)
+				s.AppendEmpty().SetDouble(2)
+			},
+		},
 		{
 			statement: `set(attributes["test"], RemoveXML("<Log id=\"1\"><Message>This is a log message!</Message></Log>", "/Log/Message"))`,
 			want: func(tCtx ottllog.TransformContext) {
@@ -1214,6 +1320,84 @@ func Test_e2e_converters(t *testing.T) {
 				attributes.AppendEmpty().SetStr("foo")
 			},
 		},
+		{
+			statement: `set(attributes["test"], Map(["A", "b", "C"], x => ToLowerCase(x)))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetStr("a")
+				s.AppendEmpty().SetStr("b")
+				s.AppendEmpty().SetStr("c")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Map(attributes["things"], thing => thing["value"] * 10))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetInt(20)
+				s.AppendEmpty().SetInt(50)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Filter(attributes["things"], thing => thing["name"] != attributes["foo"]["bar"] and thing["value"] > 1))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				thing1 := s.AppendEmpty().SetEmptyMap()
+				thing1.PutStr("name", "foo")
+				thing1.PutInt("value", 2)
+				thing2 := s.AppendEmpty().SetEmptyMap()
+				thing2.PutStr("name", "bar")
+				thing2.PutInt("value", 5)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Filter(attributes["foo"], (k, v) => IsString(v) and k != "bar"))`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutEmptyMap("test").PutStr("flags", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], ForEach({"a": "x", "b": "y"}, (k, v) => Concat([k, v], "=")))`,
+			want: func(tCtx ottllog.TransformContext) {
+				m := tCtx.GetLogRecord().Attributes().PutEmptyMap("test")
+				m.PutStr("a", "a=x")
+				m.PutStr("b", "b=y")
+			},
+		},
+		{
+			statement: `set(attributes["test"], Map(attributes["things"], t => Map([1, 2], n => t["value"] * n)))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s1 := s.AppendEmpty().SetEmptySlice()
+				s1.AppendEmpty().SetInt(2)
+				s1.AppendEmpty().SetInt(4)
+				s2 := s.AppendEmpty().SetEmptySlice()
+				s2.AppendEmpty().SetInt(5)
+				s2.AppendEmpty().SetInt(10)
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where Any(attributes["things"], t => t["name"] == "bar")`,
+			want: func(tCtx ottllog.TransformContext) {
+				tCtx.GetLogRecord().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where All(attributes["things"], t => t["value"] > 2)`,
+			want:      func(_ ottllog.TransformContext) {},
+		},
+		{
+			statement: `set(attributes["test"], Map(attributes["things"], t => t["value"] > 2))`,
+			want: func(tCtx ottllog.TransformContext) {
+				s := tCtx.GetLogRecord().Attributes().PutEmptySlice("test")
+				s.AppendEmpty().SetBool(false)
+				s.AppendEmpty().SetBool(true)
+			},
+		},
+		{
+			statement: `set(attributes["test"], Filter(attributes["things"], t => t["name"]))`,
+			want:      func(_ ottllog.TransformContext) {},
+			errMsg:    "lambda must return a bool but got string",
+		},
 	}
 
 	for _, tt := range tests {
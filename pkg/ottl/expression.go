@@ -903,6 +903,13 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 			return &literal[K]{value: *i}, nil
 		}
 		if eL.Path != nil {
+			scoped, err := p.newScopedGetter(eL.Path)
+			if err != nil {
+				return nil, err
+			}
+			if scoped != nil {
+				return scoped, nil
+			}
 			np, err := p.newPath(eL.Path)
 			if err != nil {
 				return nil, err
@@ -950,6 +957,10 @@ func (p *Parser[K]) newGetterFromConverter(c converter) (Getter[K], error) {
 	if err != nil {
 		return nil, err
 	}
+	// The calls made by lambdas depend on their parameters and can't be deduplicated.
+	if slot, ok := p.memo.slot(&c); ok && p.lambdaScope == nil {
+		call = memoize(p.memo, slot, call)
+	}
 	return &exprGetter[K]{
 		expr: call,
 		keys: c.Keys,
@@ -231,10 +231,16 @@ func (p *Parser[K]) newKeys(keys []key) ([]Key[K], error) {
 		var getter Getter[K]
 		if keys[i].Expression != nil {
 			if keys[i].Expression.Path != nil {
-				g, err := p.buildGetSetterFromPath(keys[i].Expression.Path)
+				g, err := p.newScopedGetter(keys[i].Expression.Path)
 				if err != nil {
 					return nil, err
 				}
+				if g == nil {
+					g, err = p.buildGetSetterFromPath(keys[i].Expression.Path)
+					if err != nil {
+						return nil, err
+					}
+				}
 				getter = g
 			}
 			if keys[i].Expression.Converter != nil {
@@ -404,7 +410,16 @@ func (p *Parser[K]) buildArgs(ed editor, argsVal reflect.Value) error {
 			fieldType = manager.get().Type()
 		}
 
+		if arg.Lambda != nil && !strings.HasPrefix(fieldType.Name(), "LambdaGetter") {
+			return fmt.Errorf("invalid argument at position %v: lambda expressions are not supported for this argument", i)
+		}
+
 		switch {
+		case strings.HasPrefix(fieldType.Name(), "LambdaGetter"):
+			if arg.Lambda == nil {
+				return fmt.Errorf("invalid argument at position %v: must be a lambda expression", i)
+			}
+			val, err = p.newLambdaGetter(arg.Lambda)
 		case strings.HasPrefix(fieldType.Name(), "FunctionGetter"):
 			var name string
 			switch {
@@ -536,6 +551,20 @@ func (p *Parser[K]) buildSliceArg(argVal value, argType reflect.Type) (any, erro
 }
 
 func (p *Parser[K]) buildGetSetterFromPath(path *path) (GetSetter[K], error) {
+	lambdaParameter, err := p.newLambdaParameterGetter(path)
+	if err != nil {
+		return nil, err
+	}
+	if lambdaParameter != nil {
+		return nil, fmt.Errorf("lambda parameter %q can't be set", buildOriginalText(path))
+	}
+	variable, err := p.newVariableGetter(path)
+	if err != nil {
+		return nil, err
+	}
+	if variable != nil {
+		return nil, fmt.Errorf("variable %q can't be set", buildOriginalText(path))
+	}
 	np, err := p.newPath(path)
 	if err != nil {
 		return nil, err
@@ -550,6 +579,9 @@ func (p *Parser[K]) buildGetSetterFromPath(path *path) (GetSetter[K], error) {
 // Handle interfaces that can be passed as arguments to OTTL functions.
 func (p *Parser[K]) buildArg(argVal value, argType reflect.Type) (any, error) {
 	name := argType.Name()
+	if err := p.checkVariableType(argVal, name); err != nil {
+		return nil, err
+	}
 	switch {
 	case strings.HasPrefix(name, "Setter"):
 		fallthrough
@@ -6,6 +6,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"encoding/hex"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/alecthomas/participle/v2/lexer"
@@ -13,9 +14,10 @@ import (
 
 // parsedStatement represents a parsed statement. It is the entry point into the statement DSL.
 type parsedStatement struct {
-	Editor editor `parser:"(@@"`
+	Let    *letStatement `parser:"( @@"`
+	Editor editor        `parser:"| @@"`
 	// If converter is matched then return error
-	Converter   *converter         `parser:"|@@)"`
+	Converter   *converter         `parser:"| @@ )"`
 	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
 }
 
@@ -25,12 +27,39 @@ func (p *parsedStatement) checkForCustomError() error {
 		validator.add(fmt.Errorf("editor names must start with a lowercase letter but got '%v'", p.Converter.Function))
 	}
 
-	p.Editor.accept(validator)
+	p.accept(validator)
+
+	return validator.join()
+}
+
+func (p *parsedStatement) accept(v grammarVisitor) {
+	if p.Let != nil {
+		p.Let.accept(v)
+	} else {
+		p.Editor.accept(v)
+	}
 	if p.WhereClause != nil {
-		p.WhereClause.accept(validator)
+		p.WhereClause.accept(v)
 	}
+}
 
-	return validator.join()
+// letStatement declares a variable holding the result of an expression, such as
+// `let body = ParseJSON(log.body)`, which can be used by the following statements of the
+// same group. Like lambda bodies, the expression is either a value or, when it contains
+// comparisons or boolean operators, a boolean expression.
+type letStatement struct {
+	Name      string             `parser:"'let' @Lowercase Equal"`
+	Value     *value             `parser:"( @@ (?! OpComparison | OpAnd | OpOr )"`
+	Condition *booleanExpression `parser:"| @@ )"`
+}
+
+func (l *letStatement) accept(v grammarVisitor) {
+	if l.Value != nil {
+		l.Value.accept(v)
+	}
+	if l.Condition != nil {
+		l.Condition.accept(v)
+	}
 }
 
 type constExpr struct {
@@ -200,8 +229,8 @@ type editor struct {
 
 func (i *editor) accept(v grammarVisitor) {
 	v.visitEditor(i)
-	for _, arg := range i.Arguments {
-		arg.accept(v)
+	for j := range i.Arguments {
+		i.Arguments[j].accept(v)
 	}
 }
 
@@ -215,22 +244,61 @@ type converter struct {
 func (c *converter) accept(v grammarVisitor) {
 	v.visitConverter(c)
 	if c.Arguments != nil {
-		for _, a := range c.Arguments {
-			a.accept(v)
+		for i := range c.Arguments {
+			c.Arguments[i].accept(v)
 		}
 	}
 }
 
 type argument struct {
 	Name         string  `parser:"(@(Lowercase(Uppercase | Lowercase)*) Equal)?"`
-	Value        value   `parser:"( @@"`
+	Lambda       *lambda `parser:"( @@"`
+	Value        value   `parser:"| @@"`
 	FunctionName *string `parser:"| @(Uppercase(Uppercase | Lowercase)*) )"`
 }
 
 func (a *argument) accept(v grammarVisitor) {
+	if a.Lambda != nil {
+		a.Lambda.accept(v)
+		return
+	}
 	a.Value.accept(v)
 }
 
+// lambda represents an inline function passed as an argument to a higher-order converter,
+// such as `x => ToLowerCase(x)` or `(k, v) => v != nil`. Its body is either a value or,
+// when it contains comparisons or boolean operators, a boolean expression.
+type lambda struct {
+	Parameters []string           `parser:"( @Lowercase | '(' @Lowercase ( ',' @Lowercase )* ')' ) Arrow"`
+	Value      *value             `parser:"( @@ (?! OpComparison | OpAnd | OpOr )"`
+	Condition  *booleanExpression `parser:"| @@ )"`
+}
+
+func (l *lambda) accept(v grammarVisitor) {
+	// The lambda parameters aren't telemetry paths, they are hidden from the visitor.
+	scoped := &scopeVisitor{grammarVisitor: v, names: l.Parameters}
+	if l.Value != nil {
+		l.Value.accept(scoped)
+	}
+	if l.Condition != nil {
+		l.Condition.accept(scoped)
+	}
+}
+
+// scopeVisitor forwards the nodes to the wrapped visitor, except for the paths referring to
+// the names declared in the scope, such as lambda parameters or variables.
+type scopeVisitor struct {
+	grammarVisitor
+	names []string
+}
+
+func (s *scopeVisitor) visitPath(v *path) {
+	if v.Context == "" && len(v.Fields) > 0 && slices.Contains(s.names, v.Fields[0].Name) {
+		return
+	}
+	s.grammarVisitor.visitPath(v)
+}
+
 // value represents a part of a parsed statement which is resolved to a value of some sort. This can be a telemetry path
 // mathExpression, function call, or literal.
 type value struct {
@@ -263,8 +331,8 @@ func (v *value) accept(vis grammarVisitor) {
 		v.Map.accept(vis)
 	}
 	if v.List != nil {
-		for _, i := range v.List.Values {
-			i.accept(vis)
+		for i := range v.List.Values {
+			v.List.Values[i].accept(vis)
 		}
 	}
 }
@@ -507,6 +575,7 @@ func buildLexer() *lexer.StatefulDefinition {
 		{Name: `OpNot`, Pattern: `\b(not)\b`},
 		{Name: `OpOr`, Pattern: `\b(or)\b`},
 		{Name: `OpAnd`, Pattern: `\b(and)\b`},
+		{Name: `Arrow`, Pattern: `=>`},
 		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
 		{Name: `OpAddSub`, Pattern: `\+|\-`},
 		{Name: `OpMultDiv`, Pattern: `\/|\*`},
@@ -0,0 +1,187 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonpath // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/jsonpath"
+
+import (
+	"bytes"
+	"cmp"
+	"reflect"
+	"regexp"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+type filterExpr interface {
+	eval(current, root Node) bool
+}
+
+type orExpr struct {
+	left, right filterExpr
+}
+
+func (e orExpr) eval(current, root Node) bool {
+	return e.left.eval(current, root) || e.right.eval(current, root)
+}
+
+type andExpr struct {
+	left, right filterExpr
+}
+
+func (e andExpr) eval(current, root Node) bool {
+	return e.left.eval(current, root) && e.right.eval(current, root)
+}
+
+type notExpr struct {
+	expr filterExpr
+}
+
+func (e notExpr) eval(current, root Node) bool {
+	return !e.expr.eval(current, root)
+}
+
+// existsExpr tests whether a path selects any value.
+type existsExpr struct {
+	query queryOperand
+}
+
+func (e existsExpr) eval(current, root Node) bool {
+	return len(e.query.nodes(current, root)) > 0
+}
+
+type compareExpr struct {
+	left  operand
+	op    string
+	right operand
+}
+
+func (e compareExpr) eval(current, root Node) bool {
+	left, leftOK := e.left.value(current, root)
+	right, rightOK := e.right.value(current, root)
+	if !leftOK || !rightOK {
+		// A path selecting nothing is only equal to another path selecting nothing.
+		nothing := !leftOK && !rightOK
+		switch e.op {
+		case "==", "<=", ">=":
+			return nothing
+		case "!=":
+			return !nothing
+		}
+		return false
+	}
+
+	switch e.op {
+	case "==":
+		return equal(left, right)
+	case "!=":
+		return !equal(left, right)
+	}
+	c, ok := order(left, right)
+	if !ok {
+		return false
+	}
+	switch e.op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	default:
+		return c >= 0
+	}
+}
+
+type matchExpr struct {
+	left operand
+	re   *regexp.Regexp
+}
+
+func (e matchExpr) eval(current, root Node) bool {
+	v, ok := e.left.value(current, root)
+	if !ok {
+		return false
+	}
+	s, ok := v.(string)
+	return ok && e.re.MatchString(s)
+}
+
+type operand interface {
+	// value returns the value of the operand, or false when it is a path selecting nothing.
+	value(current, root Node) (any, bool)
+}
+
+type literalOperand struct {
+	literal any
+}
+
+func (o literalOperand) value(Node, Node) (any, bool) {
+	return o.literal, true
+}
+
+type queryOperand struct {
+	relative bool
+	path     *Path
+}
+
+func (o queryOperand) nodes(current, root Node) []Node {
+	if o.relative {
+		return o.path.Select(current)
+	}
+	return o.path.Select(root)
+}
+
+func (o queryOperand) value(current, root Node) (any, bool) {
+	nodes := o.nodes(current, root)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0].Value(), true
+}
+
+func equal(left, right any) bool {
+	if l, ok := toFloat(left); ok {
+		r, ok := toFloat(right)
+		return ok && l == r
+	}
+	switch l := left.(type) {
+	case pcommon.Map:
+		r, ok := right.(pcommon.Map)
+		return ok && reflect.DeepEqual(l.AsRaw(), r.AsRaw())
+	case pcommon.Slice:
+		r, ok := right.(pcommon.Slice)
+		return ok && reflect.DeepEqual(l.AsRaw(), r.AsRaw())
+	case []byte:
+		r, ok := right.([]byte)
+		return ok && bytes.Equal(l, r)
+	}
+	switch right.(type) {
+	case pcommon.Map, pcommon.Slice, []byte:
+		return false
+	}
+	return left == right
+}
+
+// order compares two numbers or two strings.
+func order(left, right any) (int, bool) {
+	if l, ok := toFloat(left); ok {
+		r, ok := toFloat(right)
+		return cmp.Compare(l, r), ok
+	}
+	l, ok := left.(string)
+	if !ok {
+		return 0, false
+	}
+	r, ok := right.(string)
+	return cmp.Compare(l, r), ok
+}
+
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
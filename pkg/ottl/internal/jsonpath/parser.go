@@ -0,0 +1,443 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonpath // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/jsonpath"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parse compiles a JSONPath expression. The expression may omit the leading `$`, in
+// which case it starts with a member name, e.g. `a.b[0]` is the same as `$.a.b[0]`.
+//
+// The supported syntax is:
+//   - `.name`, `['name']` and `["name"]` select a map entry.
+//   - `[n]` selects a slice element, counting from the end when n is negative.
+//   - `[start:end:step]` selects a range of slice elements.
+//   - `.*` and `[*]` select all map entries or slice elements.
+//   - `..` applies the following selector to a value and all of its descendants.
+//   - `[a,b]` selects the union of several selectors.
+//   - `[?(expr)]` selects the map entries or slice elements matching a filter, where
+//     expr compares `@` (the current value) and `$` (the root) paths with literals
+//     using `==`, `!=`, `<`, `<=`, `>`, `>=` and `=~` (a regular expression), tests
+//     the existence of a path, and combines tests with `&&`, `||`, `!` and parentheses.
+func Parse(expr string) (*Path, error) {
+	p := &parser{input: expr}
+	path, err := p.parseRoot()
+	if err == nil && p.pos < len(p.input) {
+		err = p.errorf("unexpected %q", p.input[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", expr, err)
+	}
+	return path, nil
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at offset %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *parser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *parser) consume(s string) bool {
+	if strings.HasPrefix(p.input[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *parser) parseRoot() (*Path, error) {
+	switch c := p.peek(); {
+	case c == 0:
+		return nil, errors.New("empty path")
+	case c == '$':
+		p.pos++
+		return p.parseSegments()
+	case c == '[':
+		return p.parseSegments()
+	case isNameChar(c):
+		name := p.parseName()
+		path, err := p.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		path.segments = append([]segment{{selectors: []selector{nameSelector{name: name}}}}, path.segments...)
+		return path, nil
+	default:
+		return nil, p.errorf("unexpected %q", c)
+	}
+}
+
+func (p *parser) parseSegments() (*Path, error) {
+	path := &Path{}
+	for {
+		var seg segment
+		var err error
+		switch {
+		case p.consume(".."):
+			seg.descendant = true
+			seg.selectors, err = p.parseMemberSelectors()
+		case p.consume("."):
+			seg.selectors, err = p.parseMemberSelectors()
+		case p.peek() == '[':
+			seg.selectors, err = p.parseBracket()
+		default:
+			return path, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		path.segments = append(path.segments, seg)
+	}
+}
+
+// parseMemberSelectors parses the selectors following a `.` or `..`.
+func (p *parser) parseMemberSelectors() ([]selector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return []selector{wildcardSelector{}}, nil
+	case c == '[':
+		return p.parseBracket()
+	case isNameChar(c):
+		return []selector{nameSelector{name: p.parseName()}}, nil
+	default:
+		return nil, p.errorf("expected a member name")
+	}
+}
+
+func isNameChar(c byte) bool {
+	return c == '_' || c == '-' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func (p *parser) parseName() string {
+	start := p.pos
+	for p.pos < len(p.input) && isNameChar(p.input[p.pos]) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// parseBracket parses a comma separated list of selectors between brackets.
+func (p *parser) parseBracket() ([]selector, error) {
+	p.pos++
+	var selectors []selector
+	for {
+		p.skipSpaces()
+		sel, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, sel)
+		p.skipSpaces()
+		switch {
+		case p.consume(","):
+		case p.consume("]"):
+			return selectors, nil
+		default:
+			return nil, p.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+func (p *parser) parseSelector() (selector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return nameSelector{name: name}, nil
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+	case c == '?':
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return filterSelector{expr: expr}, nil
+	case c == '-' || c == ':' || c >= '0' && c <= '9':
+		return p.parseIndexOrSlice()
+	default:
+		return nil, p.errorf("expected a selector")
+	}
+}
+
+func (p *parser) parseIndexOrSlice() (selector, error) {
+	start, err := p.parseOptionalInt()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if !p.consume(":") {
+		if start == nil {
+			return nil, p.errorf("expected an index")
+		}
+		return indexSelector{index: *start}, nil
+	}
+	sel := sliceSelector{start: start}
+	p.skipSpaces()
+	if sel.end, err = p.parseOptionalInt(); err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	if p.consume(":") {
+		p.skipSpaces()
+		if sel.step, err = p.parseOptionalInt(); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+func (p *parser) parseOptionalInt() (*int, error) {
+	start := p.pos
+	if p.peek() == '-' {
+		p.pos++
+	}
+	for p.pos < len(p.input) && p.input[p.pos] >= '0' && p.input[p.pos] <= '9' {
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, nil
+	}
+	i, err := strconv.Atoi(p.input[start:p.pos])
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("invalid index %q", p.input[start:p.pos])
+	}
+	return &i, nil
+}
+
+// parseString parses a single or double quoted string, supporting backslash escapes.
+func (p *parser) parseString() (string, error) {
+	quote := p.input[p.pos]
+	p.pos++
+	var sb strings.Builder
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		p.pos++
+		switch c {
+		case quote:
+			return sb.String(), nil
+		case '\\':
+			if p.pos == len(p.input) {
+				return "", p.errorf("unterminated string")
+			}
+			c = p.input[p.pos]
+			p.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 't':
+				c = '\t'
+			case 'r':
+				c = '\r'
+			}
+		}
+		sb.WriteByte(c)
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) parseOr() (filterExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !p.consume("||") {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+}
+
+func (p *parser) parseAnd() (filterExpr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpaces()
+		if !p.consume("&&") {
+			return left, nil
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary() (filterExpr, error) {
+	p.skipSpaces()
+	switch {
+	case p.peek() == '!' && !strings.HasPrefix(p.input[p.pos:], "!="):
+		p.pos++
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	case p.consume("("):
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.errorf("expected ')'")
+		}
+		return expr, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+var comparisonOperators = []string{"==", "!=", "<=", ">=", "=~", "<", ">"}
+
+func (p *parser) parseComparison() (filterExpr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	op := ""
+	for _, candidate := range comparisonOperators {
+		if p.consume(candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		q, ok := left.(queryOperand)
+		if !ok {
+			return nil, p.errorf("expected a comparison operator")
+		}
+		return existsExpr{query: q}, nil
+	}
+
+	p.skipSpaces()
+	if op == "=~" {
+		if c := p.peek(); c != '\'' && c != '"' {
+			return nil, p.errorf("expected a regular expression string")
+		}
+		pattern, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+		}
+		if err = checkSingular(left); err != nil {
+			return nil, err
+		}
+		return matchExpr{left: left, re: re}, nil
+	}
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSingular(left); err != nil {
+		return nil, err
+	}
+	if err = checkSingular(right); err != nil {
+		return nil, err
+	}
+	return compareExpr{left: left, op: op, right: right}, nil
+}
+
+// checkSingular checks that a compared query selects at most one value.
+func checkSingular(o operand) error {
+	if q, ok := o.(queryOperand); ok && !q.path.Definite() {
+		return errors.New("only paths selecting a single value can be compared")
+	}
+	return nil
+}
+
+func (p *parser) parseOperand() (operand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		path, err := p.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		return queryOperand{relative: c == '@', path: path}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		if err != nil {
+			return nil, err
+		}
+		return literalOperand{literal: s}, nil
+	case c == '-' || c >= '0' && c <= '9':
+		return p.parseNumber()
+	case p.consume("true"):
+		return literalOperand{literal: true}, nil
+	case p.consume("false"):
+		return literalOperand{literal: false}, nil
+	case p.consume("null"):
+		return literalOperand{literal: nil}, nil
+	default:
+		return nil, p.errorf("expected a path or a literal")
+	}
+}
+
+func (p *parser) parseNumber() (operand, error) {
+	start := p.pos
+	isFloat := false
+	for p.pos < len(p.input) && isNumberChar(p.input[p.pos]) {
+		if c := p.input[p.pos]; c == '.' || c == 'e' || c == 'E' {
+			isFloat = true
+		}
+		p.pos++
+	}
+	text := p.input[start:p.pos]
+	if !isFloat {
+		if i, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return literalOperand{literal: i}, nil
+		}
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("invalid number %q", text)
+	}
+	return literalOperand{literal: f}, nil
+}
+
+func isNumberChar(c byte) bool {
+	return c >= '0' && c <= '9' || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E'
+}
@@ -0,0 +1,361 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonpath implements a subset of JSONPath (RFC 9535) over pcommon maps and slices.
+package jsonpath // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/jsonpath"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// Node is a map, slice or value queried by a Path. Nodes reference the queried data,
+// so the values set through a Path are visible in it.
+type Node struct {
+	value pcommon.Value
+	m     pcommon.Map
+	s     pcommon.Slice
+	kind  nodeKind
+}
+
+type nodeKind int
+
+const (
+	valueNode nodeKind = iota
+	mapNode
+	sliceNode
+)
+
+// NewMapNode returns a Node querying m.
+func NewMapNode(m pcommon.Map) Node {
+	return Node{m: m, kind: mapNode}
+}
+
+// NewSliceNode returns a Node querying s.
+func NewSliceNode(s pcommon.Slice) Node {
+	return Node{s: s, kind: sliceNode}
+}
+
+// NewValueNode returns a Node querying v.
+func NewValueNode(v pcommon.Value) Node {
+	return Node{value: v}
+}
+
+func (n Node) asMap() (pcommon.Map, bool) {
+	switch {
+	case n.kind == mapNode:
+		return n.m, true
+	case n.kind == valueNode && n.value.Type() == pcommon.ValueTypeMap:
+		return n.value.Map(), true
+	}
+	return pcommon.Map{}, false
+}
+
+func (n Node) asSlice() (pcommon.Slice, bool) {
+	switch {
+	case n.kind == sliceNode:
+		return n.s, true
+	case n.kind == valueNode && n.value.Type() == pcommon.ValueTypeSlice:
+		return n.value.Slice(), true
+	}
+	return pcommon.Slice{}, false
+}
+
+// Value returns the value of the node as returned by OTTL paths: maps and slices are
+// returned as pcommon.Map and pcommon.Slice, and scalars as their Go type.
+func (n Node) Value() any {
+	switch n.kind {
+	case mapNode:
+		return n.m
+	case sliceNode:
+		return n.s
+	}
+	return ottlcommon.GetValue(n.value)
+}
+
+// CopyTo copies the value of the node to dest.
+func (n Node) CopyTo(dest pcommon.Value) {
+	switch n.kind {
+	case mapNode:
+		n.m.CopyTo(dest.SetEmptyMap())
+	case sliceNode:
+		n.s.CopyTo(dest.SetEmptySlice())
+	default:
+		n.value.CopyTo(dest)
+	}
+}
+
+// Path is a compiled JSONPath expression.
+type Path struct {
+	segments []segment
+}
+
+type segment struct {
+	// descendant is set for the `..` segments, applying the selectors to the node and
+	// all of its descendants.
+	descendant bool
+	selectors  []selector
+}
+
+// createMode tells the name selectors whether they create the missing map entries.
+type createMode int
+
+const (
+	selectExisting createMode = iota
+	createMap
+	createEmpty
+)
+
+// Definite returns whether the path selects at most one value, i.e. it only selects map
+// entries by name and slice elements by index.
+func (p *Path) Definite() bool {
+	for _, seg := range p.segments {
+		if !seg.definite() {
+			return false
+		}
+	}
+	return true
+}
+
+func (seg segment) definite() bool {
+	if seg.descendant || len(seg.selectors) != 1 {
+		return false
+	}
+	switch seg.selectors[0].(type) {
+	case nameSelector, indexSelector:
+		return true
+	}
+	return false
+}
+
+func (seg segment) selectsNames() bool {
+	if seg.descendant {
+		return false
+	}
+	for _, sel := range seg.selectors {
+		if _, ok := sel.(nameSelector); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Select returns the nodes selected by the path in root, in document order.
+func (p *Path) Select(root Node) []Node {
+	nodes := []Node{root}
+	for _, seg := range p.segments {
+		nodes = seg.apply(nodes, root, selectExisting)
+		if len(nodes) == 0 {
+			break
+		}
+	}
+	return nodes
+}
+
+// Set calls set with each value selected by the path in root. Missing map entries
+// selected by name are created, along with the maps containing them when the path
+// only selects names below them.
+func (p *Path) Set(root Node, set func(pcommon.Value) error) error {
+	if len(p.segments) == 0 {
+		return errors.New("the path must select values inside the target")
+	}
+	nodes := []Node{root}
+	for i, seg := range p.segments {
+		mode := selectExisting
+		switch {
+		case i == len(p.segments)-1:
+			mode = createEmpty
+		case p.segments[i+1].selectsNames():
+			mode = createMap
+		}
+		nodes = seg.apply(nodes, root, mode)
+		if len(nodes) == 0 {
+			return nil
+		}
+	}
+	for _, n := range nodes {
+		if err := set(n.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (seg segment) apply(nodes []Node, root Node, mode createMode) []Node {
+	var out []Node
+	for _, n := range nodes {
+		if seg.descendant {
+			for _, d := range descendants(n, nil) {
+				for _, sel := range seg.selectors {
+					out = sel.selectFrom(d, root, out)
+				}
+			}
+			continue
+		}
+		if mode != selectExisting {
+			// Create the missing entries before selecting any of them, as adding entries
+			// to a map invalidates the values previously taken from it.
+			for _, sel := range seg.selectors {
+				if name, ok := sel.(nameSelector); ok {
+					name.create(n, mode)
+				}
+			}
+		}
+		for _, sel := range seg.selectors {
+			out = sel.selectFrom(n, root, out)
+		}
+	}
+	return out
+}
+
+// descendants appends n and all of its descendants to out, parents before children.
+func descendants(n Node, out []Node) []Node {
+	out = append(out, n)
+	eachChild(n, func(c Node) { out = descendants(c, out) })
+	return out
+}
+
+// eachChild calls fn with the entries of a map or the elements of a slice.
+func eachChild(n Node, fn func(Node)) {
+	if m, ok := n.asMap(); ok {
+		for _, v := range m.All() {
+			fn(NewValueNode(v))
+		}
+	} else if s, ok := n.asSlice(); ok {
+		for i := 0; i < s.Len(); i++ {
+			fn(NewValueNode(s.At(i)))
+		}
+	}
+}
+
+type selector interface {
+	// selectFrom appends the children of n matched by the selector to out.
+	selectFrom(n, root Node, out []Node) []Node
+}
+
+type nameSelector struct {
+	name string
+}
+
+func (s nameSelector) selectFrom(n, _ Node, out []Node) []Node {
+	if m, ok := n.asMap(); ok {
+		if v, ok := m.Get(s.name); ok {
+			out = append(out, NewValueNode(v))
+		}
+	}
+	return out
+}
+
+func (s nameSelector) create(n Node, mode createMode) {
+	m, ok := n.asMap()
+	if !ok {
+		return
+	}
+	if _, ok = m.Get(s.name); ok {
+		return
+	}
+	if mode == createMap {
+		m.PutEmptyMap(s.name)
+	} else {
+		m.PutEmpty(s.name)
+	}
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectFrom(n, _ Node, out []Node) []Node {
+	eachChild(n, func(c Node) { out = append(out, c) })
+	return out
+}
+
+type indexSelector struct {
+	index int
+}
+
+func (s indexSelector) selectFrom(n, _ Node, out []Node) []Node {
+	sl, ok := n.asSlice()
+	if !ok {
+		return out
+	}
+	i := s.index
+	if i < 0 {
+		i += sl.Len()
+	}
+	if i >= 0 && i < sl.Len() {
+		out = append(out, NewValueNode(sl.At(i)))
+	}
+	return out
+}
+
+type sliceSelector struct {
+	start, end, step *int
+}
+
+func (s sliceSelector) selectFrom(n, _ Node, out []Node) []Node {
+	sl, ok := n.asSlice()
+	if !ok {
+		return out
+	}
+	for _, i := range s.indices(sl.Len()) {
+		out = append(out, NewValueNode(sl.At(i)))
+	}
+	return out
+}
+
+// indices returns the indices selected in a slice of length n, following the slice
+// semantics of RFC 9535.
+func (s sliceSelector) indices(n int) []int {
+	step := 1
+	if s.step != nil {
+		step = *s.step
+	}
+	if step == 0 {
+		return nil
+	}
+	start, end := 0, n
+	if step < 0 {
+		start, end = n-1, -n-1
+	}
+	if s.start != nil {
+		start = *s.start
+	}
+	if s.end != nil {
+		end = *s.end
+	}
+	if start < 0 {
+		start += n
+	}
+	if end < 0 {
+		end += n
+	}
+
+	var indices []int
+	if step > 0 {
+		lower, upper := min(max(start, 0), n), min(max(end, 0), n)
+		for i := lower; i < upper; i += step {
+			indices = append(indices, i)
+		}
+		return indices
+	}
+	upper, lower := min(max(start, -1), n-1), min(max(end, -1), n-1)
+	for i := upper; lower < i; i += step {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+type filterSelector struct {
+	expr filterExpr
+}
+
+func (s filterSelector) selectFrom(n, root Node, out []Node) []Node {
+	eachChild(n, func(c Node) {
+		if s.expr.eval(c, root) {
+			out = append(out, c)
+		}
+	})
+	return out
+}
@@ -0,0 +1,221 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonpath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func testDocument(t *testing.T) pcommon.Map {
+	m := pcommon.NewMap()
+	require.NoError(t, m.FromRaw(map[string]any{
+		"service":     "checkout",
+		"http.method": "GET",
+		"items": []any{
+			map[string]any{"name": "book", "price": 8.95, "tags": []any{"paper"}},
+			map[string]any{"name": "pen", "price": int64(2), "sale": true},
+			map[string]any{"name": "lamp", "price": 22.99, "owner": map[string]any{"name": "alice"}},
+		},
+		"limits": map[string]any{"max": int64(10)},
+	}))
+	return m
+}
+
+func values(nodes []Node) []any {
+	out := make([]any, 0, len(nodes))
+	for _, n := range nodes {
+		v := n.Value()
+		switch t := v.(type) {
+		case pcommon.Map:
+			v = t.AsRaw()
+		case pcommon.Slice:
+			v = t.AsRaw()
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		expr     string
+		definite bool
+		want     []any
+	}{
+		{expr: "$.service", definite: true, want: []any{"checkout"}},
+		{expr: "service", definite: true, want: []any{"checkout"}},
+		{expr: "$['http.method']", definite: true, want: []any{"GET"}},
+		{expr: `["http.method"]`, definite: true, want: []any{"GET"}},
+		{expr: "$.missing", definite: true, want: []any{}},
+		{expr: "$.items[0].name", definite: true, want: []any{"book"}},
+		{expr: "items[-1].name", definite: true, want: []any{"lamp"}},
+		{expr: "$.items[5].name", definite: true, want: []any{}},
+		{expr: "$.limits", definite: true, want: []any{map[string]any{"max": int64(10)}}},
+		{expr: "$.items[*].name", want: []any{"book", "pen", "lamp"}},
+		{expr: "$.items.*.price", want: []any{8.95, int64(2), 22.99}},
+		{expr: "$.items[0:2].name", want: []any{"book", "pen"}},
+		{expr: "$.items[::-1].name", want: []any{"lamp", "pen", "book"}},
+		{expr: "$.items[-2:].name", want: []any{"pen", "lamp"}},
+		{expr: "$.items[0,2]['name','price']", want: []any{"book", 8.95, "lamp", 22.99}},
+		{expr: "$..name", want: []any{"book", "pen", "lamp", "alice"}},
+		{expr: "$..[0]", want: []any{
+			map[string]any{"name": "book", "price": 8.95, "tags": []any{"paper"}},
+			"paper",
+		}},
+		{expr: "$.items[?(@.price < 10)].name", want: []any{"book", "pen"}},
+		{expr: "$.items[?@.price >= 8.95].name", want: []any{"book", "lamp"}},
+		{expr: "$.items[?(@.sale)].name", want: []any{"pen"}},
+		{expr: "$.items[?(!@.sale)].name", want: []any{"book", "lamp"}},
+		{expr: `$.items[?(@.name == "pen" || @.owner.name == 'alice')].price`, want: []any{int64(2), 22.99}},
+		{expr: "$.items[?(@.price > 5 && @.price < 10)].name", want: []any{"book"}},
+		{expr: "$.items[?(@.name =~ '^[bl]')].name", want: []any{"book", "lamp"}},
+		{expr: "$.items[?(@.price < $.limits.max)].name", want: []any{"book", "pen"}},
+		{expr: "$.items[?(@.sale == true)].name", want: []any{"pen"}},
+		{expr: "$.items[?(@.sale != true)].name", want: []any{"book", "lamp"}},
+		{expr: "$.items[?(@.tags[0] == 'paper')].name", want: []any{"book"}},
+		{expr: "$.items[?(@.name > 'l')].name", want: []any{"pen", "lamp"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := Parse(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.definite, path.Definite())
+			assert.Equal(t, tt.want, values(path.Select(NewMapNode(testDocument(t)))))
+		})
+	}
+}
+
+func TestSelect_roots(t *testing.T) {
+	path, err := Parse("$[1]")
+	require.NoError(t, err)
+
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{"a", "b"}))
+	assert.Equal(t, []any{"b"}, values(path.Select(NewSliceNode(s))))
+
+	v := pcommon.NewValueEmpty()
+	s.CopyTo(v.SetEmptySlice())
+	assert.Equal(t, []any{"b"}, values(path.Select(NewValueNode(v))))
+
+	v.SetStr("not a slice")
+	assert.Empty(t, path.Select(NewValueNode(v)))
+
+	root, err := Parse("$")
+	require.NoError(t, err)
+	assert.Equal(t, []any{[]any{"a", "b"}}, values(root.Select(NewSliceNode(s))))
+}
+
+func TestSet(t *testing.T) {
+	tests := []struct {
+		expr string
+		want func(m map[string]any)
+	}{
+		{
+			expr: "$.service",
+			want: func(m map[string]any) { m["service"] = "new" },
+		},
+		{
+			expr: "$.a.b.c",
+			want: func(m map[string]any) { m["a"] = map[string]any{"b": map[string]any{"c": "new"}} },
+		},
+		{
+			expr: "$['x','y']",
+			want: func(m map[string]any) {
+				m["x"] = "new"
+				m["y"] = "new"
+			},
+		},
+		{
+			expr: "$.items[*].status",
+			want: func(m map[string]any) {
+				for _, item := range m["items"].([]any) {
+					item.(map[string]any)["status"] = "new"
+				}
+			},
+		},
+		{
+			expr: "$.items[?(@.sale)].price",
+			want: func(m map[string]any) { m["items"].([]any)[1].(map[string]any)["price"] = "new" },
+		},
+		{
+			expr: "$.items[-1].owner.name",
+			want: func(m map[string]any) {
+				m["items"].([]any)[2].(map[string]any)["owner"] = map[string]any{"name": "new"}
+			},
+		},
+		{
+			expr: "$..name",
+			want: func(m map[string]any) {
+				items := m["items"].([]any)
+				items[0].(map[string]any)["name"] = "new"
+				items[1].(map[string]any)["name"] = "new"
+				items[2].(map[string]any)["name"] = "new"
+				items[2].(map[string]any)["owner"] = map[string]any{"name": "new"}
+			},
+		},
+		{
+			expr: "$.items[7].name",
+			want: func(map[string]any) {},
+		},
+		{
+			expr: "$.service.name",
+			want: func(map[string]any) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			path, err := Parse(tt.expr)
+			require.NoError(t, err)
+
+			doc := testDocument(t)
+			err = path.Set(NewMapNode(doc), func(v pcommon.Value) error {
+				v.SetStr("new")
+				return nil
+			})
+			require.NoError(t, err)
+
+			expected := testDocument(t).AsRaw()
+			tt.want(expected)
+			assert.Equal(t, expected, doc.AsRaw())
+		})
+	}
+}
+
+func TestSet_root(t *testing.T) {
+	path, err := Parse("$")
+	require.NoError(t, err)
+	err = path.Set(NewMapNode(pcommon.NewMap()), func(pcommon.Value) error { return nil })
+	assert.EqualError(t, err, "the path must select values inside the target")
+}
+
+func TestParse_errors(t *testing.T) {
+	tests := []struct {
+		expr string
+		err  string
+	}{
+		{expr: "", err: `invalid path "": empty path`},
+		{expr: "$.", err: `invalid path "$.": expected a member name at offset 2`},
+		{expr: "$[", err: `invalid path "$[": expected a selector at offset 2`},
+		{expr: "$[0", err: `invalid path "$[0": expected ',' or ']' at offset 3`},
+		{expr: "$['a", err: `invalid path "$['a": unterminated string at offset 4`},
+		{expr: "$.a b", err: `invalid path "$.a b": unexpected ' ' at offset 3`},
+		{expr: "$[?(@.a == )]", err: `invalid path "$[?(@.a == )]": expected a path or a literal at offset 11`},
+		{expr: "$[?(@.a == 1]", err: `invalid path "$[?(@.a == 1]": expected ')' at offset 12`},
+		{expr: "$[?('a')]", err: `invalid path "$[?('a')]": expected a comparison operator at offset 7`},
+		{expr: "$[?(@.a =~ 1)]", err: `invalid path "$[?(@.a =~ 1)]": expected a regular expression string at offset 11`},
+		{expr: "$[?(@.a =~ '(')]", err: "invalid path \"$[?(@.a =~ '(')]\": invalid regular expression \"(\": error parsing regexp: missing closing ): `(`"},
+		{expr: "$[?(@.a[*] == 1)]", err: `invalid path "$[?(@.a[*] == 1)]": only paths selecting a single value can be compared`},
+		{expr: "#", err: `invalid path "#": unexpected '#' at offset 0`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// LambdaGetter is an argument of a higher-order converter given as an inline lambda
+// expression, such as `x => ToLowerCase(x)` or `(k, v) => v != nil`.
+type LambdaGetter[K any] interface {
+	// Arity returns the number of parameters of the lambda.
+	Arity() int
+	// Call evaluates the body of the lambda with its parameters bound to args,
+	// which must contain exactly Arity values.
+	Call(ctx context.Context, tCtx K, args ...any) (any, error)
+}
+
+// StandardLambdaGetter is a basic implementation of LambdaGetter.
+type StandardLambdaGetter[K any] struct {
+	Parameters int
+	Lambda     func(ctx context.Context, tCtx K, args []any) (any, error)
+}
+
+// Arity returns the number of parameters of the lambda.
+func (g StandardLambdaGetter[K]) Arity() int {
+	return g.Parameters
+}
+
+// Call evaluates the lambda with its parameters bound to args.
+// If the number of args doesn't match the number of parameters, an error is returned.
+func (g StandardLambdaGetter[K]) Call(ctx context.Context, tCtx K, args ...any) (any, error) {
+	if len(args) != g.Parameters {
+		return nil, fmt.Errorf("lambda expects %d arguments but got %d", g.Parameters, len(args))
+	}
+	return g.Lambda(ctx, tCtx, args)
+}
+
+// lambdaScope holds the parameters of a lambda while its body is parsed. At runtime, it is
+// the context key of the arguments the lambda is called with.
+type lambdaScope struct {
+	parameters []string
+	parent     *lambdaScope
+}
+
+// lookup returns the scope declaring the parameter name, searching from the innermost lambda.
+func (s *lambdaScope) lookup(name string) (*lambdaScope, int) {
+	for scope := s; scope != nil; scope = scope.parent {
+		if i := slices.Index(scope.parameters, name); i >= 0 {
+			return scope, i
+		}
+	}
+	return nil, -1
+}
+
+func (p *Parser[K]) newLambdaGetter(l *lambda) (LambdaGetter[K], error) {
+	for i, name := range l.Parameters {
+		if slices.Contains(l.Parameters[:i], name) {
+			return nil, fmt.Errorf("duplicate lambda parameter %q", name)
+		}
+	}
+
+	scope := &lambdaScope{parameters: l.Parameters, parent: p.lambdaScope}
+	// The body is parsed by a copy of the parser, so that its parameters are only visible within the lambda.
+	bodyParser := *p
+	bodyParser.lambdaScope = scope
+
+	var body func(ctx context.Context, tCtx K) (any, error)
+	if l.Condition != nil {
+		condition, err := bodyParser.newBoolExpr(l.Condition)
+		if err != nil {
+			return nil, err
+		}
+		body = func(ctx context.Context, tCtx K) (any, error) {
+			return condition.Eval(ctx, tCtx)
+		}
+	} else {
+		getter, err := bodyParser.newGetter(*l.Value)
+		if err != nil {
+			return nil, err
+		}
+		body = getter.Get
+	}
+
+	return StandardLambdaGetter[K]{
+		Parameters: len(l.Parameters),
+		Lambda: func(ctx context.Context, tCtx K, args []any) (any, error) {
+			return body(context.WithValue(ctx, scope, args), tCtx)
+		},
+	}, nil
+}
+
+// newLambdaParameterGetter returns a getter for the path if it refers to a parameter of an
+// enclosing lambda, or nil otherwise.
+func (p *Parser[K]) newLambdaParameterGetter(path *path) (Getter[K], error) {
+	if p.lambdaScope == nil {
+		return nil, nil
+	}
+	if path.Context != "" {
+		if scope, _ := p.lambdaScope.lookup(path.Context); scope != nil {
+			return nil, fmt.Errorf("lambda parameter %q has no fields, use keys to access its elements", path.Context)
+		}
+		return nil, nil
+	}
+	if len(path.Fields) == 0 {
+		return nil, nil
+	}
+	name := path.Fields[0].Name
+	scope, index := p.lambdaScope.lookup(name)
+	if scope == nil {
+		return nil, nil
+	}
+	if len(path.Fields) > 1 {
+		return nil, fmt.Errorf("lambda parameter %q has no fields, use keys to access its elements", name)
+	}
+	keys := path.Fields[0].Keys
+	for _, k := range keys {
+		if k.String == nil && k.Int == nil {
+			return nil, fmt.Errorf("lambda parameter %q can only be indexed with string or int literals", name)
+		}
+	}
+
+	return &exprGetter[K]{
+		expr: Expr[K]{
+			exprFunc: func(ctx context.Context, _ K) (any, error) {
+				args, ok := ctx.Value(scope).([]any)
+				if !ok {
+					return nil, errors.New("lambda parameter used outside of its lambda; this is an error in OTTL")
+				}
+				return args[index], nil
+			},
+		},
+		keys: keys,
+	}, nil
+}
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+type applyArguments struct {
+	Target Getter[any]
+	Fn     LambdaGetter[any]
+}
+
+// apply calls the lambda with each element of the target list, and its index when the lambda has 2 parameters.
+func apply(target Getter[any], fn LambdaGetter[any]) (ExprFunc[any], error) {
+	return func(ctx context.Context, tCtx any) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		var results []any
+		for i, elem := range val.([]any) {
+			args := []any{elem}
+			if fn.Arity() == 2 {
+				args = []any{int64(i), elem}
+			}
+			result, err := fn.Call(ctx, tCtx, args...)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+		}
+		return results, nil
+	}, nil
+}
+
+type echoArguments struct {
+	Value Getter[any]
+}
+
+func echo(value Getter[any]) (ExprFunc[any], error) {
+	return value.Get, nil
+}
+
+func newLambdaTestParser(t *testing.T) Parser[any] {
+	p, err := NewParser[any](
+		CreateFactoryMap(
+			createFactory("Apply", &applyArguments{}, apply),
+			createFactory("Echo", &echoArguments{}, echo),
+		),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_lambda(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		expected any
+	}{
+		{
+			name:     "value body",
+			expr:     `Apply([1, 2, 3], x => x * 2)`,
+			expected: []any{int64(2), int64(4), int64(6)},
+		},
+		{
+			name:     "condition body",
+			expr:     `Apply(["a", "b"], x => x == "a" or x == "c")`,
+			expected: []any{true, false},
+		},
+		{
+			name:     "converter body",
+			expr:     `Apply([1], x => Echo(x))`,
+			expected: []any{int64(1)},
+		},
+		{
+			name:     "indexed parameter",
+			expr:     `Apply([{"k": "v"}], m => m["k"])`,
+			expected: []any{"v"},
+		},
+		{
+			name:     "parameter as key",
+			expr:     `Apply([0], i => attributes[i])`,
+			expected: []any{"tCtx"},
+		},
+		{
+			name:     "two parameters",
+			expr:     `Apply([5, 5], (i, x) => x - i)`,
+			expected: []any{int64(5), int64(4)},
+		},
+		{
+			name:     "nested lambdas capture outer parameters",
+			expr:     `Apply([1, 2], x => Apply([10], y => x + y))`,
+			expected: []any{[]any{int64(11)}, []any{int64(12)}},
+		},
+		{
+			name:     "inner parameter shadows outer parameter",
+			expr:     `Apply([1], x => Apply([2], x => x))`,
+			expected: []any{[]any{int64(2)}},
+		},
+		{
+			name:     "telemetry path in body",
+			expr:     `Apply([1], x => name)`,
+			expected: []any{"tCtx"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newLambdaTestParser(t)
+			expr, err := p.ParseValueExpression(tt.expr)
+			require.NoError(t, err)
+			got, err := expr.Eval(context.Background(), "tCtx")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func Test_lambda_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr string
+	}{
+		{
+			name:    "duplicate parameter",
+			expr:    `Apply([1], (x, x) => x)`,
+			wantErr: `duplicate lambda parameter "x"`,
+		},
+		{
+			name:    "lambda required",
+			expr:    `Apply([1], 1)`,
+			wantErr: "invalid argument at position 1: must be a lambda expression",
+		},
+		{
+			name:    "lambda not supported",
+			expr:    `Echo(x => x)`,
+			wantErr: "invalid argument at position 0: lambda expressions are not supported for this argument",
+		},
+		{
+			name:    "parameter fields",
+			expr:    `Apply([1], x => x.foo)`,
+			wantErr: `lambda parameter "x" has no fields, use keys to access its elements`,
+		},
+		{
+			name:    "parameter dynamic key",
+			expr:    `Apply([1], x => x[name])`,
+			wantErr: `lambda parameter "x" can only be indexed with string or int literals`,
+		},
+		{
+			name:    "parameter out of scope",
+			expr:    `Apply(Apply([1], x => x), y => x)`,
+			wantErr: "bad path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newLambdaTestParser(t)
+			_, err := p.ParseValueExpression(tt.expr)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_lambda_paths(t *testing.T) {
+	parsed, err := parseStatement(`set(attributes["a"], Apply(attributes["b"], x => x[0] == name and Apply(x, y => y != x)))`)
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range getParsedStatementPaths(parsed) {
+		names = append(names, buildOriginalText(&p))
+	}
+	assert.Equal(t, []string{"attributes[a]", "attributes[b]", "name"}, names)
+}
+
+func Test_lambda_call_arity(t *testing.T) {
+	fn := StandardLambdaGetter[any]{
+		Parameters: 2,
+		Lambda: func(_ context.Context, _ any, args []any) (any, error) {
+			return args[1], nil
+		},
+	}
+	got, err := fn.Call(context.Background(), nil, "k", "v")
+	require.NoError(t, err)
+	assert.Equal(t, "v", got)
+
+	_, err = fn.Call(context.Background(), nil, "k")
+	assert.EqualError(t, err, "lambda expects 2 arguments but got 1")
+}
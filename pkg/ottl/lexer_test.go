@@ -139,6 +139,17 @@ func Test_lexer(t *testing.T) {
 			{"Punct", "]"},
 			{"Punct", "]"},
 		}},
+		{"lambda", `(k, v) => v != k`, false, []result{
+			{"LParen", "("},
+			{"Lowercase", "k"},
+			{"Punct", ","},
+			{"Lowercase", "v"},
+			{"RParen", ")"},
+			{"Arrow", "=>"},
+			{"Lowercase", "v"},
+			{"OpComparison", "!="},
+			{"Lowercase", "k"},
+		}},
 		{"Dynamic path with math expression", `attributes["foo"][Len(attributes["foo"]) - 1]`, false, []result{
 			{"Lowercase", "attributes"},
 			{"Punct", "["},
@@ -0,0 +1,496 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+// constantFolder is a grammarVisitor replacing the math expressions and the boolean expressions
+// whose result is known at parse time by their result, so that they aren't evaluated for each
+// TransformContext.
+type constantFolder[K any] struct {
+	parser *Parser[K]
+}
+
+func (f *constantFolder[K]) visitPath(*path) {}
+
+func (f *constantFolder[K]) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (f *constantFolder[K]) visitEditor(e *editor) {
+	f.foldLambdas(e.Arguments)
+}
+
+func (f *constantFolder[K]) visitConverter(c *converter) {
+	f.foldLambdas(c.Arguments)
+}
+
+func (f *constantFolder[K]) visitValue(v *value) {
+	if v.MathExpression == nil {
+		return
+	}
+	result, ok := foldMathExpression(v.MathExpression)
+	if !ok {
+		return
+	}
+	switch r := result.(type) {
+	case int64:
+		v.Literal = &mathExprLiteral{Int: &r}
+	case float64:
+		v.Literal = &mathExprLiteral{Float: &r}
+	default:
+		return
+	}
+	v.MathExpression = nil
+}
+
+func (f *constantFolder[K]) foldLambdas(arguments []argument) {
+	for _, a := range arguments {
+		if a.Lambda != nil && a.Lambda.Condition != nil {
+			f.foldBooleanExpression(a.Lambda.Condition)
+		}
+	}
+}
+
+// foldBooleanExpression simplifies the terms of the expression whose result is known at parse
+// time, and returns the result of the expression if it's known.
+func (f *constantFolder[K]) foldBooleanExpression(be *booleanExpression) *bool {
+	terms := []*term{be.Left}
+	for _, r := range be.Right {
+		terms = append(terms, r.Term)
+	}
+	var kept []*term
+	for _, t := range terms {
+		result := f.foldTerm(t)
+		if result == nil {
+			kept = append(kept, t)
+			continue
+		}
+		if *result {
+			if len(kept) == 0 {
+				be.Left, be.Right = &term{Left: constantBooleanValue(true)}, nil
+				return result
+			}
+			// The terms before the true term are still evaluated, so that their errors are
+			// returned, only the terms after it are short-circuited.
+			kept = append(kept, &term{Left: constantBooleanValue(true)})
+			break
+		}
+		// A false term doesn't change the result of OR.
+	}
+	if len(kept) == 0 {
+		be.Left, be.Right = &term{Left: constantBooleanValue(false)}, nil
+		result := false
+		return &result
+	}
+	be.Left, be.Right = kept[0], nil
+	for _, t := range kept[1:] {
+		be.Right = append(be.Right, &opOrTerm{Operator: "or", Term: t})
+	}
+	return nil
+}
+
+func (f *constantFolder[K]) foldTerm(t *term) *bool {
+	values := []*booleanValue{t.Left}
+	for _, r := range t.Right {
+		values = append(values, r.Value)
+	}
+	var kept []*booleanValue
+	for _, bv := range values {
+		result := f.foldBooleanValue(bv)
+		if result == nil {
+			kept = append(kept, bv)
+			continue
+		}
+		if !*result {
+			if len(kept) == 0 {
+				t.Left, t.Right = constantBooleanValue(false), nil
+				return result
+			}
+			// The values before the false value are still evaluated, so that their errors are
+			// returned, only the values after it are short-circuited.
+			kept = append(kept, constantBooleanValue(false))
+			break
+		}
+		// A true value doesn't change the result of AND.
+	}
+	if len(kept) == 0 {
+		t.Left, t.Right = constantBooleanValue(true), nil
+		result := true
+		return &result
+	}
+	t.Left, t.Right = kept[0], nil
+	for _, bv := range kept[1:] {
+		t.Right = append(t.Right, &opAndBooleanValue{Operator: "and", Value: bv})
+	}
+	return nil
+}
+
+func (f *constantFolder[K]) foldBooleanValue(bv *booleanValue) *bool {
+	var result *bool
+	switch {
+	case bv.Comparison != nil:
+		f.visitValue(&bv.Comparison.Left)
+		f.visitValue(&bv.Comparison.Right)
+		left, leftOk := f.constantValue(bv.Comparison.Left)
+		right, rightOk := f.constantValue(bv.Comparison.Right)
+		if leftOk && rightOk {
+			r := (&ottlValueComparator{}).compare(left, right, bv.Comparison.Op)
+			result = &r
+		}
+	case bv.ConstExpr != nil && bv.ConstExpr.Boolean != nil:
+		r := bool(*bv.ConstExpr.Boolean)
+		result = &r
+	case bv.SubExpr != nil:
+		result = f.foldBooleanExpression(bv.SubExpr)
+	}
+	if result == nil {
+		return nil
+	}
+	r := *result
+	if bv.Negation != nil {
+		r = !r
+	}
+	*bv = *constantBooleanValue(r)
+	return &r
+}
+
+// constantValue returns the value of a literal.
+func (f *constantFolder[K]) constantValue(v value) (any, bool) {
+	switch {
+	case v.IsNil != nil:
+		return nil, true
+	case v.String != nil:
+		return *v.String, true
+	case v.Bool != nil:
+		return bool(*v.Bool), true
+	case v.Bytes != nil:
+		return []byte(*v.Bytes), true
+	case v.Enum != nil:
+		enum, err := f.parser.enumParser((*EnumSymbol)(v.Enum))
+		if err != nil {
+			// The error is reported when the value is parsed.
+			return nil, false
+		}
+		return int64(*enum), true
+	case v.Literal != nil && v.Literal.Int != nil:
+		return *v.Literal.Int, true
+	case v.Literal != nil && v.Literal.Float != nil:
+		return *v.Literal.Float, true
+	}
+	return nil, false
+}
+
+func constantBooleanValue(b bool) *booleanValue {
+	result := boolean(b)
+	return &booleanValue{ConstExpr: &constExpr{Boolean: &result}}
+}
+
+// foldMathExpression returns the result of the math expression if all its operands are literals.
+// Expressions that fail, such as a division by zero, aren't folded so that they fail when evaluated.
+func foldMathExpression(expr *mathExpression) (any, bool) {
+	result, ok := foldAddSubTerm(expr.Left)
+	for _, r := range expr.Right {
+		if !ok {
+			return nil, false
+		}
+		var y any
+		y, ok = foldAddSubTerm(r.Term)
+		if ok {
+			result, ok = foldMathOperation(result, r.Operator, y)
+		}
+	}
+	return result, ok
+}
+
+func foldAddSubTerm(term *addSubTerm) (any, bool) {
+	result, ok := foldMathValue(term.Left)
+	for _, r := range term.Right {
+		if !ok {
+			return nil, false
+		}
+		var y any
+		y, ok = foldMathValue(r.Value)
+		if ok {
+			result, ok = foldMathOperation(result, r.Operator, y)
+		}
+	}
+	return result, ok
+}
+
+func foldMathValue(val *mathValue) (any, bool) {
+	switch {
+	case val.Literal != nil && val.Literal.Int != nil:
+		return *val.Literal.Int, true
+	case val.Literal != nil && val.Literal.Float != nil:
+		return *val.Literal.Float, true
+	case val.SubExpression != nil:
+		return foldMathExpression(val.SubExpression)
+	}
+	return nil, false
+}
+
+func foldMathOperation(x any, op mathOp, y any) (any, bool) {
+	result, err := attemptMathOperation[any](&literal[any]{value: x}, op, &literal[any]{value: y}).Get(context.Background(), nil)
+	return result, err == nil
+}
+
+// expressionMemo holds the converter calls appearing several times in the conditions and let
+// statements of a group of statements. The result of these calls is shared by the statements
+// of the group until an editor is executed, as editors can change the values they depend on.
+// At runtime, it is the context key of the results.
+type expressionMemo struct {
+	// slots maps the keys of the deduplicated converter calls to the index of their result.
+	slots map[string]int
+	// estimated holds the keys of the calls already accounted for by the cost estimates.
+	estimated map[string]struct{}
+}
+
+// memoValues holds the results of the deduplicated converter calls for a TransformContext.
+// A result is valid while its generation is the current one.
+type memoValues struct {
+	generation uint64
+	entries    []memoEntry
+}
+
+type memoEntry struct {
+	generation uint64
+	value      any
+	err        error
+}
+
+func newMemoValues(memo *expressionMemo) *memoValues {
+	// The entries are created with generation 0, which is never the current one.
+	return &memoValues{generation: 1, entries: make([]memoEntry, len(memo.slots))}
+}
+
+// newExpressionMemo returns the memo of the converter calls appearing several times in the
+// conditions and let statements of the given statements, or nil if there are none.
+func (p *Parser[K]) newExpressionMemo(statements []string) *expressionMemo {
+	counter := &converterCounter{counts: map[string]int{}}
+	for _, statement := range statements {
+		parsed, err := parseStatement(statement)
+		if err != nil {
+			// The error is reported when the statement is parsed.
+			continue
+		}
+		// The calls are identified once folded, as they are when the statement is parsed.
+		p.foldStatement(parsed)
+		if parsed.Let != nil {
+			parsed.Let.accept(counter)
+		}
+		if parsed.WhereClause != nil {
+			parsed.WhereClause.accept(counter)
+		}
+	}
+	memo := &expressionMemo{slots: map[string]int{}, estimated: map[string]struct{}{}}
+	for key, count := range counter.counts {
+		if count > 1 {
+			memo.slots[key] = len(memo.slots)
+		}
+	}
+	if len(memo.slots) == 0 {
+		return nil
+	}
+	return memo
+}
+
+// slot returns the index of the result of the converter call, if it's deduplicated.
+func (m *expressionMemo) slot(c *converter) (int, bool) {
+	if m == nil {
+		return 0, false
+	}
+	key, ok := converterKey(c)
+	if !ok {
+		return 0, false
+	}
+	slot, ok := m.slots[key]
+	return slot, ok
+}
+
+func memoize[K any](memo *expressionMemo, slot int, call Expr[K]) Expr[K] {
+	return Expr[K]{
+		exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+			values, ok := ctx.Value(memo).(*memoValues)
+			if !ok {
+				return call.Eval(ctx, tCtx)
+			}
+			entry := &values.entries[slot]
+			if entry.generation == values.generation {
+				return entry.value, entry.err
+			}
+			val, err := call.Eval(ctx, tCtx)
+			*entry = memoEntry{generation: values.generation, value: val, err: err}
+			return val, err
+		},
+	}
+}
+
+// converterCounter is a grammarVisitor counting the occurrences of the deterministic converter calls.
+type converterCounter struct {
+	counts map[string]int
+}
+
+func (c *converterCounter) visitPath(*path) {}
+
+func (c *converterCounter) visitEditor(*editor) {}
+
+func (c *converterCounter) visitValue(*value) {}
+
+func (c *converterCounter) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (c *converterCounter) visitConverter(v *converter) {
+	if key, ok := converterKey(v); ok {
+		c.counts[key]++
+	}
+}
+
+// converterKey returns a key identifying the converter call, ignoring the keys used to index
+// its result. Calls that might not be deterministic, which are assumed to be the ones without
+// arguments such as Now() or UUID(), or the ones depending on them, have no key.
+func converterKey(c *converter) (string, bool) {
+	checker := &nonDeterministicChecker{}
+	for i := range c.Arguments {
+		c.Arguments[i].accept(checker)
+	}
+	if len(c.Arguments) == 0 || checker.found {
+		return "", false
+	}
+	var sb strings.Builder
+	sb.WriteString(c.Function)
+	writeGrammarKey(&sb, reflect.ValueOf(c.Arguments))
+	return sb.String(), true
+}
+
+type nonDeterministicChecker struct {
+	found bool
+}
+
+func (n *nonDeterministicChecker) visitPath(*path) {}
+
+func (n *nonDeterministicChecker) visitEditor(*editor) {}
+
+func (n *nonDeterministicChecker) visitValue(*value) {}
+
+func (n *nonDeterministicChecker) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (n *nonDeterministicChecker) visitConverter(c *converter) {
+	if len(c.Arguments) == 0 {
+		n.found = true
+	}
+}
+
+var positionType = reflect.TypeOf(lexer.Position{})
+
+// writeGrammarKey writes a canonical representation of a grammar node, ignoring the positions of its tokens.
+func writeGrammarKey(sb *strings.Builder, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		writeGrammarKey(sb, v.Elem())
+	case reflect.Struct:
+		sb.WriteString(v.Type().Name())
+		sb.WriteByte('{')
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Type == positionType {
+				continue
+			}
+			writeGrammarKey(sb, v.Field(i))
+			sb.WriteByte(',')
+		}
+		sb.WriteByte('}')
+	case reflect.Slice:
+		sb.WriteByte('[')
+		for i := 0; i < v.Len(); i++ {
+			writeGrammarKey(sb, v.Index(i))
+			sb.WriteByte(',')
+		}
+		sb.WriteByte(']')
+	case reflect.String:
+		sb.WriteString(strconv.Quote(v.String()))
+	default:
+		fmt.Fprint(sb, v.Interface())
+	}
+}
+
+// Relative costs of the nodes of a statement, used to estimate the cost of executing it.
+const (
+	pathCost         = 1
+	functionCallCost = 10
+	memoizedCallCost = 1
+)
+
+// costEstimator is a grammarVisitor estimating the cost of executing a statement.
+type costEstimator struct {
+	cost int
+	memo *expressionMemo
+}
+
+func (c *costEstimator) visitPath(*path) {
+	c.cost += pathCost
+}
+
+func (c *costEstimator) visitEditor(*editor) {
+	c.cost += functionCallCost
+}
+
+func (c *costEstimator) visitValue(*value) {}
+
+func (c *costEstimator) visitMathExprLiteral(*mathExprLiteral) {}
+
+func (c *costEstimator) visitConverter(v *converter) {
+	if c.memo != nil {
+		if key, ok := converterKey(v); ok {
+			if _, ok := c.memo.slots[key]; ok {
+				// Only the first call of a deduplicated converter is evaluated.
+				if _, estimated := c.memo.estimated[key]; estimated {
+					c.cost += memoizedCallCost
+					return
+				}
+				c.memo.estimated[key] = struct{}{}
+			}
+		}
+	}
+	c.cost += functionCallCost
+}
+
+// foldStatement replaces the parts of the statement whose result is known at parse time by their result.
+func (p *Parser[K]) foldStatement(parsed *parsedStatement) {
+	folder := &constantFolder[K]{parser: p}
+	parsed.accept(folder)
+	if parsed.Let != nil && parsed.Let.Condition != nil {
+		folder.foldBooleanExpression(parsed.Let.Condition)
+	}
+	if parsed.WhereClause != nil {
+		folder.foldBooleanExpression(parsed.WhereClause)
+	}
+}
+
+// foldCondition replaces the parts of the condition whose result is known at parse time by their result.
+func (p *Parser[K]) foldCondition(parsed *booleanExpression) {
+	folder := &constantFolder[K]{parser: p}
+	parsed.accept(folder)
+	folder.foldBooleanExpression(parsed)
+}
+
+// foldValue replaces the parts of the value whose result is known at parse time by their result.
+func (p *Parser[K]) foldValue(parsed *value) {
+	parsed.accept(&constantFolder[K]{parser: p})
+}
+
+// estimateCost returns an estimate of the relative cost of executing the statement.
+func (p *Parser[K]) estimateCost(parsed *parsedStatement) int {
+	estimator := &costEstimator{memo: p.memo}
+	parsed.accept(estimator)
+	return estimator.cost
+}
@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func grammarKey(node any) string {
+	var sb strings.Builder
+	writeGrammarKey(&sb, reflect.ValueOf(node))
+	return sb.String()
+}
+
+func Test_foldCondition(t *testing.T) {
+	tests := []struct {
+		condition string
+		expected  string
+	}{
+		{
+			condition: `1 + 2 * 3 == 7 and name == "x"`,
+			expected:  `name == "x"`,
+		},
+		{
+			condition: `1 == 2 or name == "x"`,
+			expected:  `name == "x"`,
+		},
+		{
+			condition: `name == "x" or "a" == "a"`,
+			expected:  `name == "x" or true`,
+		},
+		{
+			condition: `"a" == "a" or name == "x"`,
+			expected:  `true`,
+		},
+		{
+			condition: `not (1.5 > 2) and name == "x"`,
+			expected:  `name == "x"`,
+		},
+		{
+			condition: `name == "x" and (false or nil != nil)`,
+			expected:  `name == "x" and false`,
+		},
+		{
+			condition: `false and name == "x"`,
+			expected:  `false`,
+		},
+		{
+			condition: `IsMatch(name, "x") and 1 == 2 and name == "y"`,
+			expected:  `IsMatch(name, "x") and false`,
+		},
+		{
+			condition: `name == 1 + 2 or name == (4 - 1) * 2.0`,
+			expected:  `name == 3 or name == 6.0`,
+		},
+		{
+			condition: `name == 10 / 0`,
+			expected:  `name == 10 / 0`,
+		},
+		{
+			condition: `IsMatch(name, "x") and 0x01 == 0x01`,
+			expected:  `IsMatch(name, "x")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			p, _ := NewParser[any](CreateFactoryMap[any](), nil, componenttest.NewNopTelemetrySettings())
+			parsed, err := parseCondition(tt.condition)
+			require.NoError(t, err)
+			p.foldCondition(parsed)
+			expected, err := parseCondition(tt.expected)
+			require.NoError(t, err)
+			assert.Equal(t, grammarKey(expected), grammarKey(parsed))
+		})
+	}
+}
+
+func Test_foldValue(t *testing.T) {
+	p, _ := NewParser[any](CreateFactoryMap[any](), nil, componenttest.NewNopTelemetrySettings())
+	parsed, err := parseValueExpression(`[1 + 1, name + 1, {"a": 2 * 2.5}]`)
+	require.NoError(t, err)
+	p.foldValue(parsed)
+	expected, err := parseValueExpression(`[2, name + 1, {"a": 5.0}]`)
+	require.NoError(t, err)
+	assert.Equal(t, grammarKey(expected), grammarKey(parsed))
+}
+
+func Test_expressionDeduplication(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		expected   []any
+		calls      int
+	}{
+		{
+			name: "shared by conditions and let statements",
+			statements: []string{
+				`record(1) where Count(name) == "other"`,
+				`let x = Count(name)`,
+				`record(x) where Count(name) == "tctx"`,
+			},
+			expected: []any{"tctx"},
+			calls:    1,
+		},
+		{
+			name: "repeated in a condition",
+			statements: []string{
+				`record(1) where Count(name) == "other" or Count(name) == "tctx"`,
+			},
+			expected: []any{int64(1)},
+			calls:    1,
+		},
+		{
+			name: "folded arguments",
+			statements: []string{
+				`record(1) where Count(1 + 1) == 3`,
+				`record(2) where Count(2) == 2`,
+			},
+			expected: []any{int64(2)},
+			calls:    1,
+		},
+		{
+			name: "invalidated by editors",
+			statements: []string{
+				`record(1) where Count(name) == "tctx"`,
+				`record(2) where Count(name) == "tctx"`,
+			},
+			expected: []any{int64(1), int64(2)},
+			calls:    2,
+		},
+		{
+			name: "editor arguments",
+			statements: []string{
+				`record(Count(name)) where Count(name) == "tctx"`,
+			},
+			expected: []any{"tctx"},
+			calls:    2,
+		},
+		{
+			name: "lambdas",
+			statements: []string{
+				`record(1) where Apply([1], v => Count(name)) == ["tctx"] and Count(name) == "tctx"`,
+				`record(2) where Count(name) == "other"`,
+			},
+			expected: []any{int64(1)},
+			calls:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded []any
+			var calls int
+			p := newVariablesTestParser(t, &recorded, &calls)
+			WithExpressionDeduplication[any]()(&p)
+			statements, err := p.ParseStatements(tt.statements)
+			require.NoError(t, err)
+			sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+			require.NoError(t, sequence.Execute(context.Background(), "tctx"))
+			assert.Equal(t, tt.expected, recorded)
+			assert.Equal(t, tt.calls, calls)
+
+			// The results aren't shared between TransformContexts.
+			recorded = nil
+			require.NoError(t, sequence.Execute(context.Background(), "tctx"))
+			assert.Equal(t, tt.expected, recorded)
+			assert.Equal(t, 2*tt.calls, calls)
+		})
+	}
+}
+
+func Test_expressionDeduplication_disabled(t *testing.T) {
+	var recorded []any
+	var calls int
+	p := newVariablesTestParser(t, &recorded, &calls)
+	statements, err := p.ParseStatements([]string{
+		`record(1) where Count(name) == "other"`,
+		`record(2) where Count(name) == "tctx"`,
+	})
+	require.NoError(t, err)
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, sequence.Execute(context.Background(), "tctx"))
+	assert.Equal(t, []any{int64(2)}, recorded)
+	assert.Equal(t, 2, calls)
+}
+
+func Test_converterKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		first         string
+		second        string
+		equal         bool
+		deterministic bool
+	}{
+		{
+			name:          "same call",
+			first:         `Count(name)`,
+			second:        `Count( name )`,
+			equal:         true,
+			deterministic: true,
+		},
+		{
+			name:          "keys are ignored",
+			first:         `Count(attributes)["a"]`,
+			second:        `Count(attributes)["b"]`,
+			equal:         true,
+			deterministic: true,
+		},
+		{
+			name:          "different arguments",
+			first:         `Count(attributes["a"])`,
+			second:        `Count(attributes["b"])`,
+			deterministic: true,
+		},
+		{
+			name:   "no arguments",
+			first:  `Now()`,
+			second: `Now()`,
+		},
+		{
+			name:   "depends on a call without arguments",
+			first:  `Count(Now())`,
+			second: `Count(Now())`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, err := parseValueExpression(tt.first)
+			require.NoError(t, err)
+			second, err := parseValueExpression(tt.second)
+			require.NoError(t, err)
+			firstKey, firstOk := converterKey(first.Literal.Converter)
+			secondKey, secondOk := converterKey(second.Literal.Converter)
+			assert.Equal(t, tt.deterministic, firstOk)
+			assert.Equal(t, tt.deterministic, secondOk)
+			if tt.deterministic {
+				assert.Equal(t, tt.equal, firstKey == secondKey)
+			}
+		})
+	}
+}
+
+func Test_Statement_Cost(t *testing.T) {
+	p := newVariablesTestParser(t, nil, nil)
+	simple, err := p.ParseStatement(`record(name)`)
+	require.NoError(t, err)
+	withCondition, err := p.ParseStatement(`record(name) where Count(name) == "a"`)
+	require.NoError(t, err)
+	folded, err := p.ParseStatement(`record(name) where 1 + 1 == 2`)
+	require.NoError(t, err)
+	assert.Equal(t, functionCallCost+pathCost, simple.Cost())
+	assert.Equal(t, 2*functionCallCost+2*pathCost, withCondition.Cost())
+	assert.Equal(t, simple.Cost(), folded.Cost())
+
+	WithExpressionDeduplication[any]()(&p)
+	statements, err := p.ParseStatements([]string{
+		`record(name) where Count(name) == "a"`,
+		`record(name) where Count(name) == "b"`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, withCondition.Cost(), statements[0].Cost())
+	assert.Equal(t, functionCallCost+memoizedCallCost+2*pathCost, statements[1].Cost())
+}
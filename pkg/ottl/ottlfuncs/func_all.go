@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type AllArguments[K any] struct {
+	Target ottl.Getter[K]
+	Fn     ottl.LambdaGetter[K]
+}
+
+func NewAllFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("All", &AllArguments[K]{}, createAllFunction[K])
+}
+
+func createAllFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*AllArguments[K])
+
+	if !ok {
+		return nil, errors.New("AllFactory args must be of type *AllArguments[K]")
+	}
+
+	return quantify("All", args.Target, args.Fn, true)
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_all(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   []any
+		expected bool
+		calls    int
+	}{
+		{
+			name:     "mismatch stops iteration",
+			target:   []any{int64(1), "a", int64(3)},
+			expected: false,
+			calls:    2,
+		},
+		{
+			name:     "all match",
+			target:   []any{int64(1), int64(2)},
+			expected: true,
+			calls:    2,
+		},
+		{
+			name:     "empty",
+			target:   []any{},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			exprFunc, err := createAllFunction[any](ottl.FunctionContext{}, &AllArguments[any]{
+				Target: valueGetter(tt.target),
+				Fn: lambda1(func(x any) (any, error) {
+					calls++
+					_, ok := x.(int64)
+					return ok, nil
+				}),
+			})
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.calls, calls)
+		})
+	}
+}
+
+func Test_all_map(t *testing.T) {
+	exprFunc, err := createAllFunction[any](ottl.FunctionContext{}, &AllArguments[any]{
+		Target: valueGetter(map[string]any{"a": int64(1), "b": int64(2)}),
+		Fn: lambda2(func(_ string, v any) (any, error) {
+			return v.(int64) > 1, nil
+		}),
+	})
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, result)
+}
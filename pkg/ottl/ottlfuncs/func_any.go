@@ -0,0 +1,29 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type AnyArguments[K any] struct {
+	Target ottl.Getter[K]
+	Fn     ottl.LambdaGetter[K]
+}
+
+func NewAnyFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Any", &AnyArguments[K]{}, createAnyFunction[K])
+}
+
+func createAnyFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*AnyArguments[K])
+
+	if !ok {
+		return nil, errors.New("AnyFactory args must be of type *AnyArguments[K]")
+	}
+
+	return quantify("Any", args.Target, args.Fn, false)
+}
@@ -0,0 +1,165 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1" //nolint:gosec // the Community ID specification uses SHA-1
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// IANA protocol numbers with specific handling in the Community ID specification.
+const (
+	protocolICMP   = 1
+	protocolTCP    = 6
+	protocolUDP    = 17
+	protocolICMPv6 = 58
+	protocolSCTP   = 132
+)
+
+// icmpEquivalents maps the ICMP message types to the types of their replies (and the
+// other way around), so that both directions of an exchange get the same Community ID.
+var (
+	icmpEquivalents = map[int64]int64{
+		0: 8, 8: 0, // echo reply and request
+		9: 10, 10: 9, // router advertisement and solicitation
+		13: 14, 14: 13, // timestamp request and reply
+		15: 16, 16: 15, // information request and reply
+		17: 18, 18: 17, // address mask request and reply
+	}
+	icmpv6Equivalents = map[int64]int64{
+		128: 129, 129: 128, // echo request and reply
+		130: 131, 131: 130, // multicast listener query and report
+		133: 134, 134: 133, // router solicitation and advertisement
+		135: 136, 136: 135, // neighbor solicitation and advertisement
+		144: 145, 145: 144, // home agent address discovery request and reply
+	}
+)
+
+type CommunityIDArguments[K any] struct {
+	SourceIP        ottl.StringGetter[K]
+	DestinationIP   ottl.StringGetter[K]
+	SourcePort      ottl.IntGetter[K]
+	DestinationPort ottl.IntGetter[K]
+	Protocol        ottl.IntGetter[K]
+	Seed            ottl.Optional[int64]
+}
+
+func NewCommunityIDFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("CommunityID", &CommunityIDArguments[K]{}, createCommunityIDFunction[K])
+}
+
+func createCommunityIDFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*CommunityIDArguments[K])
+
+	if !ok {
+		return nil, errors.New("CommunityIDFactory args must be of type *CommunityIDArguments[K]")
+	}
+
+	return communityID(args.SourceIP, args.DestinationIP, args.SourcePort, args.DestinationPort, args.Protocol, args.Seed)
+}
+
+// communityID returns the version 1 Community ID of a network flow, as defined by
+// https://github.com/corelight/community-id-spec.
+func communityID[K any](srcIP, dstIP ottl.StringGetter[K], srcPort, dstPort, protocol ottl.IntGetter[K], s ottl.Optional[int64]) (ottl.ExprFunc[K], error) {
+	var seed uint16
+	if !s.IsEmpty() {
+		if s.Get() < 0 || s.Get() > math.MaxUint16 {
+			return nil, fmt.Errorf("invalid seed %d for CommunityID, must be between 0 and %d", s.Get(), math.MaxUint16)
+		}
+		seed = uint16(s.Get())
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		src, err := getFlowAddr(ctx, tCtx, srcIP)
+		if err != nil {
+			return nil, err
+		}
+		dst, err := getFlowAddr(ctx, tCtx, dstIP)
+		if err != nil {
+			return nil, err
+		}
+		if len(src) != len(dst) {
+			return nil, errors.New("the source and destination IP addresses must be of the same version")
+		}
+		proto, err := getInRange(ctx, tCtx, protocol, "protocol", math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		sport, err := getInRange(ctx, tCtx, srcPort, "source port", math.MaxUint16)
+		if err != nil {
+			return nil, err
+		}
+		dport, err := getInRange(ctx, tCtx, dstPort, "destination port", math.MaxUint16)
+		if err != nil {
+			return nil, err
+		}
+
+		oneWay := false
+		switch proto {
+		case protocolICMP:
+			dport, oneWay = icmpPorts(icmpEquivalents, sport, dport)
+		case protocolICMPv6:
+			dport, oneWay = icmpPorts(icmpv6Equivalents, sport, dport)
+		}
+		if !oneWay {
+			if c := bytes.Compare(src, dst); c > 0 || c == 0 && sport > dport {
+				src, dst = dst, src
+				sport, dport = dport, sport
+			}
+		}
+
+		h := sha1.New() //nolint:gosec // the Community ID specification uses SHA-1
+		_ = binary.Write(h, binary.BigEndian, seed)
+		h.Write(src)
+		h.Write(dst)
+		h.Write([]byte{byte(proto), 0})
+		switch proto {
+		case protocolICMP, protocolTCP, protocolUDP, protocolICMPv6, protocolSCTP:
+			_ = binary.Write(h, binary.BigEndian, uint16(sport))
+			_ = binary.Write(h, binary.BigEndian, uint16(dport))
+		}
+		return "1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}, nil
+}
+
+func getFlowAddr[K any](ctx context.Context, tCtx K, getter ottl.StringGetter[K]) ([]byte, error) {
+	val, err := getter.Get(ctx, tCtx)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := parseAddr(val)
+	if err != nil {
+		return nil, err
+	}
+	return addr.AsSlice(), nil
+}
+
+func getInRange[K any](ctx context.Context, tCtx K, getter ottl.IntGetter[K], name string, maxValue int64) (int64, error) {
+	val, err := getter.Get(ctx, tCtx)
+	if err != nil {
+		return 0, err
+	}
+	if val < 0 || val > maxValue {
+		return 0, fmt.Errorf("invalid %s %d, must be between 0 and %d", name, val, maxValue)
+	}
+	return val, nil
+}
+
+// icmpPorts returns the value used as destination port for an ICMP message of the given
+// type and code: the type of the matching reply or request when there is one, or the code
+// for one-way messages.
+func icmpPorts(equivalents map[int64]int64, msgType, code int64) (int64, bool) {
+	if reply, ok := equivalents[msgType]; ok {
+		return reply, false
+	}
+	return code, true
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func stringGetter(v string) ottl.StringGetter[any] {
+	return &ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return v, nil
+		},
+	}
+}
+
+func intGetter(v int64) ottl.IntGetter[any] {
+	return &ottl.StandardIntGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return v, nil
+		},
+	}
+}
+
+func Test_communityID(t *testing.T) {
+	tests := []struct {
+		name     string
+		srcIP    string
+		dstIP    string
+		srcPort  int64
+		dstPort  int64
+		protocol int64
+		seed     ottl.Optional[int64]
+		expected string
+	}{
+		{
+			name:     "tcp",
+			srcIP:    "128.232.110.120",
+			dstIP:    "66.35.250.204",
+			srcPort:  34855,
+			dstPort:  80,
+			protocol: protocolTCP,
+			expected: "1:LQU9qZlK+B5F3KDmev6m5PMibrg=",
+		},
+		{
+			name:     "tcp reversed",
+			srcIP:    "66.35.250.204",
+			dstIP:    "128.232.110.120",
+			srcPort:  80,
+			dstPort:  34855,
+			protocol: protocolTCP,
+			expected: "1:LQU9qZlK+B5F3KDmev6m5PMibrg=",
+		},
+		{
+			name:     "tcp with seed",
+			srcIP:    "128.232.110.120",
+			dstIP:    "66.35.250.204",
+			srcPort:  34855,
+			dstPort:  80,
+			protocol: protocolTCP,
+			seed:     ottl.NewTestingOptional[int64](1),
+			expected: "1:3V71V58M3Ksw/yuFALMcW0LAHvc=",
+		},
+		{
+			name:     "udp",
+			srcIP:    "192.168.1.52",
+			dstIP:    "8.8.8.8",
+			srcPort:  54585,
+			dstPort:  53,
+			protocol: protocolUDP,
+			expected: "1:d/FP5EW3wiY1vCndhwleRRKHowQ=",
+		},
+		{
+			name:     "icmp echo request",
+			srcIP:    "192.168.0.89",
+			dstIP:    "192.168.0.1",
+			srcPort:  8,
+			dstPort:  0,
+			protocol: protocolICMP,
+			expected: "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=",
+		},
+		{
+			name:     "icmp echo reply",
+			srcIP:    "192.168.0.1",
+			dstIP:    "192.168.0.89",
+			srcPort:  0,
+			dstPort:  0,
+			protocol: protocolICMP,
+			expected: "1:X0snYXpgwiv9TZtqg64sgzUn6Dk=",
+		},
+		{
+			name:     "icmpv6 neighbor solicitation",
+			srcIP:    "fe80::200:86ff:fe05:80da",
+			dstIP:    "fe80::260:97ff:fe07:69ea",
+			srcPort:  135,
+			dstPort:  0,
+			protocol: protocolICMPv6,
+			expected: "1:dGHyGvjMfljg6Bppwm3bg0LO8TY=",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := communityID[any](stringGetter(tt.srcIP), stringGetter(tt.dstIP), intGetter(tt.srcPort), intGetter(tt.dstPort), intGetter(tt.protocol), tt.seed)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_communityID_error(t *testing.T) {
+	tests := []struct {
+		name     string
+		srcIP    string
+		dstIP    string
+		srcPort  int64
+		protocol int64
+		err      string
+	}{
+		{name: "invalid address", srcIP: "10.0.0", dstIP: "10.0.0.1", protocol: protocolTCP, err: `invalid IP address "10.0.0"`},
+		{name: "mixed versions", srcIP: "10.0.0.1", dstIP: "::1", protocol: protocolTCP, err: "the source and destination IP addresses must be of the same version"},
+		{name: "invalid protocol", srcIP: "10.0.0.1", dstIP: "10.0.0.2", protocol: 256, err: "invalid protocol 256, must be between 0 and 255"},
+		{name: "invalid port", srcIP: "10.0.0.1", dstIP: "10.0.0.2", srcPort: 65536, protocol: protocolTCP, err: "invalid source port 65536, must be between 0 and 65535"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := communityID[any](stringGetter(tt.srcIP), stringGetter(tt.dstIP), intGetter(tt.srcPort), intGetter(80), intGetter(tt.protocol), ottl.Optional[int64]{})
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	_, err := communityID[any](stringGetter(""), stringGetter(""), intGetter(0), intGetter(0), intGetter(0), ottl.NewTestingOptional[int64](65536))
+	assert.EqualError(t, err, "invalid seed 65536 for CommunityID, must be between 0 and 65535")
+}
@@ -5,7 +5,9 @@ package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"context"
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
@@ -63,13 +65,19 @@ func Decode[K any](target ottl.Getter[K], encoding string) (ottl.ExprFunc[K], er
 		switch encoding {
 		// base64 is not in IANA index, so we have to deal with this encoding separately
 		case "base64":
-			return decodeBase64(base64.StdEncoding, stringValue)
+			return decodeString(base64.StdEncoding.DecodeString, stringValue)
 		case "base64-raw":
-			return decodeBase64(base64.RawStdEncoding, stringValue)
+			return decodeString(base64.RawStdEncoding.DecodeString, stringValue)
 		case "base64-url":
-			return decodeBase64(base64.URLEncoding, stringValue)
+			return decodeString(base64.URLEncoding.DecodeString, stringValue)
 		case "base64-raw-url":
-			return decodeBase64(base64.RawURLEncoding, stringValue)
+			return decodeString(base64.RawURLEncoding.DecodeString, stringValue)
+		case "base32":
+			return decodeString(base32.StdEncoding.DecodeString, stringValue)
+		case "base32-hex":
+			return decodeString(base32.HexEncoding.DecodeString, stringValue)
+		case "hex":
+			return decodeString(hex.DecodeString, stringValue)
 		default:
 			e, err := textutils.LookupEncoding(encoding)
 			if err != nil {
@@ -86,8 +94,8 @@ func Decode[K any](target ottl.Getter[K], encoding string) (ottl.ExprFunc[K], er
 	}, nil
 }
 
-func decodeBase64(encoding *base64.Encoding, stringValue string) (any, error) {
-	decodedBytes, err := encoding.DecodeString(stringValue)
+func decodeString(decode func(string) ([]byte, error), stringValue string) (any, error) {
+	decodedBytes, err := decode(stringValue)
 	if err != nil {
 		return nil, fmt.Errorf("could not decode: %w", err)
 	}
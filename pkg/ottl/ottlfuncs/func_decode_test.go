@@ -195,6 +195,42 @@ func TestDecode(t *testing.T) {
 			encoding: "base64-raw-url",
 			want:     "Go?/Z~x",
 		},
+		{
+			name:     "base32",
+			value:    "NBSWY3DP",
+			encoding: "base32",
+			want:     "hello",
+		},
+		{
+			name:     "base32-hex",
+			value:    "D1IMOR3F",
+			encoding: "base32-hex",
+			want:     "hello",
+		},
+		{
+			name:          "not-base32-string",
+			value:         "NBSWY3D",
+			encoding:      "base32",
+			expectedError: "illegal base32 data at input byte",
+		},
+		{
+			name:     "hex",
+			value:    "68656c6c6f",
+			encoding: "hex",
+			want:     "hello",
+		},
+		{
+			name:     "hex uppercase",
+			value:    []byte("68656C6C6F"),
+			encoding: "hex",
+			want:     "hello",
+		},
+		{
+			name:          "not-hex-string",
+			value:         "68656c6c6g",
+			encoding:      "hex",
+			expectedError: "encoding/hex: invalid byte",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// defaultDecompressMaxSize is the default maximum size of the data returned by Decompress,
+// protecting the collector from decompression bombs.
+const defaultDecompressMaxSize = 10 << 20
+
+// zstdMinMaxWindow is the window size that the zstd format requires decoders to support, and
+// that the encoders use by default.
+const zstdMinMaxWindow = 8 << 20
+
+type DecompressArguments[K any] struct {
+	Target    ottl.ByteSliceLikeGetter[K]
+	Algorithm string
+	MaxSize   ottl.Optional[int64]
+}
+
+func NewDecompressFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Decompress", &DecompressArguments[K]{}, createDecompressFunction[K])
+}
+
+func createDecompressFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*DecompressArguments[K])
+	if !ok {
+		return nil, errors.New("DecompressFactory args must be of type *DecompressArguments[K]")
+	}
+
+	return decompress(args.Target, args.Algorithm, args.MaxSize)
+}
+
+func decompress[K any](target ottl.ByteSliceLikeGetter[K], algorithm string, maxSizeArg ottl.Optional[int64]) (ottl.ExprFunc[K], error) {
+	maxSize := int64(defaultDecompressMaxSize)
+	if !maxSizeArg.IsEmpty() {
+		maxSize = maxSizeArg.Get()
+		if maxSize <= 0 {
+			return nil, fmt.Errorf("invalid max_size %d for Decompress, must be greater than 0", maxSize)
+		}
+	}
+
+	var decompressor func([]byte) ([]byte, error)
+	switch algorithm {
+	case "gzip":
+		decompressor = func(data []byte) ([]byte, error) {
+			r, err := gzip.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			result, err := readLimited(r, maxSize)
+			return result, errors.Join(err, r.Close())
+		}
+	case "zlib":
+		decompressor = func(data []byte) ([]byte, error) {
+			r, err := zlib.NewReader(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			result, err := readLimited(r, maxSize)
+			return result, errors.Join(err, r.Close())
+		}
+	case "zstd":
+		decoders, err := newZstdDecoderPool(maxSize)
+		if err != nil {
+			return nil, err
+		}
+		decompressor = func(data []byte) ([]byte, error) {
+			decoder := decoders.Get().(*zstd.Decoder)
+			defer decoders.Put(decoder)
+			if err := decoder.Reset(bytes.NewReader(data)); err != nil {
+				return nil, err
+			}
+			result, err := readLimited(decoder, maxSize)
+			if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+				return nil, errDecompressedSizeExceeded(maxSize)
+			}
+			return result, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q for Decompress, must be one of gzip, zlib or zstd", algorithm)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		result, err := decompressor(val)
+		if err != nil {
+			return nil, fmt.Errorf("could not decompress: %w", err)
+		}
+		return result, nil
+	}, nil
+}
+
+// newZstdDecoderPool returns a pool of zstd decoders reused across calls. The decoders decode
+// synchronously, so they don't hold any goroutine while pooled. Their memory and window sizes
+// are bounded by maxSize or 8 MiB, whichever is larger, so that a frame declaring a huge window
+// or content size can't make them allocate it.
+func newZstdDecoderPool(maxSize int64) (*sync.Pool, error) {
+	maxMemory := min(max(uint64(maxSize), zstdMinMaxWindow), zstd.MaxWindowSize)
+	newDecoder := func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxMemory),
+			zstd.WithDecoderMaxWindow(maxMemory))
+	}
+	// The options are checked once, the decoders created by the pool can't fail afterwards.
+	decoder, err := newDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("invalid max_size %d for Decompress: %w", maxSize, err)
+	}
+	pool := &sync.Pool{
+		New: func() any {
+			d, _ := newDecoder()
+			return d
+		},
+	}
+	pool.Put(decoder)
+	return pool, nil
+}
+
+// readLimited reads r to the end, failing once more than maxSize bytes have been read.
+func readLimited(r io.Reader, maxSize int64) ([]byte, error) {
+	result, err := io.ReadAll(io.LimitReader(r, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(result)) > maxSize {
+		return nil, errDecompressedSizeExceeded(maxSize)
+	}
+	return result, nil
+}
+
+func errDecompressedSizeExceeded(maxSize int64) error {
+	return fmt.Errorf("the decompressed data exceeds the maximum size of %d bytes", maxSize)
+}
@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func compressGzip(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func compressZlib(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func compressZstd(t *testing.T, data string) []byte {
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+	return encoder.EncodeAll([]byte(data), nil)
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     any
+		algorithm string
+		maxSize   ottl.Optional[int64]
+		want      any
+		wantErr   string
+	}{
+		{
+			name:      "gzip",
+			value:     compressGzip(t, `{"logEvents":[]}`),
+			algorithm: "gzip",
+			want:      []byte(`{"logEvents":[]}`),
+		},
+		{
+			name:      "gzip from string",
+			value:     string(compressGzip(t, "hello")),
+			algorithm: "gzip",
+			want:      []byte("hello"),
+		},
+		{
+			name:      "zlib",
+			value:     compressZlib(t, "hello"),
+			algorithm: "zlib",
+			want:      []byte("hello"),
+		},
+		{
+			name:      "zstd",
+			value:     compressZstd(t, "hello"),
+			algorithm: "zstd",
+			want:      []byte("hello"),
+		},
+		{
+			name:      "nil",
+			value:     nil,
+			algorithm: "gzip",
+			want:      nil,
+		},
+		{
+			name:      "gzip exceeding max size",
+			value:     compressGzip(t, strings.Repeat("a", 101)),
+			algorithm: "gzip",
+			maxSize:   ottl.NewTestingOptional[int64](100),
+			wantErr:   "the decompressed data exceeds the maximum size of 100 bytes",
+		},
+		{
+			name:      "gzip with max size",
+			value:     compressGzip(t, strings.Repeat("a", 100)),
+			algorithm: "gzip",
+			maxSize:   ottl.NewTestingOptional[int64](100),
+			want:      []byte(strings.Repeat("a", 100)),
+		},
+		{
+			name:      "zlib exceeding max size",
+			value:     compressZlib(t, strings.Repeat("a", 101)),
+			algorithm: "zlib",
+			maxSize:   ottl.NewTestingOptional[int64](100),
+			wantErr:   "the decompressed data exceeds the maximum size of 100 bytes",
+		},
+		{
+			name:      "zstd exceeding max size",
+			value:     compressZstd(t, strings.Repeat("a", 101)),
+			algorithm: "zstd",
+			maxSize:   ottl.NewTestingOptional[int64](100),
+			wantErr:   "the decompressed data exceeds the maximum size of 100 bytes",
+		},
+		{
+			name:      "invalid gzip data",
+			value:     []byte("hello"),
+			algorithm: "gzip",
+			wantErr:   "could not decompress",
+		},
+		{
+			name:      "invalid zstd data",
+			value:     []byte("hello"),
+			algorithm: "zstd",
+			wantErr:   "could not decompress",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardByteSliceLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := decompress(target, tt.algorithm, tt.maxSize)
+			require.NoError(t, err)
+			got, err := exprFunc(context.Background(), nil)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDecompress_ZstdWindowExceedingMaxSize(t *testing.T) {
+	// A flushed stream declares its window size instead of its content size.
+	var buf bytes.Buffer
+	encoder, err := zstd.NewWriter(&buf, zstd.WithWindowSize(1<<24))
+	require.NoError(t, err)
+	_, err = encoder.Write([]byte(strings.Repeat("a", 1<<16)))
+	require.NoError(t, err)
+	require.NoError(t, encoder.Flush())
+	require.NoError(t, encoder.Close())
+
+	target := &ottl.StandardByteSliceLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return buf.Bytes(), nil
+		},
+	}
+	exprFunc, err := decompress(target, "zstd", ottl.NewTestingOptional[int64](1<<20))
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorIs(t, err, zstd.ErrWindowSizeExceeded)
+}
+
+func TestDecompress_InvalidArguments(t *testing.T) {
+	target := &ottl.StandardByteSliceLikeGetter[any]{}
+	_, err := decompress(target, "brotli", ottl.Optional[int64]{})
+	assert.ErrorContains(t, err, `unsupported algorithm "brotli" for Decompress`)
+	_, err = decompress(target, "gzip", ottl.NewTestingOptional[int64](0))
+	assert.ErrorContains(t, err, "invalid max_size 0 for Decompress")
+}
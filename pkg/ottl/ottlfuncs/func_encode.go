@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	neturl "net/url"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// encoders holds the functions encoding bytes to a string for each encoding supported by Encode.
+var encoders = map[string]func([]byte) string{
+	"base64":         base64.StdEncoding.EncodeToString,
+	"base64-raw":     base64.RawStdEncoding.EncodeToString,
+	"base64-url":     base64.URLEncoding.EncodeToString,
+	"base64-raw-url": base64.RawURLEncoding.EncodeToString,
+	"base32":         base32.StdEncoding.EncodeToString,
+	"base32-hex":     base32.HexEncoding.EncodeToString,
+	"hex":            hex.EncodeToString,
+	"url": func(b []byte) string {
+		return neturl.QueryEscape(string(b))
+	},
+}
+
+type EncodeArguments[K any] struct {
+	Target   ottl.ByteSliceLikeGetter[K]
+	Encoding string
+}
+
+func NewEncodeFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Encode", &EncodeArguments[K]{}, createEncodeFunction[K])
+}
+
+func createEncodeFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*EncodeArguments[K])
+	if !ok {
+		return nil, errors.New("EncodeFactory args must be of type *EncodeArguments[K]")
+	}
+
+	return encode(args.Target, args.Encoding)
+}
+
+func encode[K any](target ottl.ByteSliceLikeGetter[K], encoding string) (ottl.ExprFunc[K], error) {
+	encoder, ok := encoders[encoding]
+	if !ok {
+		return nil, fmt.Errorf("unsupported encoding %q for Encode", encoding)
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		return encoder(val), nil
+	}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		encoding string
+		want     any
+	}{
+		{
+			name:     "base64",
+			value:    "hello world?",
+			encoding: "base64",
+			want:     "aGVsbG8gd29ybGQ/",
+		},
+		{
+			name:     "base64 with padding",
+			value:    "hello",
+			encoding: "base64",
+			want:     "aGVsbG8=",
+		},
+		{
+			name:     "base64-raw",
+			value:    "hello",
+			encoding: "base64-raw",
+			want:     "aGVsbG8",
+		},
+		{
+			name:     "base64-url",
+			value:    "hello world?",
+			encoding: "base64-url",
+			want:     "aGVsbG8gd29ybGQ_",
+		},
+		{
+			name:     "base64-raw-url",
+			value:    []byte{0xfb, 0xff},
+			encoding: "base64-raw-url",
+			want:     "-_8",
+		},
+		{
+			name:     "base32",
+			value:    "hello",
+			encoding: "base32",
+			want:     "NBSWY3DP",
+		},
+		{
+			name:     "base32-hex",
+			value:    "hello",
+			encoding: "base32-hex",
+			want:     "D1IMOR3F",
+		},
+		{
+			name:     "hex",
+			value:    []byte{0xca, 0xfe},
+			encoding: "hex",
+			want:     "cafe",
+		},
+		{
+			name:     "url",
+			value:    "a b&c=d/é",
+			encoding: "url",
+			want:     "a+b%26c%3Dd%2F%C3%A9",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			encoding: "base64",
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardByteSliceLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := encode(target, tt.encoding)
+			require.NoError(t, err)
+			got, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncode_UnsupportedEncoding(t *testing.T) {
+	target := &ottl.StandardByteSliceLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "hello", nil
+		},
+	}
+	_, err := encode(target, "utf-8")
+	assert.ErrorContains(t, err, `unsupported encoding "utf-8" for Encode`)
+}
+
+func TestEncode_UnsupportedType(t *testing.T) {
+	target := &ottl.StandardByteSliceLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return map[string]any{}, nil
+		},
+	}
+	exprFunc, err := encode(target, "base64")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.Equal(t, ottl.TypeError("unsupported type: map[string]interface {}"), err)
+}
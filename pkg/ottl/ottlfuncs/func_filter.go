@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type FilterArguments[K any] struct {
+	Target ottl.Getter[K]
+	Fn     ottl.LambdaGetter[K]
+}
+
+func NewFilterFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Filter", &FilterArguments[K]{}, createFilterFunction[K])
+}
+
+func createFilterFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*FilterArguments[K])
+
+	if !ok {
+		return nil, errors.New("FilterFactory args must be of type *FilterArguments[K]")
+	}
+
+	return filter(args.Target, args.Fn)
+}
+
+func filter[K any](target ottl.Getter[K], fn ottl.LambdaGetter[K]) (ottl.ExprFunc[K], error) {
+	if err := checkArity("Filter", fn, 1, 2); err != nil {
+		return nil, err
+	}
+	if fn.Arity() == 2 {
+		mapTarget := ottl.StandardPMapGetter[K]{Getter: target.Get}
+		return func(ctx context.Context, tCtx K) (any, error) {
+			m, err := mapTarget.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			caller := newLambdaCaller(ctx, tCtx, fn)
+			output := pcommon.NewMap()
+			for k, v := range m.All() {
+				keep, err := caller.callPredicate(func() (any, error) { return caller.callEntry(k, v) })
+				if err != nil {
+					return nil, err
+				}
+				if keep {
+					v.CopyTo(output.PutEmpty(k))
+				}
+			}
+			return output, nil
+		}, nil
+	}
+
+	sliceTarget := ottl.StandardPSliceGetter[K]{Getter: target.Get}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := sliceTarget.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		caller := newLambdaCaller(ctx, tCtx, fn)
+		output := pcommon.NewSlice()
+		for i := 0; i < slice.Len(); i++ {
+			v := slice.At(i)
+			keep, err := caller.callPredicate(func() (any, error) { return caller.callElement(v) })
+			if err != nil {
+				return nil, err
+			}
+			if keep {
+				v.CopyTo(output.AppendEmpty())
+			}
+		}
+		return output, nil
+	}, nil
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func valueGetter(value any) ottl.StandardGetSetter[any] {
+	return ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return value, nil
+		},
+	}
+}
+
+func Test_filter_slice(t *testing.T) {
+	exprFunc, err := filter[any](
+		valueGetter([]any{int64(1), "a", int64(3), map[string]any{"b": "c"}}),
+		lambda1(func(x any) (any, error) {
+			_, ok := x.(int64)
+			return !ok, nil
+		}),
+	)
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", map[string]any{"b": "c"}}, result.(pcommon.Slice).AsRaw())
+}
+
+func Test_filter_map(t *testing.T) {
+	exprFunc, err := filter[any](
+		valueGetter(map[string]any{"http.method": "GET", "http.path": "/", "user": "bob"}),
+		lambda2(func(k string, _ any) (any, error) {
+			return k != "user", nil
+		}),
+	)
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"http.method": "GET", "http.path": "/"}, result.(pcommon.Map).AsRaw())
+}
+
+func Test_filter_errors(t *testing.T) {
+	_, err := filter[any](valueGetter(nil), ottl.StandardLambdaGetter[any]{Parameters: 3})
+	assert.EqualError(t, err, "Filter expects a lambda with 1 parameter for lists or 2 parameters for maps but got 3")
+
+	exprFunc, err := filter[any](valueGetter([]any{"a"}), lambda1(func(x any) (any, error) { return x, nil }))
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "lambda must return a bool but got string")
+
+	exprFunc, err = filter[any](valueGetter([]any{"a"}), lambda2(func(string, any) (any, error) { return true, nil }))
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, "expected pcommon.Map but got []interface {}")
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ForEachArguments[K any] struct {
+	Target ottl.PMapGetter[K]
+	Fn     ottl.LambdaGetter[K]
+}
+
+func NewForEachFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ForEach", &ForEachArguments[K]{}, createForEachFunction[K])
+}
+
+func createForEachFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ForEachArguments[K])
+
+	if !ok {
+		return nil, errors.New("ForEachFactory args must be of type *ForEachArguments[K]")
+	}
+
+	return forEach(args.Target, args.Fn)
+}
+
+func forEach[K any](target ottl.PMapGetter[K], fn ottl.LambdaGetter[K]) (ottl.ExprFunc[K], error) {
+	if err := checkArity("ForEach", fn, 2); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		m, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		caller := newLambdaCaller(ctx, tCtx, fn)
+		output := pcommon.NewMap()
+		output.EnsureCapacity(m.Len())
+		for k, v := range m.All() {
+			result, err := caller.callEntry(k, v)
+			if err != nil {
+				return nil, err
+			}
+			if err = setResultValue(output.PutEmpty(k), result); err != nil {
+				return nil, err
+			}
+		}
+		return output, nil
+	}, nil
+}
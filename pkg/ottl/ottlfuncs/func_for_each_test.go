@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func mapGetter(values map[string]any) ottl.StandardPMapGetter[any] {
+	return ottl.StandardPMapGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return values, nil
+		},
+	}
+}
+
+func lambda2(fn func(k string, v any) (any, error)) ottl.StandardLambdaGetter[any] {
+	return ottl.StandardLambdaGetter[any]{
+		Parameters: 2,
+		Lambda: func(_ context.Context, _ any, args []any) (any, error) {
+			return fn(args[0].(string), args[1])
+		},
+	}
+}
+
+func Test_forEach(t *testing.T) {
+	exprFunc, err := forEach[any](
+		mapGetter(map[string]any{"a": "x", "b": int64(1), "c": map[string]any{"d": "e"}}),
+		lambda2(func(k string, v any) (any, error) {
+			switch val := v.(type) {
+			case string:
+				return k + "=" + val, nil
+			case pcommon.Map:
+				return val, nil
+			}
+			return nil, nil
+		}),
+	)
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"a": "a=x", "b": nil, "c": map[string]any{"d": "e"}}, result.(pcommon.Map).AsRaw())
+}
+
+func Test_forEach_error(t *testing.T) {
+	exprFunc, err := forEach[any](
+		mapGetter(map[string]any{"a": "x"}),
+		lambda2(func(string, any) (any, error) {
+			return nil, errors.New("failed")
+		}),
+	)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "failed")
+}
+
+func Test_forEach_invalid_lambda(t *testing.T) {
+	_, err := forEach[any](mapGetter(nil), ottl.StandardLambdaGetter[any]{Parameters: 1})
+	assert.EqualError(t, err, "ForEach expects a lambda with 2 parameters but got 1")
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IPToIntArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewIPToIntFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IPToInt", &IPToIntArguments[K]{}, createIPToIntFunction[K])
+}
+
+func createIPToIntFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IPToIntArguments[K])
+
+	if !ok {
+		return nil, errors.New("IPToIntFactory args must be of type *IPToIntArguments[K]")
+	}
+
+	return ipToInt(args.Target), nil
+}
+
+// ipToInt returns the integer value of an IPv4 address. IPv6 addresses don't fit in an
+// int64 and are rejected.
+func ipToInt[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddr(val)
+		if err != nil {
+			return nil, err
+		}
+		if !addr.Is4() {
+			return nil, fmt.Errorf("IPToInt only supports IPv4 addresses but got %q", val)
+		}
+		b := addr.As4()
+		return int64(binary.BigEndian.Uint32(b[:])), nil
+	}
+}
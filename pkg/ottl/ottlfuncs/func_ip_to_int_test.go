@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ipToInt(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected int64
+	}{
+		{ip: "0.0.0.0", expected: 0},
+		{ip: "10.0.0.1", expected: 167772161},
+		{ip: "255.255.255.255", expected: 4294967295},
+		{ip: "::ffff:192.168.1.1", expected: 3232235777},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			target := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.ip, nil
+				},
+			}
+			result, err := ipToInt[any](target)(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ipToInt_error(t *testing.T) {
+	tests := []struct {
+		ip  string
+		err string
+	}{
+		{ip: "2001:db8::1", err: `IPToInt only supports IPv4 addresses but got "2001:db8::1"`},
+		{ip: "localhost", err: `invalid IP address "localhost"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			target := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.ip, nil
+				},
+			}
+			_, err := ipToInt[any](target)(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type IsInCIDRArguments[K any] struct {
+	Target ottl.StringLikeGetter[K]
+	CIDRs  []string
+}
+
+func NewIsInCIDRFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("IsInCIDR", &IsInCIDRArguments[K]{}, createIsInCIDRFunction[K])
+}
+
+func createIsInCIDRFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*IsInCIDRArguments[K])
+
+	if !ok {
+		return nil, errors.New("IsInCIDRFactory args must be of type *IsInCIDRArguments[K]")
+	}
+
+	return isInCIDR(args.Target, args.CIDRs)
+}
+
+func isInCIDR[K any](target ottl.StringLikeGetter[K], cidrs []string) (ottl.ExprFunc[K], error) {
+	if len(cidrs) == 0 {
+		return nil, errors.New("IsInCIDR requires at least one CIDR block")
+	}
+	trie, err := newPrefixTrie(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return false, nil
+		}
+		addr, err := parseAddr(*val)
+		if err != nil {
+			return nil, err
+		}
+		return trie.contains(addr), nil
+	}, nil
+}
@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_isInCIDR(t *testing.T) {
+	cidrs := []string{"10.0.0.0/8", "192.168.1.0/24", "172.16.5.4", "2001:db8::/32", "fd00::/8"}
+	tests := []struct {
+		name     string
+		ip       any
+		cidrs    []string
+		expected bool
+	}{
+		{name: "in first block", ip: "10.1.2.3", cidrs: cidrs, expected: true},
+		{name: "in last IPv4 block", ip: "192.168.1.255", cidrs: cidrs, expected: true},
+		{name: "outside IPv4 blocks", ip: "192.168.2.1", cidrs: cidrs, expected: false},
+		{name: "single address", ip: "172.16.5.4", cidrs: cidrs, expected: true},
+		{name: "next to single address", ip: "172.16.5.5", cidrs: cidrs, expected: false},
+		{name: "IPv6 in block", ip: "2001:db8:1::1", cidrs: cidrs, expected: true},
+		{name: "IPv6 outside blocks", ip: "2001:db9::1", cidrs: cidrs, expected: false},
+		{name: "IPv4-mapped IPv6", ip: "::ffff:10.0.0.1", cidrs: cidrs, expected: true},
+		{name: "IPv4 not in IPv6 block", ip: "32.1.13.184", cidrs: []string{"2001:db8::/32"}, expected: false},
+		{name: "default route", ip: "8.8.8.8", cidrs: []string{"0.0.0.0/0"}, expected: true},
+		{name: "nested blocks", ip: "10.20.0.1", cidrs: []string{"10.20.0.0/16", "10.0.0.0/8"}, expected: true},
+		{name: "unmasked block", ip: "10.20.0.1", cidrs: []string{"10.20.30.40/16"}, expected: true},
+		{name: "IPv4-mapped block", ip: "10.1.2.3", cidrs: []string{"::ffff:10.0.0.0/104"}, expected: true},
+		{name: "IPv4-mapped address in IPv4-mapped block", ip: "::ffff:10.1.2.3", cidrs: []string{"::ffff:10.0.0.0/104"}, expected: true},
+		{name: "outside IPv4-mapped block", ip: "11.1.2.3", cidrs: []string{"::ffff:10.0.0.0/104"}, expected: false},
+		{name: "IPv4-mapped address in IPv6 default route", ip: "::ffff:1.2.3.4", cidrs: []string{"::/0"}, expected: true},
+		{name: "IPv4-mapped address outside IPv6 block", ip: "::ffff:1.2.3.4", cidrs: []string{"::/97"}, expected: false},
+		{name: "nil", ip: nil, cidrs: cidrs, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardStringLikeGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.ip, nil
+				},
+			}
+			exprFunc, err := isInCIDR[any](target, tt.cidrs)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_isInCIDR_invalid_address(t *testing.T) {
+	target := &ottl.StandardStringLikeGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "not an ip", nil
+		},
+	}
+	exprFunc, err := isInCIDR[any](target, []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.ErrorContains(t, err, `invalid IP address "not an ip"`)
+}
+
+func Test_isInCIDR_invalid_blocks(t *testing.T) {
+	target := &ottl.StandardStringLikeGetter[any]{}
+	_, err := isInCIDR[any](target, []string{"10.0.0.0/33"})
+	assert.ErrorContains(t, err, `invalid CIDR block "10.0.0.0/33"`)
+
+	_, err = isInCIDR[any](target, []string{"localhost"})
+	assert.ErrorContains(t, err, `invalid CIDR block "localhost"`)
+
+	_, err = isInCIDR[any](target, nil)
+	assert.EqualError(t, err, "IsInCIDR requires at least one CIDR block")
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type MapArguments[K any] struct {
+	Target ottl.PSliceGetter[K]
+	Fn     ottl.LambdaGetter[K]
+}
+
+func NewMapFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Map", &MapArguments[K]{}, createMapFunction[K])
+}
+
+func createMapFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*MapArguments[K])
+
+	if !ok {
+		return nil, errors.New("MapFactory args must be of type *MapArguments[K]")
+	}
+
+	return mapSlice(args.Target, args.Fn)
+}
+
+func mapSlice[K any](target ottl.PSliceGetter[K], fn ottl.LambdaGetter[K]) (ottl.ExprFunc[K], error) {
+	if err := checkArity("Map", fn, 1); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		caller := newLambdaCaller(ctx, tCtx, fn)
+		output := pcommon.NewSlice()
+		output.EnsureCapacity(slice.Len())
+		for i := 0; i < slice.Len(); i++ {
+			result, err := caller.callElement(slice.At(i))
+			if err != nil {
+				return nil, err
+			}
+			if err = setResultValue(output.AppendEmpty(), result); err != nil {
+				return nil, err
+			}
+		}
+		return output, nil
+	}, nil
+}
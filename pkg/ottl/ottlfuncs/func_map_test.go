@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func sliceGetter(values ...any) ottl.StandardPSliceGetter[any] {
+	return ottl.StandardPSliceGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return values, nil
+		},
+	}
+}
+
+func lambda1(fn func(x any) (any, error)) ottl.StandardLambdaGetter[any] {
+	return ottl.StandardLambdaGetter[any]{
+		Parameters: 1,
+		Lambda: func(_ context.Context, _ any, args []any) (any, error) {
+			return fn(args[0])
+		},
+	}
+}
+
+func Test_map(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   []any
+		fn       func(x any) (any, error)
+		expected []any
+	}{
+		{
+			name:   "strings",
+			target: []any{"A", "b"},
+			fn: func(x any) (any, error) {
+				return strings.ToLower(x.(string)), nil
+			},
+			expected: []any{"a", "b"},
+		},
+		{
+			name:   "maps",
+			target: []any{map[string]any{"name": "foo"}, map[string]any{"name": "bar"}},
+			fn: func(x any) (any, error) {
+				v, _ := x.(pcommon.Map).Get("name")
+				return v.Str(), nil
+			},
+			expected: []any{"foo", "bar"},
+		},
+		{
+			name:   "slices",
+			target: []any{int64(1), int64(2)},
+			fn: func(x any) (any, error) {
+				return []int64{x.(int64), x.(int64) * 10}, nil
+			},
+			expected: []any{[]any{int64(1), int64(10)}, []any{int64(2), int64(20)}},
+		},
+		{
+			name:   "nil",
+			target: []any{"a"},
+			fn: func(any) (any, error) {
+				return nil, nil
+			},
+			expected: []any{nil},
+		},
+		{
+			name:     "empty",
+			target:   []any{},
+			expected: []any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := mapSlice[any](sliceGetter(tt.target...), lambda1(tt.fn))
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_map_invalid_lambda(t *testing.T) {
+	_, err := mapSlice[any](sliceGetter(), ottl.StandardLambdaGetter[any]{Parameters: 2})
+	assert.EqualError(t, err, "Map expects a lambda with 1 parameters but got 2")
+}
+
+func Test_map_max_lambda_calls(t *testing.T) {
+	target := make([]any, maxLambdaCalls/10)
+	for i := range target {
+		target[i] = int64(i)
+	}
+	var inner ottl.ExprFunc[any]
+	outer, err := mapSlice[any](sliceGetter(target...), ottl.StandardLambdaGetter[any]{
+		Parameters: 1,
+		Lambda: func(ctx context.Context, tCtx any, _ []any) (any, error) {
+			// The nested Map shares the budget of the outer one.
+			return inner(ctx, tCtx)
+		},
+	})
+	require.NoError(t, err)
+	inner, err = mapSlice[any](sliceGetter(target[:9]...), lambda1(func(x any) (any, error) { return x, nil }))
+	require.NoError(t, err)
+
+	// 1000 outer calls with 9 inner calls each.
+	_, err = outer(context.Background(), nil)
+	require.NoError(t, err)
+
+	inner, err = mapSlice[any](sliceGetter(target[:10]...), lambda1(func(x any) (any, error) { return x, nil }))
+	require.NoError(t, err)
+	_, err = outer(context.Background(), nil)
+	assert.EqualError(t, err, "lambda expressions can't be called more than 10000 times per call of the outermost higher-order converter")
+}
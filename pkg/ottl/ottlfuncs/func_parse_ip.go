@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+type ParseIPArguments[K any] struct {
+	Target ottl.StringGetter[K]
+}
+
+func NewParseIPFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseIP", &ParseIPArguments[K]{}, createParseIPFunction[K])
+}
+
+func createParseIPFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseIPArguments[K])
+
+	if !ok {
+		return nil, errors.New("ParseIPFactory args must be of type *ParseIPArguments[K]")
+	}
+
+	return parseIP(args.Target), nil
+}
+
+// parseIP returns a map describing an IP address: its canonical form, its version and
+// the ranges it belongs to.
+func parseIP[K any](target ottl.StringGetter[K]) ottl.ExprFunc[K] {
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := parseAddr(val)
+		if err != nil {
+			return nil, err
+		}
+
+		result := pcommon.NewMap()
+		result.PutStr("address", addr.String())
+		if addr.Is4() {
+			result.PutInt("version", 4)
+		} else {
+			result.PutInt("version", 6)
+		}
+		result.PutBool("private", addr.IsPrivate())
+		result.PutBool("loopback", addr.IsLoopback())
+		result.PutBool("multicast", addr.IsMulticast())
+		result.PutBool("link_local", addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast())
+		result.PutBool("unspecified", addr.IsUnspecified())
+		result.PutBool("global_unicast", addr.IsGlobalUnicast() && !addr.IsPrivate())
+		return result, nil
+	}
+}
+
+// parseAddr parses an IP address, handling IPv4-mapped IPv6 addresses as IPv4 addresses.
+func parseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q: %w", s, err)
+	}
+	return addr.Unmap(), nil
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_parseIP(t *testing.T) {
+	tests := []struct {
+		ip       string
+		expected map[string]any
+	}{
+		{
+			ip: "10.0.0.1",
+			expected: map[string]any{
+				"address": "10.0.0.1", "version": int64(4), "private": true, "loopback": false,
+				"multicast": false, "link_local": false, "unspecified": false, "global_unicast": false,
+			},
+		},
+		{
+			ip: "8.8.8.8",
+			expected: map[string]any{
+				"address": "8.8.8.8", "version": int64(4), "private": false, "loopback": false,
+				"multicast": false, "link_local": false, "unspecified": false, "global_unicast": true,
+			},
+		},
+		{
+			ip: "127.0.0.1",
+			expected: map[string]any{
+				"address": "127.0.0.1", "version": int64(4), "private": false, "loopback": true,
+				"multicast": false, "link_local": false, "unspecified": false, "global_unicast": false,
+			},
+		},
+		{
+			ip: "::ffff:192.168.0.1",
+			expected: map[string]any{
+				"address": "192.168.0.1", "version": int64(4), "private": true, "loopback": false,
+				"multicast": false, "link_local": false, "unspecified": false, "global_unicast": false,
+			},
+		},
+		{
+			ip: "2001:DB8::0:1",
+			expected: map[string]any{
+				"address": "2001:db8::1", "version": int64(6), "private": false, "loopback": false,
+				"multicast": false, "link_local": false, "unspecified": false, "global_unicast": true,
+			},
+		},
+		{
+			ip: "fe80::1",
+			expected: map[string]any{
+				"address": "fe80::1", "version": int64(6), "private": false, "loopback": false,
+				"multicast": false, "link_local": true, "unspecified": false, "global_unicast": false,
+			},
+		},
+		{
+			ip: "ff02::1",
+			expected: map[string]any{
+				"address": "ff02::1", "version": int64(6), "private": false, "loopback": false,
+				"multicast": true, "link_local": true, "unspecified": false, "global_unicast": false,
+			},
+		},
+		{
+			ip: "::",
+			expected: map[string]any{
+				"address": "::", "version": int64(6), "private": false, "loopback": false,
+				"multicast": false, "link_local": false, "unspecified": true, "global_unicast": false,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			target := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.ip, nil
+				},
+			}
+			result, err := parseIP[any](target)(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_parseIP_error(t *testing.T) {
+	target := &ottl.StandardStringGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "10.0.0.256", nil
+		},
+	}
+	_, err := parseIP[any](target)(context.Background(), nil)
+	assert.ErrorContains(t, err, `invalid IP address "10.0.0.256"`)
+}
@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// severityNames holds the names of the severity numbers, as used by the otel preset.
+var severityNames = []string{
+	"trace", "trace2", "trace3", "trace4",
+	"debug", "debug2", "debug3", "debug4",
+	"info", "info2", "info3", "info4",
+	"warn", "warn2", "warn3", "warn4",
+	"error", "error2", "error3", "error4",
+	"fatal", "fatal2", "fatal3", "fatal4",
+}
+
+// severityPresets holds the values recognized by each preset of ParseSeverity. They are the
+// same as the presets of the severity parser of the stanza operators.
+var severityPresets = func() map[string]map[string]plog.SeverityNumber {
+	otel := map[string]plog.SeverityNumber{}
+	for i, name := range severityNames {
+		severity := plog.SeverityNumber(i + 1)
+		otel[name] = severity
+		otel[strconv.Itoa(i+1)] = severity
+	}
+	defaultPreset := map[string]plog.SeverityNumber{
+		"warning":  plog.SeverityNumberWarn,
+		"warning2": plog.SeverityNumberWarn2,
+		"warning3": plog.SeverityNumberWarn3,
+		"warning4": plog.SeverityNumberWarn4,
+		"err":      plog.SeverityNumberError,
+		"err2":     plog.SeverityNumberError2,
+		"err3":     plog.SeverityNumberError3,
+		"err4":     plog.SeverityNumberError4,
+	}
+	for value, severity := range otel {
+		defaultPreset[value] = severity
+	}
+	return map[string]map[string]plog.SeverityNumber{
+		"none":    {},
+		"otel":    otel,
+		"aliases": otel,
+		"default": defaultPreset,
+	}
+}()
+
+// httpStatusRanges holds the special mapping values matching a range of HTTP status codes.
+var httpStatusRanges = map[string][2]int64{
+	"2xx": {200, 299},
+	"3xx": {300, 399},
+	"4xx": {400, 499},
+	"5xx": {500, 599},
+}
+
+type ParseSeverityArguments[K any] struct {
+	Target  ottl.Getter[K]
+	Mapping ottl.PMapGetter[K]
+	Preset  ottl.Optional[string]
+}
+
+func NewParseSeverityFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("ParseSeverity", &ParseSeverityArguments[K]{}, createParseSeverityFunction[K])
+}
+
+func createParseSeverityFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*ParseSeverityArguments[K])
+
+	if !ok {
+		return nil, errors.New("ParseSeverityFactory args must be of type *ParseSeverityArguments[K]")
+	}
+
+	return parseSeverity(args.Target, args.Mapping, args.Preset)
+}
+
+// parseSeverity returns the severity number of a value, looking it up in the mapping first
+// and in the preset then. Values matching neither get the unspecified severity number.
+func parseSeverity[K any](target ottl.Getter[K], mapping ottl.PMapGetter[K], presetArg ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	presetName := "default"
+	if !presetArg.IsEmpty() {
+		presetName = presetArg.Get()
+	}
+	preset, ok := severityPresets[presetName]
+	if !ok {
+		return nil, fmt.Errorf("unknown preset %q for ParseSeverity, must be one of default, otel, aliases or none", presetName)
+	}
+
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		key, err := severityKey(val)
+		if err != nil {
+			return nil, err
+		}
+		m, err := mapping.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		for name, values := range m.All() {
+			severity, ok := severityPresets["aliases"][strings.ToLower(name)]
+			if !ok {
+				return nil, fmt.Errorf("invalid severity %q in the mapping of ParseSeverity", name)
+			}
+			matched, err := matchesSeverityValues(key, values)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				return int64(severity), nil
+			}
+		}
+		return int64(preset[key]), nil
+	}, nil
+}
+
+// severityKey returns the string a value is compared as: lower case strings and decimal
+// representations of whole numbers.
+func severityKey(val any) (string, error) {
+	switch v := val.(type) {
+	case string:
+		return strings.ToLower(v), nil
+	case []byte:
+		return strings.ToLower(string(v)), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		if v != math.Trunc(v) {
+			return "", fmt.Errorf("%v cannot be a severity unless it is a whole number", v)
+		}
+		return strconv.FormatInt(int64(v), 10), nil
+	case pcommon.Value:
+		switch v.Type() {
+		case pcommon.ValueTypeStr, pcommon.ValueTypeBytes, pcommon.ValueTypeInt, pcommon.ValueTypeDouble:
+			return severityKey(v.AsRaw())
+		}
+		return "", fmt.Errorf("%s value cannot be a severity", v.Type())
+	default:
+		return "", fmt.Errorf("type %T cannot be a severity", val)
+	}
+}
+
+// matchesSeverityValues returns whether key matches the mapping values of a severity: a string,
+// a number, a range of HTTP status codes such as 2xx, a map with min and max numbers, or a
+// list of those.
+func matchesSeverityValues(key string, values pcommon.Value) (bool, error) {
+	switch values.Type() {
+	case pcommon.ValueTypeSlice:
+		for _, value := range values.Slice().All() {
+			matched, err := matchesSeverityValues(key, value)
+			if err != nil || matched {
+				return matched, err
+			}
+		}
+		return false, nil
+	case pcommon.ValueTypeStr:
+		if r, ok := httpStatusRanges[values.Str()]; ok {
+			return inSeverityRange(key, r[0], r[1]), nil
+		}
+		return strings.ToLower(values.Str()) == key, nil
+	case pcommon.ValueTypeInt:
+		return strconv.FormatInt(values.Int(), 10) == key, nil
+	case pcommon.ValueTypeMap:
+		minVal, minOK := values.Map().Get("min")
+		maxVal, maxOK := values.Map().Get("max")
+		if !minOK || !maxOK || minVal.Type() != pcommon.ValueTypeInt || maxVal.Type() != pcommon.ValueTypeInt {
+			return false, errors.New("a severity range must have integer min and max values")
+		}
+		return inSeverityRange(key, min(minVal.Int(), maxVal.Int()), max(minVal.Int(), maxVal.Int())), nil
+	default:
+		return false, fmt.Errorf("%s value cannot be parsed as a severity", values.Type())
+	}
+}
+
+func inSeverityRange(key string, minVal, maxVal int64) bool {
+	n, err := strconv.ParseInt(key, 10, 64)
+	return err == nil && n >= minVal && n <= maxVal
+}
@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestParseSeverity(t *testing.T) {
+	mapping := map[string]any{
+		"error": []any{"oops", map[string]any{"min": 500, "max": 599}},
+		"warn":  "4xx",
+		"info":  []any{"ok", 200},
+		"debug": 42,
+		"fatal": []any{"Panic"},
+	}
+	tests := []struct {
+		name    string
+		value   any
+		mapping map[string]any
+		preset  ottl.Optional[string]
+		want    plog.SeverityNumber
+	}{
+		{
+			name:  "mapped string",
+			value: "oops",
+			want:  plog.SeverityNumberError,
+		},
+		{
+			name:  "mapped string is case insensitive",
+			value: "PANIC",
+			want:  plog.SeverityNumberFatal,
+		},
+		{
+			name:  "mapped number",
+			value: int64(42),
+			want:  plog.SeverityNumberDebug,
+		},
+		{
+			name:  "mapped whole double",
+			value: float64(200),
+			want:  plog.SeverityNumberInfo,
+		},
+		{
+			name:  "mapped range",
+			value: int64(503),
+			want:  plog.SeverityNumberError,
+		},
+		{
+			name:  "mapped HTTP status range",
+			value: "404",
+			want:  plog.SeverityNumberWarn,
+		},
+		{
+			name:  "mapped value",
+			value: pcommon.NewValueStr("ok"),
+			want:  plog.SeverityNumberInfo,
+		},
+		{
+			name:  "mapping takes precedence over the preset",
+			value: int64(9),
+			mapping: map[string]any{
+				"error": 9,
+			},
+			want: plog.SeverityNumberError,
+		},
+		{
+			name:  "default preset",
+			value: "Warning",
+			want:  plog.SeverityNumberWarn,
+		},
+		{
+			name:  "default preset number",
+			value: int64(18),
+			want:  plog.SeverityNumberError2,
+		},
+		{
+			name:   "otel preset",
+			value:  "warning",
+			preset: ottl.NewTestingOptional("otel"),
+			want:   plog.SeverityNumberUnspecified,
+		},
+		{
+			name:   "otel preset name",
+			value:  "info3",
+			preset: ottl.NewTestingOptional("otel"),
+			want:   plog.SeverityNumberInfo3,
+		},
+		{
+			name:   "none preset",
+			value:  "info",
+			preset: ottl.NewTestingOptional("none"),
+			want:   plog.SeverityNumberUnspecified,
+		},
+		{
+			name:  "unknown value",
+			value: "verbose",
+			want:  plog.SeverityNumberUnspecified,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mapping
+			if tt.mapping != nil {
+				m = tt.mapping
+			}
+			exprFunc, err := parseSeverity[any](
+				&ottl.StandardGetSetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.value, nil
+					},
+				},
+				severityMappingGetter(t, m),
+				tt.preset,
+			)
+			require.NoError(t, err)
+			got, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Equal(t, int64(tt.want), got)
+		})
+	}
+}
+
+func TestParseSeverity_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		mapping map[string]any
+		wantErr string
+	}{
+		{
+			name:    "fractional number",
+			value:   1.5,
+			mapping: map[string]any{},
+			wantErr: "1.5 cannot be a severity unless it is a whole number",
+		},
+		{
+			name:    "nil value",
+			value:   nil,
+			mapping: map[string]any{},
+			wantErr: "type <nil> cannot be a severity",
+		},
+		{
+			name:    "unknown severity",
+			value:   "info",
+			mapping: map[string]any{"notice": "info"},
+			wantErr: `invalid severity "notice" in the mapping of ParseSeverity`,
+		},
+		{
+			name:    "invalid range",
+			value:   "info",
+			mapping: map[string]any{"info": map[string]any{"min": 1}},
+			wantErr: "a severity range must have integer min and max values",
+		},
+		{
+			name:    "invalid mapping value",
+			value:   "info",
+			mapping: map[string]any{"info": true},
+			wantErr: "Bool value cannot be parsed as a severity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := parseSeverity[any](
+				&ottl.StandardGetSetter[any]{
+					Getter: func(context.Context, any) (any, error) {
+						return tt.value, nil
+					},
+				},
+				severityMappingGetter(t, tt.mapping),
+				ottl.Optional[string]{},
+			)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParseSeverity_UnknownPreset(t *testing.T) {
+	_, err := parseSeverity[any](nil, nil, ottl.NewTestingOptional("syslog"))
+	assert.ErrorContains(t, err, `unknown preset "syslog" for ParseSeverity`)
+}
+
+func severityMappingGetter(t *testing.T, mapping map[string]any) ottl.PMapGetter[any] {
+	m := pcommon.NewMap()
+	require.NoError(t, m.FromRaw(mapping))
+	return &ottl.StandardPMapGetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return m, nil
+		},
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/jsonpath"
+)
+
+type QueryArguments[K any] struct {
+	Target     ottl.Getter[K]
+	Expression string
+}
+
+func NewQueryFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("Query", &QueryArguments[K]{}, createQueryFunction[K])
+}
+
+func createQueryFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*QueryArguments[K])
+
+	if !ok {
+		return nil, errors.New("QueryFactory args must be of type *QueryArguments[K]")
+	}
+
+	return query(args.Target, args.Expression)
+}
+
+// query returns the value selected by a definite JSONPath expression, or nil when it
+// selects nothing, and a list with a copy of every value selected by other expressions.
+func query[K any](target ottl.Getter[K], expression string) (ottl.ExprFunc[K], error) {
+	path, err := jsonpath.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		root, err := queryRoot(val)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes := path.Select(root)
+		if path.Definite() {
+			if len(nodes) == 0 {
+				return nil, nil
+			}
+			return nodes[0].Value(), nil
+		}
+		result := pcommon.NewSlice()
+		result.EnsureCapacity(len(nodes))
+		for _, n := range nodes {
+			n.CopyTo(result.AppendEmpty())
+		}
+		return result, nil
+	}, nil
+}
+
+// queryRoot returns the node a JSONPath expression is evaluated against.
+func queryRoot(val any) (jsonpath.Node, error) {
+	switch v := val.(type) {
+	case pcommon.Map:
+		return jsonpath.NewMapNode(v), nil
+	case pcommon.Slice:
+		return jsonpath.NewSliceNode(v), nil
+	case pcommon.Value:
+		if v.Type() == pcommon.ValueTypeMap || v.Type() == pcommon.ValueTypeSlice {
+			return jsonpath.NewValueNode(v), nil
+		}
+	case map[string]any:
+		m := pcommon.NewMap()
+		if err := m.FromRaw(v); err != nil {
+			return jsonpath.Node{}, err
+		}
+		return jsonpath.NewMapNode(m), nil
+	case []any:
+		s := pcommon.NewSlice()
+		if err := s.FromRaw(v); err != nil {
+			return jsonpath.Node{}, err
+		}
+		return jsonpath.NewSliceNode(s), nil
+	}
+	return jsonpath.Node{}, fmt.Errorf("expected a map or a list but got %T", val)
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func queryTestMap(t *testing.T) pcommon.Map {
+	// the keys are added one by one, as the recursive descent follows their order
+	m := pcommon.NewMap()
+	require.NoError(t, m.PutEmptyMap("user").FromRaw(map[string]any{"id": int64(42), "name": "alice"}))
+	require.NoError(t, m.PutEmptySlice("orders").FromRaw([]any{
+		map[string]any{"id": "a1", "total": 12.5, "status": "shipped"},
+		map[string]any{"id": "a2", "total": int64(80), "status": "pending"},
+		map[string]any{"id": "a3", "total": 150.0, "status": "shipped"},
+	}))
+	return m
+}
+
+func Test_query(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     func(t *testing.T) any
+		expression string
+		want       any
+	}{
+		{
+			name:       "definite path to a scalar",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.user.name",
+			want:       "alice",
+		},
+		{
+			name:       "definite path without root",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "orders[-1].total",
+			want:       150.0,
+		},
+		{
+			name:       "definite path to a map",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.user",
+			want:       map[string]any{"id": int64(42), "name": "alice"},
+		},
+		{
+			name:       "definite path selecting nothing",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.user.email",
+			want:       nil,
+		},
+		{
+			name:       "wildcard",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.orders[*].id",
+			want:       []any{"a1", "a2", "a3"},
+		},
+		{
+			name:       "filter",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.orders[?(@.status == 'shipped' && @.total > 100)].id",
+			want:       []any{"a3"},
+		},
+		{
+			name:       "filter selecting nothing",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.orders[?(@.total > 1000)].id",
+			want:       []any{},
+		},
+		{
+			name:       "recursive descent",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$..id",
+			want:       []any{int64(42), "a1", "a2", "a3"},
+		},
+		{
+			name:       "projection of maps",
+			target:     func(t *testing.T) any { return queryTestMap(t) },
+			expression: "$.orders[0:2]",
+			want: []any{
+				map[string]any{"id": "a1", "total": 12.5, "status": "shipped"},
+				map[string]any{"id": "a2", "total": int64(80), "status": "pending"},
+			},
+		},
+		{
+			name: "slice target",
+			target: func(t *testing.T) any {
+				s := pcommon.NewSlice()
+				require.NoError(t, s.FromRaw([]any{"a", "b", "c"}))
+				return s
+			},
+			expression: "$[1]",
+			want:       "b",
+		},
+		{
+			name: "value target",
+			target: func(t *testing.T) any {
+				v := pcommon.NewValueEmpty()
+				queryTestMap(t).CopyTo(v.SetEmptyMap())
+				return v
+			},
+			expression: "$.user.id",
+			want:       int64(42),
+		},
+		{
+			name: "raw map target",
+			target: func(*testing.T) any {
+				return map[string]any{"a": []any{int64(1), int64(2)}}
+			},
+			expression: "$.a[*]",
+			want:       []any{int64(1), int64(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target(t), nil
+				},
+			}
+			exprFunc, err := query[any](target, tt.expression)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+
+			switch r := result.(type) {
+			case pcommon.Map:
+				result = r.AsRaw()
+			case pcommon.Slice:
+				result = r.AsRaw()
+			}
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_query_copiesSelection(t *testing.T) {
+	m := queryTestMap(t)
+	target := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return m, nil
+		},
+	}
+	exprFunc, err := query[any](target, "$.orders[*]")
+	require.NoError(t, err)
+	result, err := exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+
+	result.(pcommon.Slice).At(0).Map().PutStr("status", "lost")
+	status, _ := m.Get("orders")
+	assert.Equal(t, "shipped", status.Slice().At(0).Map().AsRaw()["status"])
+}
+
+func Test_query_bad_input(t *testing.T) {
+	target := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "not a map", nil
+		},
+	}
+	exprFunc, err := query[any](target, "$.a")
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "expected a map or a list but got string")
+}
+
+func Test_query_bad_expression(t *testing.T) {
+	target := ottl.StandardGetSetter[any]{}
+	_, err := query[any](target, "$.a[")
+	assert.EqualError(t, err, `invalid path "$.a[": expected a selector at offset 4`)
+}
@@ -39,14 +39,15 @@ func createReplacePatternFunction[K any](_ ottl.FunctionContext, oArgs ottl.Argu
 	return replacePattern(args.Target, args.RegexPattern, args.Replacement, args.Function, args.ReplacementFormat)
 }
 
+// The patterns validating the replacement formats are compiled once, as the formats are only known at runtime.
+var (
+	validFormatRegex   = regexp.MustCompile(`^(.*?%s.*?)$`)
+	invalidFormatRegex = regexp.MustCompile(`%[^s]`)
+)
+
 func validFormatString(formatString string) bool {
 	// Check for exactly one %s and no other invalid format specifiers
-	validPattern := `^(.*?%s.*?)$`
-	validRegex := regexp.MustCompile(validPattern)
-	invalidPattern := `%[^s]`
-	invalidRegex := regexp.MustCompile(invalidPattern)
-
-	return validRegex.MatchString(formatString) && !invalidRegex.MatchString(formatString)
+	return validFormatRegex.MatchString(formatString) && !invalidFormatRegex.MatchString(formatString)
 }
 
 func applyReplaceFormat[K any](ctx context.Context, tCtx K, replacementFormat ottl.Optional[ottl.StringGetter[K]], replacementVal string) (string, error) {
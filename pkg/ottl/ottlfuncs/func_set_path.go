@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/jsonpath"
+)
+
+type SetPathArguments[K any] struct {
+	Target     ottl.Getter[K]
+	Expression string
+	Value      ottl.Getter[K]
+}
+
+func NewSetPathFactory[K any]() ottl.Factory[K] {
+	return ottl.NewFactory("set_path", &SetPathArguments[K]{}, createSetPathFunction[K])
+}
+
+func createSetPathFunction[K any](_ ottl.FunctionContext, oArgs ottl.Arguments) (ottl.ExprFunc[K], error) {
+	args, ok := oArgs.(*SetPathArguments[K])
+
+	if !ok {
+		return nil, errors.New("SetPathFactory args must be of type *SetPathArguments[K]")
+	}
+
+	return setPath(args.Target, args.Expression, args.Value)
+}
+
+// setPath sets the value into every value selected by a JSONPath expression in the target
+// map or list, creating the missing map entries selected by name.
+func setPath[K any](target ottl.Getter[K], expression string, value ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	path, err := jsonpath.Parse(expression)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		val, err := value.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		if val == nil {
+			return nil, nil
+		}
+		t, err := target.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		var root jsonpath.Node
+		switch v := t.(type) {
+		case pcommon.Map:
+			root = jsonpath.NewMapNode(v)
+		case pcommon.Slice:
+			root = jsonpath.NewSliceNode(v)
+		case pcommon.Value:
+			if v.Type() != pcommon.ValueTypeMap && v.Type() != pcommon.ValueTypeSlice {
+				return nil, fmt.Errorf("expected a map or a list but got %v", v.Type())
+			}
+			root = jsonpath.NewValueNode(v)
+		default:
+			return nil, fmt.Errorf("expected a map or a list path but got %T", t)
+		}
+
+		return nil, path.Set(root, func(dst pcommon.Value) error {
+			return setResultValue(dst, val)
+		})
+	}, nil
+}
@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_setPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		value      any
+		want       func(pcommon.Map)
+	}{
+		{
+			name:       "set existing value",
+			expression: "$.user.name",
+			value:      "bob",
+			want: func(m pcommon.Map) {
+				user, _ := m.Get("user")
+				user.Map().PutStr("name", "bob")
+			},
+		},
+		{
+			name:       "create missing maps",
+			expression: "$.meta['http.route'].template",
+			value:      "/users/{id}",
+			want: func(m pcommon.Map) {
+				m.PutEmptyMap("meta").PutEmptyMap("http.route").PutStr("template", "/users/{id}")
+			},
+		},
+		{
+			name:       "set every selected value",
+			expression: "$.orders[?(@.status == 'pending')].status",
+			value:      "cancelled",
+			want: func(m pcommon.Map) {
+				orders, _ := m.Get("orders")
+				orders.Slice().At(1).Map().PutStr("status", "cancelled")
+			},
+		},
+		{
+			name:       "set a map",
+			expression: "$.orders[0].shipping",
+			value:      map[string]any{"carrier": "ups"},
+			want: func(m pcommon.Map) {
+				orders, _ := m.Get("orders")
+				orders.Slice().At(0).Map().PutEmptyMap("shipping").PutStr("carrier", "ups")
+			},
+		},
+		{
+			name:       "set a list",
+			expression: "$.tags",
+			value:      []string{"a", "b"},
+			want: func(m pcommon.Map) {
+				tags := m.PutEmptySlice("tags")
+				tags.AppendEmpty().SetStr("a")
+				tags.AppendEmpty().SetStr("b")
+			},
+		},
+		{
+			name:       "index out of range",
+			expression: "$.orders[5].status",
+			value:      "lost",
+			want:       func(pcommon.Map) {},
+		},
+		{
+			name:       "nil value",
+			expression: "$.user.name",
+			value:      nil,
+			want:       func(pcommon.Map) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := queryTestMap(t)
+			target := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return m, nil
+				},
+			}
+			value := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := setPath[any](target, tt.expression, value)
+			require.NoError(t, err)
+			result, err := exprFunc(context.Background(), nil)
+			require.NoError(t, err)
+			assert.Nil(t, result)
+
+			expected := queryTestMap(t)
+			tt.want(expected)
+			assert.Equal(t, expected.AsRaw(), m.AsRaw())
+		})
+	}
+}
+
+func Test_setPath_slice(t *testing.T) {
+	s := pcommon.NewSlice()
+	require.NoError(t, s.FromRaw([]any{map[string]any{}, map[string]any{}}))
+	target := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return s, nil
+		},
+	}
+	value := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return int64(1), nil
+		},
+	}
+	exprFunc, err := setPath[any](target, "$[*].seen", value)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []any{map[string]any{"seen": int64(1)}, map[string]any{"seen": int64(1)}}, s.AsRaw())
+}
+
+func Test_setPath_bad_input(t *testing.T) {
+	tests := []struct {
+		name   string
+		target any
+		err    string
+	}{
+		{
+			name:   "string",
+			target: "not a map",
+			err:    "expected a map or a list path but got string",
+		},
+		{
+			name:   "string value",
+			target: pcommon.NewValueStr("not a map"),
+			err:    "expected a map or a list but got Str",
+		},
+		{
+			name:   "raw map",
+			target: map[string]any{},
+			err:    "expected a map or a list path but got map[string]interface {}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.target, nil
+				},
+			}
+			value := ottl.StandardGetSetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return "value", nil
+				},
+			}
+			exprFunc, err := setPath[any](target, "$.a", value)
+			require.NoError(t, err)
+			_, err = exprFunc(context.Background(), nil)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func Test_setPath_root(t *testing.T) {
+	target := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return pcommon.NewMap(), nil
+		},
+	}
+	value := ottl.StandardGetSetter[any]{
+		Getter: func(context.Context, any) (any, error) {
+			return "value", nil
+		},
+	}
+	exprFunc, err := setPath[any](target, "$", value)
+	require.NoError(t, err)
+	_, err = exprFunc(context.Background(), nil)
+	assert.EqualError(t, err, "the path must select values inside the target")
+}
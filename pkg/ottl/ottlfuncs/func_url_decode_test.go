@@ -0,0 +1,60 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TestURLDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "plain",
+			value: "hello",
+			want:  "hello",
+		},
+		{
+			name:  "escaped",
+			value: "a+b%26c%3Dd%2F%C3%A9",
+			want:  "a b&c=d/é",
+		},
+		{
+			name:  "escaped space",
+			value: "a%20b",
+			want:  "a b",
+		},
+		{
+			name:    "invalid escape",
+			value:   "100%",
+			wantErr: "could not decode",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardStringGetter[any]{
+				Getter: func(context.Context, any) (any, error) {
+					return tt.value, nil
+				},
+			}
+			got, err := urlDecode(target)(context.Background(), nil)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
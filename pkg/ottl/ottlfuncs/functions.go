@@ -23,6 +23,7 @@ func StandardFuncs[K any]() map[string]ottl.Factory[K] {
 		NewReplaceMatchFactory[K](),
 		NewReplacePatternFactory[K](),
 		NewSetFactory[K](),
+		NewSetPathFactory[K](),
 		NewTruncateAllFactory[K](),
 	}
 	f = append(f, converters[K]()...)
@@ -38,9 +39,14 @@ func StandardConverters[K any]() map[string]ottl.Factory[K] {
 func converters[K any]() []ottl.Factory[K] {
 	return []ottl.Factory[K]{
 		// Converters
+		NewAllFactory[K](),
+		NewAnyFactory[K](),
 		NewBase64DecodeFactory[K](),
 		NewDecodeFactory[K](),
+		NewDecompressFactory[K](),
+		NewEncodeFactory[K](),
 		NewConcatFactory[K](),
+		NewCommunityIDFactory[K](),
 		NewContainsValueFactory[K](),
 		NewConvertCaseFactory[K](),
 		NewConvertAttributesToElementsXMLFactory[K](),
@@ -49,8 +55,10 @@ func converters[K any]() []ottl.Factory[K] {
 		NewDoubleFactory[K](),
 		NewDurationFactory[K](),
 		NewExtractPatternsFactory[K](),
+		NewFilterFactory[K](),
 		NewExtractGrokPatternsFactory[K](),
 		NewFnvFactory[K](),
+		NewForEachFactory[K](),
 		NewGetXMLFactory[K](),
 		NewHasPrefixFactory[K](),
 		NewHasSuffixFactory[K](),
@@ -58,6 +66,8 @@ func converters[K any]() []ottl.Factory[K] {
 		NewHoursFactory[K](),
 		NewInsertXMLFactory[K](),
 		NewIntFactory[K](),
+		NewIPToIntFactory[K](),
+		NewIsInCIDRFactory[K](),
 		NewIsBoolFactory[K](),
 		NewIsDoubleFactory[K](),
 		NewIsListFactory[K](),
@@ -67,6 +77,7 @@ func converters[K any]() []ottl.Factory[K] {
 		NewIsStringFactory[K](),
 		NewLenFactory[K](),
 		NewLogFactory[K](),
+		NewMapFactory[K](),
 		NewIsValidLuhnFactory[K](),
 		NewMD5Factory[K](),
 		NewMicrosecondsFactory[K](),
@@ -80,10 +91,13 @@ func converters[K any]() []ottl.Factory[K] {
 		NewNanosecondsFactory[K](),
 		NewNowFactory[K](),
 		NewParseCSVFactory[K](),
+		NewParseIPFactory[K](),
+		NewParseSeverityFactory[K](),
 		NewParseJSONFactory[K](),
 		NewParseKeyValueFactory[K](),
 		NewParseSimplifiedXMLFactory[K](),
 		NewParseXMLFactory[K](),
+		NewQueryFactory[K](),
 		NewRemoveXMLFactory[K](),
 		NewSecondFactory[K](),
 		NewSecondsFactory[K](),
@@ -113,6 +127,7 @@ func converters[K any]() []ottl.Factory[K] {
 		NewUnixSecondsFactory[K](),
 		NewUUIDFactory[K](),
 		NewURLFactory[K](),
+		NewURLDecodeFactory[K](),
 		NewWeekdayFactory[K](),
 		NewUserAgentFactory[K](),
 		NewAppendFactory[K](),
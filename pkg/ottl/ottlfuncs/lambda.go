@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlcommon"
+)
+
+// maxLambdaCalls bounds the number of times a higher-order converter calls its lambda,
+// including the calls made by the higher-order converters nested in the lambda.
+const maxLambdaCalls = 10000
+
+type lambdaBudgetKey struct{}
+
+// lambdaCaller calls a lambda within the budget of the outermost higher-order converter.
+type lambdaCaller[K any] struct {
+	lambda    ottl.LambdaGetter[K]
+	ctx       context.Context
+	tCtx      K
+	remaining *int
+}
+
+func newLambdaCaller[K any](ctx context.Context, tCtx K, lambda ottl.LambdaGetter[K]) lambdaCaller[K] {
+	remaining, ok := ctx.Value(lambdaBudgetKey{}).(*int)
+	if !ok {
+		remaining = new(int)
+		*remaining = maxLambdaCalls
+		ctx = context.WithValue(ctx, lambdaBudgetKey{}, remaining)
+	}
+	return lambdaCaller[K]{lambda: lambda, ctx: ctx, tCtx: tCtx, remaining: remaining}
+}
+
+func (c lambdaCaller[K]) call(args ...any) (any, error) {
+	if *c.remaining <= 0 {
+		return nil, fmt.Errorf("lambda expressions can't be called more than %d times per call of the outermost higher-order converter", maxLambdaCalls)
+	}
+	*c.remaining--
+	return c.lambda.Call(c.ctx, c.tCtx, args...)
+}
+
+// callElement calls the lambda with the value of a slice element.
+func (c lambdaCaller[K]) callElement(v pcommon.Value) (any, error) {
+	return c.call(ottlcommon.GetValue(v))
+}
+
+// callEntry calls the lambda with the key and value of a map entry.
+func (c lambdaCaller[K]) callEntry(k string, v pcommon.Value) (any, error) {
+	return c.call(k, ottlcommon.GetValue(v))
+}
+
+// callPredicate calls the lambda and checks that it returns a bool.
+func (c lambdaCaller[K]) callPredicate(call func() (any, error)) (bool, error) {
+	result, err := call()
+	if err != nil {
+		return false, err
+	}
+	b, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("lambda must return a bool but got %T", result)
+	}
+	return b, nil
+}
+
+func checkArity[K any](name string, lambda ottl.LambdaGetter[K], arities ...int) error {
+	for _, arity := range arities {
+		if lambda.Arity() == arity {
+			return nil
+		}
+	}
+	if len(arities) == 1 {
+		return fmt.Errorf("%s expects a lambda with %d parameters but got %d", name, arities[0], lambda.Arity())
+	}
+	return fmt.Errorf("%s expects a lambda with 1 parameter for lists or 2 parameters for maps but got %d", name, lambda.Arity())
+}
+
+// setResultValue sets the value returned by a lambda or a Getter into dst.
+func setResultValue(dst pcommon.Value, result any) error {
+	switch v := result.(type) {
+	case pcommon.Value:
+		v.CopyTo(dst)
+	case pcommon.Map:
+		v.CopyTo(dst.SetEmptyMap())
+	case pcommon.Slice:
+		v.CopyTo(dst.SetEmptySlice())
+	case []string:
+		setSliceResult(dst, v, pcommon.Value.SetStr)
+	case []int64:
+		setSliceResult(dst, v, pcommon.Value.SetInt)
+	case []float64:
+		setSliceResult(dst, v, pcommon.Value.SetDouble)
+	case []bool:
+		setSliceResult(dst, v, pcommon.Value.SetBool)
+	default:
+		return dst.FromRaw(result)
+	}
+	return nil
+}
+
+func setSliceResult[T any](dst pcommon.Value, values []T, set func(pcommon.Value, T)) {
+	s := dst.SetEmptySlice()
+	s.EnsureCapacity(len(values))
+	for _, v := range values {
+		set(s.AppendEmpty(), v)
+	}
+}
+
+// quantify returns whether the lambda returns true for any (or all) of the elements of
+// a list, or of the entries of a map when the lambda has 2 parameters. It stops at the
+// first element deciding the result.
+func quantify[K any](name string, target ottl.Getter[K], fn ottl.LambdaGetter[K], all bool) (ottl.ExprFunc[K], error) {
+	if err := checkArity(name, fn, 1, 2); err != nil {
+		return nil, err
+	}
+	if fn.Arity() == 2 {
+		mapTarget := ottl.StandardPMapGetter[K]{Getter: target.Get}
+		return func(ctx context.Context, tCtx K) (any, error) {
+			m, err := mapTarget.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			caller := newLambdaCaller(ctx, tCtx, fn)
+			for k, v := range m.All() {
+				matched, err := caller.callPredicate(func() (any, error) { return caller.callEntry(k, v) })
+				if err != nil {
+					return nil, err
+				}
+				if matched != all {
+					return !all, nil
+				}
+			}
+			return all, nil
+		}, nil
+	}
+
+	sliceTarget := ottl.StandardPSliceGetter[K]{Getter: target.Get}
+	return func(ctx context.Context, tCtx K) (any, error) {
+		slice, err := sliceTarget.Get(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		caller := newLambdaCaller(ctx, tCtx, fn)
+		for i := 0; i < slice.Len(); i++ {
+			matched, err := caller.callPredicate(func() (any, error) { return caller.callElement(slice.At(i)) })
+			if err != nil {
+				return nil, err
+			}
+			if matched != all {
+				return !all, nil
+			}
+		}
+		return all, nil
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// prefixTrie is a binary trie of IP prefixes, with one root per address family, answering
+// whether an address is contained in any of the prefixes in as many steps as the length
+// of the longest prefix.
+type prefixTrie struct {
+	v4, v6 trieNode
+}
+
+type trieNode struct {
+	children [2]*trieNode
+	// terminal is set when the path to the node is one of the prefixes.
+	terminal bool
+}
+
+// newPrefixTrie returns a trie of the given CIDR blocks. A block without a prefix length
+// contains a single address.
+func newPrefixTrie(cidrs []string) (*prefixTrie, error) {
+	t := &prefixTrie{}
+	for _, cidr := range cidrs {
+		prefix, err := parsePrefix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		t.insert(prefix)
+		if prefix.Addr().Is6() && prefix.Bits() <= ipv4MappedPrefix.Bits() && prefix.Contains(ipv4MappedPrefix.Addr()) {
+			// The IPv6 block contains all the IPv4-mapped addresses, which are looked up as IPv4 addresses.
+			t.insert(netip.PrefixFrom(netip.IPv4Unspecified(), 0))
+		}
+	}
+	return t, nil
+}
+
+// ipv4MappedPrefix is the block of the IPv4-mapped IPv6 addresses, ::ffff:0:0/96.
+var ipv4MappedPrefix = netip.MustParsePrefix("::ffff:0.0.0.0/96")
+
+func parsePrefix(cidr string) (netip.Prefix, error) {
+	if !strings.Contains(cidr, "/") {
+		addr, err := netip.ParseAddr(cidr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: %w", cidr, err)
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR block %q: %w", cidr, err)
+	}
+	if prefix.Addr().Is4In6() && prefix.Bits() >= ipv4MappedPrefix.Bits() {
+		// The blocks of IPv4-mapped addresses are IPv4 blocks, as the addresses are looked up as IPv4 addresses.
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-ipv4MappedPrefix.Bits())
+	}
+	return prefix.Masked(), nil
+}
+
+func (t *prefixTrie) root(addr netip.Addr) *trieNode {
+	if addr.Is4() {
+		return &t.v4
+	}
+	return &t.v6
+}
+
+func (t *prefixTrie) insert(prefix netip.Prefix) {
+	addr := prefix.Addr()
+	bytes := addr.AsSlice()
+	node := t.root(addr)
+	for i := 0; i < prefix.Bits(); i++ {
+		if node.terminal {
+			// A shorter prefix already contains this one.
+			return
+		}
+		bit := bytes[i/8] >> (7 - i%8) & 1
+		if node.children[bit] == nil {
+			node.children[bit] = &trieNode{}
+		}
+		node = node.children[bit]
+	}
+	node.terminal = true
+	node.children = [2]*trieNode{}
+}
+
+// contains returns whether addr is contained in any of the prefixes of the trie.
+// IPv4-mapped IPv6 addresses are looked up as IPv4 addresses.
+func (t *prefixTrie) contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	bytes := addr.AsSlice()
+	node := t.root(addr)
+	for i := 0; i < addr.BitLen(); i++ {
+		if node.terminal {
+			return true
+		}
+		node = node.children[bytes[i/8]>>(7-i%8)&1]
+		if node == nil {
+			return false
+		}
+	}
+	return node.terminal
+}
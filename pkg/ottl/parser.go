@@ -7,9 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/alecthomas/participle/v2"
 	"go.opentelemetry.io/collector/component"
@@ -24,6 +27,13 @@ type Statement[K any] struct {
 	condition         BoolExpr[K]
 	origText          string
 	telemetrySettings component.TelemetrySettings
+	// variables is the scope of the variables of the statement's group, if any.
+	variables *variableScope
+	// memo holds the converter calls deduplicated across the statement's group, if any.
+	memo *expressionMemo
+	// readOnly is true for the statements that don't modify the telemetry, such as let statements.
+	readOnly bool
+	cost     int
 }
 
 // Execute is a function that will execute the statement's function if the statement's condition is met.
@@ -50,6 +60,13 @@ func (s *Statement[K]) Execute(ctx context.Context, tCtx K) (any, bool, error) {
 	return result, condition, nil
 }
 
+// Cost returns an estimate of the relative cost of executing the statement, computed at parse time
+// from the paths, functions and deduplicated expressions it contains. It can be used to compare
+// statements, but isn't related to a unit of time.
+func (s *Statement[K]) Cost() int {
+	return s.cost
+}
+
 // Condition holds a top level Condition. A Condition is a boolean expression to match telemetry.
 type Condition[K any] struct {
 	condition BoolExpr[K]
@@ -69,6 +86,15 @@ type Parser[K any] struct {
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
 	pathContextNames  map[string]struct{}
+	// lambdaScope holds the parameters of the lambdas enclosing the expression being parsed.
+	lambdaScope *lambdaScope
+	// variables holds the variables declared by the statements of the group being parsed.
+	variables *variableScope
+	// deduplicateExpressions enables the deduplication of the converter calls repeated across the
+	// statements of a group.
+	deduplicateExpressions bool
+	// memo holds the converter calls deduplicated across the statements of the group being parsed.
+	memo *expressionMemo
 }
 
 // NewParser creates a new Parser
@@ -121,15 +147,35 @@ func WithPathContextNames[K any](contexts []string) Option[K] {
 	}
 }
 
+// WithExpressionDeduplication enables the deduplication of the converter calls appearing several
+// times in the conditions and let statements of the statements parsed by ParseStatements. A
+// deduplicated call is evaluated once per TransformContext and its result is reused by the
+// following statements, until a statement executes an editor. Converters without arguments, such
+// as Now() or UUID(), and the calls made by lambdas are never deduplicated.
+// The statements must be executed by a StatementSequence for their results to be reused.
+func WithExpressionDeduplication[K any]() Option[K] {
+	return func(p *Parser[K]) {
+		p.deduplicateExpressions = true
+	}
+}
+
 // ParseStatements parses string statements into ottl.Statement objects ready for execution.
+// The variables declared by let statements can be used by the following statements of the slice,
+// which must be executed by the same StatementSequence.
 // Returns a slice of statements and a nil error on successful parsing.
 // If parsing fails, returns nil and a joined error containing each error per failed statement.
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
 	parsedStatements := make([]*Statement[K], 0, len(statements))
 	var parseErrs []error
 
+	// The statements are parsed by a copy of the parser, so that their variables are only visible within the group.
+	groupParser := *p
+	groupParser.variables = &variableScope{}
+	if p.deduplicateExpressions {
+		groupParser.memo = p.newExpressionMemo(statements)
+	}
 	for _, statement := range statements {
-		ps, err := p.ParseStatement(statement)
+		ps, err := groupParser.ParseStatement(statement)
 		if err != nil {
 			parseErrs = append(parseErrs, fmt.Errorf("unable to parse OTTL statement %q: %w", statement, err))
 			continue
@@ -152,7 +198,24 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 	if err != nil {
 		return nil, err
 	}
-	function, err := p.newFunctionCall(parsed.Editor)
+	p.foldStatement(parsed)
+	cost := p.estimateCost(parsed)
+	if p.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
+		p.telemetrySettings.Logger.Debug("parsed OTTL statement", zap.String("statement", statement), zap.Int("estimated_cost", cost))
+	}
+	if parsed.Let != nil {
+		letStatement, err := p.newLetStatement(parsed, statement)
+		if err != nil {
+			return nil, err
+		}
+		letStatement.cost = cost
+		return letStatement, nil
+	}
+	// The editor's arguments aren't deduplicated, as they're evaluated after the condition and
+	// might be modified by the editor.
+	editorParser := *p
+	editorParser.memo = nil
+	function, err := editorParser.newFunctionCall(parsed.Editor)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +228,8 @@ func (p *Parser[K]) ParseStatement(statement string) (*Statement[K], error) {
 		condition:         expression,
 		origText:          statement,
 		telemetrySettings: p.telemetrySettings,
+		memo:              p.memo,
+		cost:              cost,
 	}, nil
 }
 
@@ -199,6 +264,7 @@ func (p *Parser[K]) ParseCondition(condition string) (*Condition[K], error) {
 	if err != nil {
 		return nil, err
 	}
+	p.foldCondition(parsed)
 	expression, err := p.newBoolExpr(parsed)
 	if err != nil {
 		return nil, err
@@ -245,6 +311,32 @@ func (p *Parser[K]) prependContextToStatementPaths(context string, statement str
 	})
 }
 
+// prependContextToStatementsPaths changes the given group of OTTL statements adding the context
+// name prefix to all context-less paths, like prependContextToStatementPaths, except for the paths
+// referring to the variables declared by the previous statements of the group.
+func (p *Parser[K]) prependContextToStatementsPaths(context string, statements []string) ([]string, error) {
+	var variables []string
+	prependedStatements := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		prependedStatement, err := p.prependContextToPaths(context, statement, func(ottl string) ([]path, error) {
+			parsed, err := parseStatement(ottl)
+			if err != nil {
+				return nil, err
+			}
+			paths := getParsedStatementPaths(parsed, variables...)
+			if parsed.Let != nil {
+				variables = append(variables, parsed.Let.Name)
+			}
+			return paths, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		prependedStatements = append(prependedStatements, prependedStatement)
+	}
+	return prependedStatements, nil
+}
+
 // prependContextToConditionPaths changes the given OTTL condition adding the context name prefix
 // to all context-less paths. No modifications are performed for paths which [Path.Context]
 // value matches any WithPathContextNames value.
@@ -364,6 +456,30 @@ type StatementSequence[K any] struct {
 	statements        []*Statement[K]
 	errorMode         ErrorMode
 	telemetrySettings component.TelemetrySettings
+	// variables holds the scopes of the variables declared by the statements.
+	variables []*variableScope
+	// memos holds the converter calls deduplicated across the statements.
+	memos []*expressionMemo
+	// executionHook is called after each execution of a statement, if set.
+	executionHook func(context.Context, StatementExecution)
+	// diffSamplingInterval is the number of executions of the sequence between two executions
+	// whose changes are logged, 0 disabling the logging.
+	diffSamplingInterval uint64
+	executions           *atomic.Uint64
+}
+
+// StatementExecution describes an execution of a Statement by a StatementSequence.
+type StatementExecution struct {
+	// Index is the index of the statement in the StatementSequence.
+	Index int
+	// Statement is the text of the statement.
+	Statement string
+	// ConditionMatched is true if the statement's condition was met and its function was executed.
+	ConditionMatched bool
+	// Err is the error returned by the statement, if any.
+	Err error
+	// Duration is the time spent executing the statement, including its condition.
+	Duration time.Duration
 }
 
 // StatementSequenceOption is an option for a StatementSequence
@@ -376,6 +492,24 @@ func WithStatementSequenceErrorMode[K any](errorMode ErrorMode) StatementSequenc
 	}
 }
 
+// WithStatementSequenceExecutionHook sets a function called after each execution of a statement of the
+// StatementSequence, e.g. to record statement level telemetry. The hook is called synchronously, so it must be cheap.
+func WithStatementSequenceExecutionHook[K any](hook func(context.Context, StatementExecution)) StatementSequenceOption[K] {
+	return func(s *StatementSequence[K]) {
+		s.executionHook = hook
+	}
+}
+
+// WithStatementSequenceDiffLogging logs, at the debug level, the changes made by each executed statement to
+// one out of every samplingInterval TransformContexts executed by the StatementSequence, starting with the first one.
+// The changes are computed from the fields logged for the TransformContext, so it must implement
+// zapcore.ObjectMarshaler. A samplingInterval of 0 disables the logging.
+func WithStatementSequenceDiffLogging[K any](samplingInterval uint64) StatementSequenceOption[K] {
+	return func(s *StatementSequence[K]) {
+		s.diffSamplingInterval = samplingInterval
+	}
+}
+
 // NewStatementSequence creates a new StatementSequence with the provided Statement slice and component.TelemetrySettings.
 // The default ErrorMode is `Propagate`.
 // You may also augment the StatementSequence with a slice of StatementSequenceOption.
@@ -388,6 +522,17 @@ func NewStatementSequence[K any](statements []*Statement[K], telemetrySettings c
 	for _, op := range options {
 		op(&s)
 	}
+	if s.diffSamplingInterval > 0 {
+		s.executions = &atomic.Uint64{}
+	}
+	for _, statement := range statements {
+		if statement.variables != nil && !slices.Contains(s.variables, statement.variables) {
+			s.variables = append(s.variables, statement.variables)
+		}
+		if statement.memo != nil && !slices.Contains(s.memos, statement.memo) {
+			s.memos = append(s.memos, statement.memo)
+		}
+	}
 	return s
 }
 
@@ -399,8 +544,54 @@ func (s *StatementSequence[K]) Execute(ctx context.Context, tCtx K) error {
 	if s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) {
 		s.telemetrySettings.Logger.Debug("initial TransformContext before executing StatementSequence", zap.Any("TransformContext", tCtx))
 	}
-	for _, statement := range s.statements {
-		_, _, err := statement.Execute(ctx, tCtx)
+	// The variables are set by their let statements for each TransformContext.
+	for _, scope := range s.variables {
+		if len(scope.names) > 0 {
+			ctx = context.WithValue(ctx, scope, make([]any, len(scope.names)))
+		}
+	}
+	// The results of the deduplicated converter calls are reused until an editor is executed.
+	memoValues := make([]*memoValues, len(s.memos))
+	for i, memo := range s.memos {
+		memoValues[i] = newMemoValues(memo)
+		ctx = context.WithValue(ctx, memo, memoValues[i])
+	}
+	var before map[string]any
+	// The snapshots are only taken when the changes can be logged.
+	logDiffs := s.executions != nil && s.telemetrySettings.Logger.Core().Enabled(zap.DebugLevel) &&
+		(s.executions.Add(1)-1)%s.diffSamplingInterval == 0
+	if logDiffs {
+		before = snapshotTransformContext(tCtx)
+	}
+	for i, statement := range s.statements {
+		var start time.Time
+		if s.executionHook != nil {
+			start = time.Now()
+		}
+		_, executed, err := statement.Execute(ctx, tCtx)
+		if s.executionHook != nil {
+			s.executionHook(ctx, StatementExecution{
+				Index:            i,
+				Statement:        statement.origText,
+				ConditionMatched: executed,
+				Err:              err,
+				Duration:         time.Since(start),
+			})
+		}
+		if logDiffs && (executed || err != nil) {
+			after := snapshotTransformContext(tCtx)
+			s.telemetrySettings.Logger.Debug("statement changes",
+				zap.String("statement", statement.origText),
+				zap.Bool("condition matched", executed),
+				zap.Array("changes", diffSnapshots(before, after)),
+				zap.Error(err))
+			before = after
+		}
+		if executed && !statement.readOnly {
+			for _, values := range memoValues {
+				values.generation++
+			}
+		}
 		if err != nil {
 			if s.errorMode == PropagateError {
 				err = fmt.Errorf("failed to execute statement: %v, %w", statement.origText, err)
@@ -546,6 +737,7 @@ func (p *Parser[K]) ParseValueExpression(raw string) (*ValueExpression[K], error
 	if err != nil {
 		return nil, err
 	}
+	p.foldValue(parsed)
 	getter, err := p.newGetter(*parsed)
 	if err != nil {
 		return nil, err
@@ -276,15 +276,7 @@ func createStatementsParserWithConverter[K any, R any](converter ParsedStatement
 		var parsingStatements []string
 		if prependPathsContext {
 			originalStatements := statements.GetStatements()
-			parsingStatements = make([]string, 0, len(originalStatements))
-			for _, cond := range originalStatements {
-				prependedStatement, prependErr := parser.prependContextToStatementPaths(context, cond)
-				if prependErr != nil {
-					err = prependErr
-					break
-				}
-				parsingStatements = append(parsingStatements, prependedStatement)
-			}
+			parsingStatements, err = parser.prependContextToStatementsPaths(context, originalStatements)
 			if err != nil {
 				return *new(R), err
 			}
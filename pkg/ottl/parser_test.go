@@ -17,6 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -2709,6 +2712,128 @@ func Test_Statements_Execute_Error(t *testing.T) {
 	}
 }
 
+func Test_StatementSequence_ExecutionHook(t *testing.T) {
+	statements := []*Statement[any]{
+		{
+			condition:         BoolExpr[any]{alwaysTrue[any]},
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, nil }},
+			origText:          "first",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+		{
+			condition:         BoolExpr[any]{alwaysFalse[any]},
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, nil }},
+			origText:          "second",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+		{
+			condition:         BoolExpr[any]{alwaysTrue[any]},
+			function:          Expr[any]{exprFunc: func(context.Context, any) (any, error) { return nil, errors.New("test") }},
+			origText:          "third",
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		},
+	}
+
+	var executions []StatementExecution
+	sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings(),
+		WithStatementSequenceErrorMode[any](IgnoreError),
+		WithStatementSequenceExecutionHook[any](func(_ context.Context, execution StatementExecution) {
+			assert.GreaterOrEqual(t, execution.Duration, time.Duration(0))
+			execution.Duration = 0
+			executions = append(executions, execution)
+		}),
+	)
+	require.NoError(t, sequence.Execute(context.Background(), nil))
+	assert.Equal(t, []StatementExecution{
+		{Index: 0, Statement: "first", ConditionMatched: true},
+		{Index: 1, Statement: "second", ConditionMatched: false},
+		{Index: 2, Statement: "third", ConditionMatched: true, Err: errors.New("test")},
+	}, executions)
+}
+
+// loggableMap is a TransformContext logged as its fields.
+type loggableMap map[string]any
+
+func (m loggableMap) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddReflected(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func Test_StatementSequence_DiffLogging(t *testing.T) {
+	set := func(key string, value any) ExprFunc[loggableMap] {
+		return func(_ context.Context, tCtx loggableMap) (any, error) {
+			tCtx[key] = value
+			return nil, nil
+		}
+	}
+	newStatement := func(text string, condition boolExpressionEvaluator[loggableMap], function ExprFunc[loggableMap]) *Statement[loggableMap] {
+		return &Statement[loggableMap]{
+			condition:         BoolExpr[loggableMap]{condition},
+			function:          Expr[loggableMap]{exprFunc: function},
+			origText:          text,
+			telemetrySettings: componenttest.NewNopTelemetrySettings(),
+		}
+	}
+	statements := []*Statement[loggableMap]{
+		newStatement(`set(name, "new")`, alwaysTrue[loggableMap], set("name", "new")),
+		newStatement(`set(skipped, true) where false`, alwaysFalse[loggableMap], set("skipped", true)),
+		newStatement(`set(nested, {"a": 1})`, alwaysTrue[loggableMap], set("nested", map[string]any{"a": 1})),
+	}
+
+	core, logs := observer.New(zap.DebugLevel)
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.Logger = zap.New(core)
+	sequence := NewStatementSequence(statements, settings, WithStatementSequenceDiffLogging[loggableMap](2))
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, sequence.Execute(context.Background(), loggableMap{"name": "old", "id": i}))
+	}
+
+	entries := logs.FilterMessage("statement changes").AllUntimed()
+	require.Len(t, entries, 4)
+	assert.Equal(t, `set(name, "new")`, entries[0].ContextMap()["statement"])
+	assert.Equal(t, []any{map[string]any{"path": "name", "before": "old", "after": "new"}}, entries[0].ContextMap()["changes"])
+	assert.Equal(t, `set(nested, {"a": 1})`, entries[1].ContextMap()["statement"])
+	assert.Equal(t, []any{map[string]any{"path": "nested", "before": nil, "after": map[string]any{"a": 1}}}, entries[1].ContextMap()["changes"])
+	// The second TransformContext isn't sampled.
+	assert.Equal(t, `set(name, "new")`, entries[2].ContextMap()["statement"])
+
+	// Nothing is logged above the debug level.
+	core, logs = observer.New(zap.InfoLevel)
+	settings.Logger = zap.New(core)
+	sequence = NewStatementSequence(statements, settings, WithStatementSequenceDiffLogging[loggableMap](1))
+	require.NoError(t, sequence.Execute(context.Background(), loggableMap{"name": "old"}))
+	assert.Zero(t, logs.Len())
+}
+
+func Test_diffSnapshots(t *testing.T) {
+	before := map[string]any{
+		"log": map[string]any{
+			"body":       "a",
+			"attributes": map[string]any{"kept": "x", "removed": "y"},
+			"list":       []any{"1"},
+		},
+	}
+	after := map[string]any{
+		"log": map[string]any{
+			"body":       "b",
+			"attributes": map[string]any{"kept": "x", "added": "z"},
+			"list":       []any{"1", "2"},
+		},
+	}
+	assert.Equal(t, fieldChanges{
+		{path: "log.attributes.added", after: "z"},
+		{path: "log.attributes.removed", before: "y"},
+		{path: "log.body", before: "a", after: "b"},
+		{path: "log.list", before: []any{"1"}, after: []any{"1", "2"}},
+	}, diffSnapshots(before, after))
+	assert.Empty(t, diffSnapshots(before, before))
+}
+
 func Test_ConditionSequence_Eval(t *testing.T) {
 	tests := []struct {
 		name           string
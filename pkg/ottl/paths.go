@@ -17,12 +17,11 @@ func (v *grammarPathVisitor) visitPath(value *path) {
 	v.paths = append(v.paths, *value)
 }
 
-func getParsedStatementPaths(ps *parsedStatement) []path {
+// getParsedStatementPaths returns the paths of the statement, except for the ones referring to
+// the given variables, which were declared by the previous statements of its group.
+func getParsedStatementPaths(ps *parsedStatement, variables ...string) []path {
 	visitor := &grammarPathVisitor{}
-	ps.Editor.accept(visitor)
-	if ps.WhereClause != nil {
-		ps.WhereClause.accept(visitor)
-	}
+	ps.accept(&scopeVisitor{grammarVisitor: visitor, names: variables})
 	return visitor.paths
 }
 
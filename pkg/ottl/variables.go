@@ -0,0 +1,223 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// variableScope holds the variables declared by the let statements of a group of statements.
+// At runtime, it is the context key of the values of the variables.
+type variableScope struct {
+	names []string
+	// types holds the type of each variable when it's known at parse time, or an empty string.
+	types []string
+}
+
+func (s *variableScope) lookup(name string) int {
+	return slices.Index(s.names, name)
+}
+
+// strictGetterTypes maps the typed getters to the types of variables they accept, for the getters
+// that don't convert their values. Passing a variable of another known type is a parse error.
+var strictGetterTypes = map[string][]string{
+	"StringGetter": {"string"},
+	"IntGetter":    {"int"},
+	"FloatGetter":  {"float"},
+	"BoolGetter":   {"bool"},
+	"PMapGetter":   {"map"},
+	"PSliceGetter": {"list"},
+}
+
+// newLetStatement creates the Statement declaring the variable of a let statement. The variable
+// is set when the statement is executed, and is nil if its condition isn't met.
+func (p *Parser[K]) newLetStatement(parsed *parsedStatement, origText string) (*Statement[K], error) {
+	let := parsed.Let
+	// The condition is parsed first, as it can't refer to the variable it guards.
+	condition, err := p.newBoolExpr(parsed.WhereClause)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := p.variables
+	if scope == nil {
+		// A statement parsed on its own has its own scope, its variable can't be used by other statements.
+		scope = &variableScope{}
+	}
+	if scope.lookup(let.Name) >= 0 {
+		return nil, fmt.Errorf("variable %q is already declared", let.Name)
+	}
+	if _, ok := p.pathContextNames[let.Name]; ok {
+		return nil, fmt.Errorf("variable %q can't have the name of a context", let.Name)
+	}
+
+	var getter Getter[K]
+	var varType string
+	if let.Condition != nil {
+		boolExpr, err := p.newBoolExpr(let.Condition)
+		if err != nil {
+			return nil, err
+		}
+		getter = &StandardGetSetter[K]{
+			Getter: func(ctx context.Context, tCtx K) (any, error) {
+				return boolExpr.Eval(ctx, tCtx)
+			},
+		}
+		varType = "bool"
+	} else {
+		getter, err = p.newGetter(*let.Value)
+		if err != nil {
+			return nil, err
+		}
+		varType = p.staticType(*let.Value)
+	}
+
+	index := len(scope.names)
+	scope.names = append(scope.names, let.Name)
+	scope.types = append(scope.types, varType)
+
+	return &Statement[K]{
+		function: Expr[K]{
+			exprFunc: func(ctx context.Context, tCtx K) (any, error) {
+				values, ok := ctx.Value(scope).([]any)
+				if !ok {
+					return nil, fmt.Errorf("variable %q can only be declared by statements executed by a StatementSequence", let.Name)
+				}
+				val, err := getter.Get(ctx, tCtx)
+				if err != nil {
+					return nil, err
+				}
+				values[index] = val
+				return val, nil
+			},
+		},
+		condition:         condition,
+		origText:          origText,
+		telemetrySettings: p.telemetrySettings,
+		variables:         scope,
+		memo:              p.memo,
+		readOnly:          true,
+	}, nil
+}
+
+// staticType returns the type of the value when it's known at parse time, or an empty string.
+func (p *Parser[K]) staticType(val value) string {
+	switch {
+	case val.String != nil:
+		return "string"
+	case val.Bool != nil:
+		return "bool"
+	case val.Bytes != nil:
+		return "byte slice"
+	case val.Map != nil:
+		return "map"
+	case val.List != nil:
+		return "list"
+	case val.Literal != nil:
+		switch {
+		case val.Literal.Int != nil:
+			return "int"
+		case val.Literal.Float != nil:
+			return "float"
+		case val.Literal.Path != nil && p.variables != nil:
+			path := val.Literal.Path
+			if path.Context == "" && len(path.Fields) == 1 && len(path.Fields[0].Keys) == 0 {
+				if index := p.variables.lookup(path.Fields[0].Name); index >= 0 {
+					return p.variables.types[index]
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// checkVariableType returns an error if the argument is a variable whose type is known at parse
+// time and isn't accepted by the parameter type.
+func (p *Parser[K]) checkVariableType(argVal value, argType string) error {
+	if p.variables == nil || argVal.Literal == nil || argVal.Literal.Path == nil {
+		return nil
+	}
+	path := argVal.Literal.Path
+	if path.Context != "" || len(path.Fields) != 1 || len(path.Fields[0].Keys) > 0 {
+		return nil
+	}
+	if p.lambdaScope != nil {
+		if scope, _ := p.lambdaScope.lookup(path.Fields[0].Name); scope != nil {
+			return nil
+		}
+	}
+	index := p.variables.lookup(path.Fields[0].Name)
+	if index < 0 || p.variables.types[index] == "" {
+		return nil
+	}
+	for prefix, accepted := range strictGetterTypes {
+		if strings.HasPrefix(argType, prefix) && !slices.Contains(accepted, p.variables.types[index]) {
+			return fmt.Errorf("variable %q has type %s, expected %s", path.Fields[0].Name, p.variables.types[index], strings.Join(accepted, " or "))
+		}
+	}
+	return nil
+}
+
+// newVariableGetter returns a getter for the path if it refers to a variable declared by a
+// previous statement of the group, or nil otherwise.
+func (p *Parser[K]) newVariableGetter(path *path) (Getter[K], error) {
+	if p.variables == nil {
+		return nil, nil
+	}
+	if path.Context != "" {
+		if p.variables.lookup(path.Context) >= 0 {
+			return nil, fmt.Errorf("variable %q has no fields, use keys to access its elements", path.Context)
+		}
+		return nil, nil
+	}
+	if len(path.Fields) == 0 {
+		return nil, nil
+	}
+	name := path.Fields[0].Name
+	scope := p.variables
+	index := scope.lookup(name)
+	if index < 0 {
+		return nil, nil
+	}
+	if len(path.Fields) > 1 {
+		return nil, fmt.Errorf("variable %q has no fields, use keys to access its elements", name)
+	}
+	keys := path.Fields[0].Keys
+	if len(keys) > 0 {
+		if varType := scope.types[index]; varType != "" && varType != "map" && varType != "list" {
+			return nil, fmt.Errorf("variable %q has type %s and can't be indexed", name, varType)
+		}
+	}
+	for _, k := range keys {
+		if k.String == nil && k.Int == nil {
+			return nil, fmt.Errorf("variable %q can only be indexed with string or int literals", name)
+		}
+	}
+
+	return &exprGetter[K]{
+		expr: Expr[K]{
+			exprFunc: func(ctx context.Context, _ K) (any, error) {
+				values, ok := ctx.Value(scope).([]any)
+				if !ok {
+					return nil, fmt.Errorf("variable %q can only be used by statements executed by a StatementSequence", name)
+				}
+				return values[index], nil
+			},
+		},
+		keys: keys,
+	}, nil
+}
+
+// newScopedGetter returns a getter for the path if it refers to a lambda parameter or to a
+// variable, or nil otherwise. Lambda parameters shadow variables, which shadow telemetry paths.
+func (p *Parser[K]) newScopedGetter(path *path) (Getter[K], error) {
+	lambdaParameter, err := p.newLambdaParameterGetter(path)
+	if err != nil || lambdaParameter != nil {
+		return lambdaParameter, err
+	}
+	return p.newVariableGetter(path)
+}
@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ottl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+type recordArguments struct {
+	Value Getter[any]
+}
+
+type countArguments struct {
+	Value Getter[any]
+}
+
+type upperArguments struct {
+	Value StringGetter[any]
+}
+
+type setArguments struct {
+	Target GetSetter[any]
+	Value  Getter[any]
+}
+
+func newVariablesTestParser(t *testing.T, recorded *[]any, calls *int) Parser[any] {
+	record := func(value Getter[any]) (ExprFunc[any], error) {
+		return func(ctx context.Context, tCtx any) (any, error) {
+			val, err := value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			*recorded = append(*recorded, val)
+			return nil, nil
+		}, nil
+	}
+	count := func(value Getter[any]) (ExprFunc[any], error) {
+		return func(ctx context.Context, tCtx any) (any, error) {
+			*calls++
+			return value.Get(ctx, tCtx)
+		}, nil
+	}
+	upper := func(value StringGetter[any]) (ExprFunc[any], error) {
+		return func(ctx context.Context, tCtx any) (any, error) {
+			val, err := value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return strings.ToUpper(val), nil
+		}, nil
+	}
+	set := func(target GetSetter[any], value Getter[any]) (ExprFunc[any], error) {
+		return func(ctx context.Context, tCtx any) (any, error) {
+			val, err := value.Get(ctx, tCtx)
+			if err != nil {
+				return nil, err
+			}
+			return nil, target.Set(ctx, tCtx, val)
+		}, nil
+	}
+	p, err := NewParser[any](
+		CreateFactoryMap(
+			createFactory("record", &recordArguments{}, record),
+			createFactory("set", &setArguments{}, set),
+			createFactory("Count", &countArguments{}, count),
+			createFactory("Upper", &upperArguments{}, upper),
+			createFactory("Apply", &applyArguments{}, apply),
+		),
+		testParsePath[any],
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+	return p
+}
+
+func Test_variables(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		expected   []any
+		calls      int
+	}{
+		{
+			name: "evaluated once",
+			statements: []string{
+				`let x = Count(name)`,
+				`record(x)`,
+				`record(Upper(x))`,
+				`record(x) where x == "tctx"`,
+			},
+			expected: []any{"tctx", "TCTX", "tctx"},
+			calls:    1,
+		},
+		{
+			name: "indexed",
+			statements: []string{
+				`let m = {"a": {"b": [1, 2]}}`,
+				`record(m["a"]["b"][1])`,
+			},
+			expected: []any{int64(2)},
+		},
+		{
+			name: "condition",
+			statements: []string{
+				`let matched = name == "tctx" and not (name == "other")`,
+				`record(matched)`,
+			},
+			expected: []any{true},
+		},
+		{
+			name: "unmet condition leaves the variable unset",
+			statements: []string{
+				`let x = 1 where name == "other"`,
+				`record(x)`,
+			},
+			expected: []any{nil},
+		},
+		{
+			name: "variable referring to a variable",
+			statements: []string{
+				`let x = "a"`,
+				`let y = x`,
+				`record(Upper(y))`,
+			},
+			expected: []any{"A"},
+		},
+		{
+			name: "lambda body",
+			statements: []string{
+				`let n = 10`,
+				`record(Apply([1, 2], x => x + n))`,
+			},
+			expected: []any{[]any{int64(11), int64(12)}},
+		},
+		{
+			name: "lambda parameter shadows variable",
+			statements: []string{
+				`let x = 10`,
+				`record(Apply([1], x => x))`,
+			},
+			expected: []any{[]any{int64(1)}},
+		},
+		{
+			name: "variable as key",
+			statements: []string{
+				`let k = "b"`,
+				`record(attributes[k])`,
+			},
+			expected: []any{"tctx"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recorded []any
+			var calls int
+			p := newVariablesTestParser(t, &recorded, &calls)
+			statements, err := p.ParseStatements(tt.statements)
+			require.NoError(t, err)
+			sequence := NewStatementSequence(statements, componenttest.NewNopTelemetrySettings())
+
+			require.NoError(t, sequence.Execute(context.Background(), "tctx"))
+			assert.Equal(t, tt.expected, recorded)
+			assert.Equal(t, tt.calls, calls)
+
+			// The variables are evaluated again for each TransformContext.
+			recorded = nil
+			require.NoError(t, sequence.Execute(context.Background(), "tctx"))
+			assert.Equal(t, tt.expected, recorded)
+			assert.Equal(t, 2*tt.calls, calls)
+		})
+	}
+}
+
+func Test_variables_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []string
+		wantErr    string
+	}{
+		{
+			name:       "redeclared",
+			statements: []string{`let x = 1`, `let x = 2`},
+			wantErr:    `variable "x" is already declared`,
+		},
+		{
+			name:       "used before declaration",
+			statements: []string{`record(x)`, `let x = 1`},
+			wantErr:    "bad path",
+		},
+		{
+			name:       "used by its own condition",
+			statements: []string{`let x = 1 where x == 1`},
+			wantErr:    "bad path",
+		},
+		{
+			name:       "set",
+			statements: []string{`let x = 1`, `set(x, 2)`},
+			wantErr:    `variable "x" can't be set`,
+		},
+		{
+			name:       "fields",
+			statements: []string{`let x = {"a": 1}`, `record(x.a)`},
+			wantErr:    `variable "x" has no fields, use keys to access its elements`,
+		},
+		{
+			name:       "dynamic key",
+			statements: []string{`let x = {"a": 1}`, `record(x[name])`},
+			wantErr:    `variable "x" can only be indexed with string or int literals`,
+		},
+		{
+			name:       "scalar indexed",
+			statements: []string{`let x = 1`, `record(x["a"])`},
+			wantErr:    `variable "x" has type int and can't be indexed`,
+		},
+		{
+			name:       "type mismatch",
+			statements: []string{`let x = 1`, `record(Upper(x))`},
+			wantErr:    `variable "x" has type int, expected string`,
+		},
+		{
+			name:       "condition type mismatch",
+			statements: []string{`let x = name == "a"`, `let y = x`, `record(Upper(y))`},
+			wantErr:    `variable "y" has type bool, expected string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newVariablesTestParser(t, nil, nil)
+			_, err := p.ParseStatements(tt.statements)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_variables_not_shared(t *testing.T) {
+	p := newVariablesTestParser(t, nil, nil)
+	_, err := p.ParseStatements([]string{`let x = 1`})
+	require.NoError(t, err)
+	_, err = p.ParseStatements([]string{`record(x)`})
+	assert.ErrorContains(t, err, "bad path")
+
+	statement, err := p.ParseStatement(`let x = 1`)
+	require.NoError(t, err)
+	_, _, err = statement.Execute(context.Background(), "tctx")
+	assert.EqualError(t, err, `variable "x" can only be declared by statements executed by a StatementSequence`)
+}
+
+func Test_variables_paths(t *testing.T) {
+	p := newVariablesTestParser(t, nil, nil)
+	p.pathContextNames = map[string]struct{}{"log": {}}
+
+	statements, err := p.prependContextToStatementsPaths("log", []string{
+		`record(x)`,
+		`let x = attributes["a"]`,
+		`record(x["b"]) where x != name`,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`record(log.x)`,
+		`let x = log.attributes["a"]`,
+		`record(x["b"]) where x != log.name`,
+	}, statements)
+
+	_, err = p.ParseStatements([]string{`let log = 1`})
+	assert.ErrorContains(t, err, `variable "log" can't have the name of a context`)
+}
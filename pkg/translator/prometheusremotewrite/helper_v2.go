@@ -76,7 +76,7 @@ func (c *prometheusConverterV2) addResourceTargetInfoV2(resource pcommon.Resourc
 // addSampleWithLabels is a helper function to create and add a sample with labels
 func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timestamp int64, noRecordedValue bool,
 	baseName string, baseLabels []prompb.Label, labelName, labelValue string, metadata metadata,
-) {
+) *writev2.TimeSeries {
 	sample := &writev2.Sample{
 		Value:     sampleValue,
 		Timestamp: timestamp,
@@ -85,10 +85,9 @@ func (c *prometheusConverterV2) addSampleWithLabels(sampleValue float64, timesta
 		sample.Value = math.Float64frombits(value.StaleNaN)
 	}
 	if labelName != "" && labelValue != "" {
-		c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
-	} else {
-		c.addSample(sample, createLabels(baseName, baseLabels), metadata)
+		return c.addSample(sample, createLabels(baseName, baseLabels, labelName, labelValue), metadata)
 	}
+	return c.addSample(sample, createLabels(baseName, baseLabels), metadata)
 }
 
 func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryDataPointSlice, resource pcommon.Resource,
@@ -97,18 +96,19 @@ func (c *prometheusConverterV2) addSummaryDataPoints(dataPoints pmetric.SummaryD
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
+		ct := createdTimestamp(pt.StartTimestamp())
 		baseLabels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false)
 		noRecordedValue := pt.Flags().NoRecordedValue()
 
 		// Add sum and count samples
-		c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata).CreatedTimestamp = ct
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata).CreatedTimestamp = ct
 
 		// Process quantiles
 		for i := 0; i < pt.QuantileValues().Len(); i++ {
 			qt := pt.QuantileValues().At(i)
 			percentileStr := strconv.FormatFloat(qt.Quantile(), 'f', -1, 64)
-			c.addSampleWithLabels(qt.Value(), timestamp, noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata)
+			c.addSampleWithLabels(qt.Value(), timestamp, noRecordedValue, baseName, baseLabels, quantileStr, percentileStr, metadata).CreatedTimestamp = ct
 		}
 	}
 }
@@ -119,31 +119,38 @@ func (c *prometheusConverterV2) addHistogramDataPoints(dataPoints pmetric.Histog
 	for x := 0; x < dataPoints.Len(); x++ {
 		pt := dataPoints.At(x)
 		timestamp := convertTimeStamp(pt.Timestamp())
+		ct := createdTimestamp(pt.StartTimestamp())
 		baseLabels := createAttributes(resource, pt.Attributes(), settings.ExternalLabels, nil, false)
 		noRecordedValue := pt.Flags().NoRecordedValue()
 
 		// If the sum is unset, it indicates the _sum metric point should be
 		// omitted
 		if pt.HasSum() {
-			c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata)
+			c.addSampleWithLabels(pt.Sum(), timestamp, noRecordedValue, baseName+sumStr, baseLabels, "", "", metadata).CreatedTimestamp = ct
 		}
 
 		// treat count as a sample in an individual TimeSeries
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata)
+		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+countStr, baseLabels, "", "", metadata).CreatedTimestamp = ct
 
 		// cumulative count for conversion to cumulative histogram
 		var cumulativeCount uint64
 
+		bucketBounds := make([]bucketBoundsDataV2, 0, pt.ExplicitBounds().Len()+1)
+
 		// process each bound, based on histograms proto definition, # of buckets = # of explicit bounds + 1
 		for i := 0; i < pt.ExplicitBounds().Len() && i < pt.BucketCounts().Len(); i++ {
 			bound := pt.ExplicitBounds().At(i)
 			cumulativeCount += pt.BucketCounts().At(i)
 			boundStr := strconv.FormatFloat(bound, 'f', -1, 64)
-			c.addSampleWithLabels(float64(cumulativeCount), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
+			ts := c.addSampleWithLabels(float64(cumulativeCount), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, boundStr, metadata)
+			ts.CreatedTimestamp = ct
+			bucketBounds = append(bucketBounds, bucketBoundsDataV2{ts: ts, bound: bound})
 		}
 		// add le=+Inf bucket
-		c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
+		ts := c.addSampleWithLabels(float64(pt.Count()), timestamp, noRecordedValue, baseName+bucketStr, baseLabels, leStr, pInfStr, metadata)
+		ts.CreatedTimestamp = ct
+		bucketBounds = append(bucketBounds, bucketBoundsDataV2{ts: ts, bound: math.Inf(1)})
 
-		// TODO implement exemplars support
+		c.addExemplars(pt, bucketBounds)
 	}
 }
@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
+
+import (
+	"math"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func (c *prometheusConverterV2) addExponentialHistogramDataPoints(dataPoints pmetric.ExponentialHistogramDataPointSlice,
+	resource pcommon.Resource, settings Settings, baseName string, metadata metadata, temporality pmetric.AggregationTemporality,
+) error {
+	for x := 0; x < dataPoints.Len(); x++ {
+		pt := dataPoints.At(x)
+		lbls := createAttributes(
+			resource,
+			pt.Attributes(),
+			settings.ExternalLabels,
+			nil,
+			true,
+			model.MetricNameLabel,
+			baseName,
+		)
+
+		h, err := exponentialToNativeHistogram(pt)
+		if err != nil {
+			return err
+		}
+		hv2 := nativeHistogramToV2(h)
+		if temporality == pmetric.AggregationTemporalityDelta {
+			hv2.ResetHint = writev2.Histogram_RESET_HINT_GAUGE
+		}
+
+		ts := c.addHistogram(hv2, lbls, metadata)
+		ts.CreatedTimestamp = createdTimestamp(pt.StartTimestamp())
+		ts.Exemplars = getPromExemplarsV2(pt, &c.symbolTable)
+	}
+
+	return nil
+}
+
+// addCustomBucketsHistogramDataPoints translates explicit-bucket histogram data points to native
+// histograms with custom buckets (NHCB), producing a single series per data point instead of
+// the classic _bucket, _sum and _count series.
+func (c *prometheusConverterV2) addCustomBucketsHistogramDataPoints(dataPoints pmetric.HistogramDataPointSlice,
+	resource pcommon.Resource, settings Settings, baseName string, metadata metadata, temporality pmetric.AggregationTemporality,
+) {
+	for x := 0; x < dataPoints.Len(); x++ {
+		pt := dataPoints.At(x)
+		lbls := createAttributes(
+			resource,
+			pt.Attributes(),
+			settings.ExternalLabels,
+			nil,
+			true,
+			model.MetricNameLabel,
+			baseName,
+		)
+
+		ts := c.addHistogram(explicitHistogramToCustomBucketsHistogramV2(pt, temporality), lbls, metadata)
+		ts.CreatedTimestamp = createdTimestamp(pt.StartTimestamp())
+		ts.Exemplars = getPromExemplarsV2(pt, &c.symbolTable)
+	}
+}
+
+// explicitHistogramToCustomBucketsHistogramV2 translates an OTel explicit-bucket histogram data point
+// to a Prometheus native histogram with custom buckets.
+func explicitHistogramToCustomBucketsHistogramV2(p pmetric.HistogramDataPoint, temporality pmetric.AggregationTemporality) writev2.Histogram {
+	spans, deltas := customBucketsLayout(p.BucketCounts().AsRaw())
+
+	// See exponentialToNativeHistogram for why the reset hint is unspecified for cumulative histograms.
+	resetHint := writev2.Histogram_RESET_HINT_UNSPECIFIED
+	if temporality == pmetric.AggregationTemporalityDelta {
+		resetHint = writev2.Histogram_RESET_HINT_GAUGE
+	}
+
+	h := writev2.Histogram{
+		ResetHint: resetHint,
+		Schema:    histogram.CustomBucketsSchema,
+
+		PositiveSpans:  spans,
+		PositiveDeltas: deltas,
+		// OTel explicit histograms have an implicit +Inf bucket whose lower bound is the last
+		// explicit bound, which is the same layout as the custom_values of a NHCB.
+		CustomValues: p.ExplicitBounds().AsRaw(),
+
+		Timestamp: convertTimeStamp(p.Timestamp()),
+	}
+
+	if p.Flags().NoRecordedValue() {
+		h.Sum = math.Float64frombits(value.StaleNaN)
+		h.Count = &writev2.Histogram_CountInt{CountInt: value.StaleNaN}
+	} else {
+		if p.HasSum() {
+			h.Sum = p.Sum()
+		}
+		h.Count = &writev2.Histogram_CountInt{CountInt: p.Count()}
+	}
+	return h
+}
+
+// customBucketsLayout converts dense bucket counts to the sparse span and delta representation
+// of native histograms. Empty buckets are skipped by starting a new span.
+func customBucketsLayout(bucketCounts []uint64) ([]writev2.BucketSpan, []int64) {
+	var (
+		spans     []writev2.BucketSpan
+		deltas    []int64
+		prevCount int64
+		gap       int32
+	)
+	for _, count := range bucketCounts {
+		if count == 0 {
+			gap++
+			continue
+		}
+		if len(spans) == 0 || gap > 0 {
+			spans = append(spans, writev2.BucketSpan{Offset: gap})
+			gap = 0
+		}
+		spans[len(spans)-1].Length++
+		deltas = append(deltas, int64(count)-prevCount)
+		prevCount = int64(count)
+	}
+	return spans, deltas
+}
+
+// nativeHistogramToV2 converts a remote write 1.0 native histogram to its 2.0 representation.
+func nativeHistogramToV2(h prompb.Histogram) writev2.Histogram {
+	out := writev2.Histogram{
+		Sum:            h.Sum,
+		Schema:         h.Schema,
+		ZeroThreshold:  h.ZeroThreshold,
+		NegativeSpans:  bucketSpansToV2(h.NegativeSpans),
+		NegativeDeltas: h.NegativeDeltas,
+		NegativeCounts: h.NegativeCounts,
+		PositiveSpans:  bucketSpansToV2(h.PositiveSpans),
+		PositiveDeltas: h.PositiveDeltas,
+		PositiveCounts: h.PositiveCounts,
+		ResetHint:      writev2.Histogram_ResetHint(h.ResetHint),
+		Timestamp:      h.Timestamp,
+		CustomValues:   h.CustomValues,
+	}
+	switch count := h.Count.(type) {
+	case *prompb.Histogram_CountInt:
+		out.Count = &writev2.Histogram_CountInt{CountInt: count.CountInt}
+	case *prompb.Histogram_CountFloat:
+		out.Count = &writev2.Histogram_CountFloat{CountFloat: count.CountFloat}
+	}
+	switch zeroCount := h.ZeroCount.(type) {
+	case *prompb.Histogram_ZeroCountInt:
+		out.ZeroCount = &writev2.Histogram_ZeroCountInt{ZeroCountInt: zeroCount.ZeroCountInt}
+	case *prompb.Histogram_ZeroCountFloat:
+		out.ZeroCount = &writev2.Histogram_ZeroCountFloat{ZeroCountFloat: zeroCount.ZeroCountFloat}
+	}
+	return out
+}
+
+func bucketSpansToV2(spans []prompb.BucketSpan) []writev2.BucketSpan {
+	if spans == nil {
+		return nil
+	}
+	out := make([]writev2.BucketSpan, len(spans))
+	for i, s := range spans {
+		out[i] = writev2.BucketSpan{Offset: s.Offset, Length: s.Length}
+	}
+	return out
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
+	"github.com/prometheus/prometheus/prompb"
+	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestCustomBucketsLayout(t *testing.T) {
+	tests := []struct {
+		name       string
+		counts     []uint64
+		wantSpans  []writev2.BucketSpan
+		wantDeltas []int64
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:       "all buckets populated",
+			counts:     []uint64{1, 3, 2},
+			wantSpans:  []writev2.BucketSpan{{Offset: 0, Length: 3}},
+			wantDeltas: []int64{1, 2, -1},
+		},
+		{
+			name:       "leading and inner empty buckets",
+			counts:     []uint64{0, 0, 4, 0, 1},
+			wantSpans:  []writev2.BucketSpan{{Offset: 2, Length: 1}, {Offset: 1, Length: 1}},
+			wantDeltas: []int64{4, -3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans, deltas := customBucketsLayout(tt.counts)
+			assert.Equal(t, tt.wantSpans, spans)
+			assert.Equal(t, tt.wantDeltas, deltas)
+		})
+	}
+}
+
+func TestPrometheusConverterV2_addCustomBucketsHistogramDataPoints(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("test_hist")
+	metric.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	pt := metric.Histogram().DataPoints().AppendEmpty()
+	pt.SetStartTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
+	pt.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(500)))
+	pt.SetCount(5)
+	pt.SetSum(12.5)
+	pt.ExplicitBounds().FromRaw([]float64{1, 5})
+	pt.BucketCounts().FromRaw([]uint64{2, 0, 3})
+	exemplar := pt.Exemplars().AppendEmpty()
+	exemplar.SetDoubleValue(7)
+	exemplar.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(400)))
+
+	converter := newPrometheusConverterV2()
+	converter.addCustomBucketsHistogramDataPoints(
+		metric.Histogram().DataPoints(),
+		pcommon.NewResource(),
+		Settings{},
+		metric.Name(),
+		metadata{Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM},
+		metric.Histogram().AggregationTemporality(),
+	)
+
+	lbls := []prompb.Label{{Name: model.MetricNameLabel, Value: "test_hist"}}
+	want := map[uint64]*writev2.TimeSeries{
+		timeSeriesSignature(lbls): {
+			LabelsRefs: []uint32{1, 2},
+			Histograms: []writev2.Histogram{
+				{
+					Count:          &writev2.Histogram_CountInt{CountInt: 5},
+					Sum:            12.5,
+					Schema:         histogram.CustomBucketsSchema,
+					PositiveSpans:  []writev2.BucketSpan{{Offset: 0, Length: 1}, {Offset: 1, Length: 1}},
+					PositiveDeltas: []int64{2, 1},
+					CustomValues:   []float64{1, 5},
+					Timestamp:      500,
+				},
+			},
+			Exemplars: []writev2.Exemplar{{Value: 7, Timestamp: 400}},
+			Metadata: writev2.Metadata{
+				Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			},
+			CreatedTimestamp: 100,
+		},
+	}
+	assert.Equal(t, want, converter.unique)
+}
+
+func TestPrometheusConverterV2_addExponentialHistogramDataPoints(t *testing.T) {
+	metric := pmetric.NewMetric()
+	metric.SetName("test_exp_hist")
+	metric.SetEmptyExponentialHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	pt := metric.ExponentialHistogram().DataPoints().AppendEmpty()
+	pt.SetStartTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(100)))
+	pt.SetTimestamp(pcommon.NewTimestampFromTime(time.UnixMilli(500)))
+	pt.SetCount(4)
+	pt.SetSum(10.1)
+	pt.SetScale(1)
+	pt.SetZeroCount(1)
+	pt.Positive().BucketCounts().FromRaw([]uint64{1, 1})
+	pt.Positive().SetOffset(1)
+	pt.Negative().BucketCounts().FromRaw([]uint64{1, 1})
+	pt.Negative().SetOffset(1)
+
+	converter := newPrometheusConverterV2()
+	require.NoError(t, converter.addExponentialHistogramDataPoints(
+		metric.ExponentialHistogram().DataPoints(),
+		pcommon.NewResource(),
+		Settings{},
+		metric.Name(),
+		metadata{Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM},
+		metric.ExponentialHistogram().AggregationTemporality(),
+	))
+
+	lbls := []prompb.Label{{Name: model.MetricNameLabel, Value: "test_exp_hist"}}
+	want := map[uint64]*writev2.TimeSeries{
+		timeSeriesSignature(lbls): {
+			LabelsRefs: []uint32{1, 2},
+			Histograms: []writev2.Histogram{
+				{
+					Count:          &writev2.Histogram_CountInt{CountInt: 4},
+					Sum:            10.1,
+					Schema:         1,
+					ZeroThreshold:  defaultZeroThreshold,
+					ZeroCount:      &writev2.Histogram_ZeroCountInt{ZeroCountInt: 1},
+					NegativeSpans:  []writev2.BucketSpan{{Offset: 2, Length: 2}},
+					NegativeDeltas: []int64{1, 0},
+					PositiveSpans:  []writev2.BucketSpan{{Offset: 2, Length: 2}},
+					PositiveDeltas: []int64{1, 0},
+					ResetHint:      writev2.Histogram_RESET_HINT_GAUGE,
+					Timestamp:      500,
+				},
+			},
+			Metadata: writev2.Metadata{
+				Type: writev2.Metadata_METRIC_TYPE_HISTOGRAM,
+			},
+			CreatedTimestamp: 100,
+		},
+	}
+	assert.Equal(t, want, converter.unique)
+}
@@ -23,6 +23,10 @@ type Settings struct {
 	DisableTargetInfo bool
 	AddMetricSuffixes bool
 	SendMetadata      bool
+
+	// ConvertHistogramsToNHCB controls whether explicit-bucket histograms are
+	// sent as native histograms with custom buckets (NHCB). Only used by PRW 2.0.
+	ConvertHistogramsToNHCB bool
 }
 
 // FromMetrics converts pmetric.Metrics to Prometheus remote write format.
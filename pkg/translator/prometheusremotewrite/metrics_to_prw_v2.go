@@ -105,9 +105,17 @@ func (c *prometheusConverterV2) fromMetrics(md pmetric.Metrics, settings Setting
 					if dataPoints.Len() == 0 {
 						break
 					}
+					if settings.ConvertHistogramsToNHCB {
+						c.addCustomBucketsHistogramDataPoints(dataPoints, resource, settings, promName, m, metric.Histogram().AggregationTemporality())
+						break
+					}
 					c.addHistogramDataPoints(dataPoints, resource, settings, promName, m)
 				case pmetric.MetricTypeExponentialHistogram:
-					// TODO implement
+					dataPoints := metric.ExponentialHistogram().DataPoints()
+					if dataPoints.Len() == 0 {
+						break
+					}
+					errs = multierr.Append(errs, c.addExponentialHistogramDataPoints(dataPoints, resource, settings, promName, m, metric.ExponentialHistogram().AggregationTemporality()))
 				case pmetric.MetricTypeSummary:
 					dataPoints := metric.Summary().DataPoints()
 					if dataPoints.Len() == 0 {
@@ -134,30 +142,73 @@ func (c *prometheusConverterV2) timeSeries() []writev2.TimeSeries {
 	return allTS
 }
 
-func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) {
+// addSample creates a TimeSeries holding sample for lbls, and returns it so callers can attach
+// exemplars and a created timestamp.
+func (c *prometheusConverterV2) addSample(sample *writev2.Sample, lbls []prompb.Label, metadata metadata) *writev2.TimeSeries {
+	ts := c.newTimeSeries(lbls, metadata)
+	ts.Samples = []writev2.Sample{*sample}
+	return ts
+}
+
+// addHistogram creates a TimeSeries holding the native histogram h for lbls, and returns it.
+func (c *prometheusConverterV2) addHistogram(h writev2.Histogram, lbls []prompb.Label, metadata metadata) *writev2.TimeSeries {
+	ts := c.newTimeSeries(lbls, metadata)
+	ts.Histograms = []writev2.Histogram{h}
+	return ts
+}
+
+// newTimeSeries interns lbls and metadata into the symbol table and registers an empty TimeSeries for them.
+func (c *prometheusConverterV2) newTimeSeries(lbls []prompb.Label, metadata metadata) *writev2.TimeSeries {
 	// TODO consider how to accommodate metadata in the symbol table when allocating the buffer, given not all metrics might have metadata.
 	buf := make([]uint32, 0, len(lbls)*2)
 
-	// TODO: Read the PRW spec to see if labels need to be sorted. If it is, then we need to sort in export code. If not, we can sort in the test. (@dashpole have more context on this)
+	// Labels are sorted so that LabelsRefs are stable for a given label set.
 	sort.Slice(lbls, func(i, j int) bool {
 		return lbls[i].Name < lbls[j].Name
 	})
 
-	var off uint32
 	for _, l := range lbls {
-		off = c.symbolTable.Symbolize(l.Name)
-		buf = append(buf, off)
-		off = c.symbolTable.Symbolize(l.Value)
-		buf = append(buf, off)
+		buf = append(buf, c.symbolTable.Symbolize(l.Name), c.symbolTable.Symbolize(l.Value))
 	}
-	ts := writev2.TimeSeries{
+	ts := &writev2.TimeSeries{
 		LabelsRefs: buf,
-		Samples:    []writev2.Sample{*sample},
 		Metadata: writev2.Metadata{
 			Type:    metadata.Type,
 			HelpRef: c.symbolTable.Symbolize(metadata.Help),
 			UnitRef: c.symbolTable.Symbolize(metadata.Unit),
 		},
 	}
-	c.unique[timeSeriesSignature(lbls)] = &ts
+	c.unique[timeSeriesSignature(lbls)] = ts
+	return ts
+}
+
+// addExemplars attaches the exemplars of dataPoint to the bucket time series they fall into, provided that
+// the time series has samples. bucketBounds must be sorted by bound.
+func (c *prometheusConverterV2) addExemplars(dataPoint pmetric.HistogramDataPoint, bucketBounds []bucketBoundsDataV2) {
+	if len(bucketBounds) == 0 {
+		return
+	}
+
+	exemplars := getPromExemplarsV2(dataPoint, &c.symbolTable)
+	for _, exemplar := range exemplars {
+		for _, bound := range bucketBounds {
+			if len(bound.ts.Samples) > 0 && exemplar.Value <= bound.bound {
+				bound.ts.Exemplars = append(bound.ts.Exemplars, exemplar)
+				break
+			}
+		}
+	}
+}
+
+type bucketBoundsDataV2 struct {
+	ts    *writev2.TimeSeries
+	bound float64
+}
+
+// createdTimestamp returns the created timestamp in milliseconds for the given OTel start time, or 0 if it is unset.
+func createdTimestamp(start pcommon.Timestamp) int64 {
+	if start == 0 {
+		return 0
+	}
+	return convertTimeStamp(start)
 }
@@ -4,14 +4,19 @@
 package prometheusremotewrite // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheusremotewrite"
 
 import (
+	"encoding/hex"
 	"math"
+	"unicode/utf8"
 
 	"github.com/prometheus/common/model"
 	"github.com/prometheus/prometheus/model/timestamp"
 	"github.com/prometheus/prometheus/model/value"
+	"github.com/prometheus/prometheus/prompb"
 	writev2 "github.com/prometheus/prometheus/prompb/io/prometheus/write/v2"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	prometheustranslator "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/translator/prometheus"
 )
 
 func (c *prometheusConverterV2) addGaugeNumberDataPoints(dataPoints pmetric.NumberDataPointSlice,
@@ -75,16 +80,22 @@ func (c *prometheusConverterV2) addSumNumberDataPoints(dataPoints pmetric.Number
 		if pt.Flags().NoRecordedValue() {
 			sample.Value = math.Float64frombits(value.StaleNaN)
 		}
-		// TODO: properly add exemplars to the TimeSeries
-		c.addSample(sample, lbls, metadata)
+		ts := c.addSample(sample, lbls, metadata)
+		ts.CreatedTimestamp = createdTimestamp(pt.StartTimestamp())
+		ts.Exemplars = getPromExemplarsV2(pt, &c.symbolTable)
 	}
 }
 
-// getPromExemplarsV2 returns a slice of writev2.Exemplar from pdata exemplars.
-func getPromExemplarsV2[T exemplarType](pt T) []writev2.Exemplar {
+// getPromExemplarsV2 returns a slice of writev2.Exemplar from pdata exemplars. Exemplar labels (trace and
+// span IDs, and filtered attributes) are interned into symbolTable.
+func getPromExemplarsV2[T exemplarType](pt T, symbolTable *writev2.SymbolsTable) []writev2.Exemplar {
+	if pt.Exemplars().Len() == 0 {
+		return nil
+	}
 	promExemplars := make([]writev2.Exemplar, 0, pt.Exemplars().Len())
 	for i := 0; i < pt.Exemplars().Len(); i++ {
 		exemplar := pt.Exemplars().At(i)
+		exemplarRunes := 0
 
 		var promExemplar writev2.Exemplar
 
@@ -100,7 +111,38 @@ func getPromExemplarsV2[T exemplarType](pt T) []writev2.Exemplar {
 				Timestamp: timestamp.FromTime(exemplar.Timestamp().AsTime()),
 			}
 		}
-		// TODO append labels to promExemplar.Labels
+
+		var lbls []prompb.Label
+		if traceID := exemplar.TraceID(); !traceID.IsEmpty() {
+			val := hex.EncodeToString(traceID[:])
+			exemplarRunes += utf8.RuneCountInString(prometheustranslator.ExemplarTraceIDKey) + utf8.RuneCountInString(val)
+			lbls = append(lbls, prompb.Label{Name: prometheustranslator.ExemplarTraceIDKey, Value: val})
+		}
+		if spanID := exemplar.SpanID(); !spanID.IsEmpty() {
+			val := hex.EncodeToString(spanID[:])
+			exemplarRunes += utf8.RuneCountInString(prometheustranslator.ExemplarSpanIDKey) + utf8.RuneCountInString(val)
+			lbls = append(lbls, prompb.Label{Name: prometheustranslator.ExemplarSpanIDKey, Value: val})
+		}
+
+		attrs := exemplar.FilteredAttributes()
+		labelsFromAttributes := make([]prompb.Label, 0, attrs.Len())
+		for key, value := range attrs.All() {
+			val := value.AsString()
+			exemplarRunes += utf8.RuneCountInString(key) + utf8.RuneCountInString(val)
+			labelsFromAttributes = append(labelsFromAttributes, prompb.Label{Name: key, Value: val})
+		}
+		if exemplarRunes <= maxExemplarRunes {
+			// only append filtered attributes if it does not cause exemplar
+			// labels to exceed the max number of runes
+			lbls = append(lbls, labelsFromAttributes...)
+		}
+
+		if len(lbls) > 0 {
+			promExemplar.LabelsRefs = make([]uint32, 0, len(lbls)*2)
+			for _, l := range lbls {
+				promExemplar.LabelsRefs = append(promExemplar.LabelsRefs, symbolTable.Symbolize(l.Name), symbolTable.Symbolize(l.Value))
+			}
+		}
 
 		promExemplars = append(promExemplars, promExemplar)
 	}
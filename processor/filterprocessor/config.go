@@ -36,6 +36,8 @@ type Config struct {
 	Spans filterconfig.MatchConfig `mapstructure:"spans"`
 
 	Traces TraceFilters `mapstructure:"traces"`
+
+	Profiles ProfileFilters `mapstructure:"profiles"`
 }
 
 // MetricFilters filters by Metric properties.
@@ -77,6 +79,19 @@ type TraceFilters struct {
 	SpanEventConditions []string `mapstructure:"spanevent"`
 }
 
+// ProfileFilters filters by OTTL conditions
+type ProfileFilters struct {
+	// ProfileConditions is a list of OTTL conditions for an ottlprofile context.
+	// If any condition resolves to true, the profile will be dropped.
+	// Supports `and`, `or`, and `()`
+	ProfileConditions []string `mapstructure:"profile"`
+
+	// ProfileSampleConditions is a list of OTTL conditions for an ottlprofilesample context.
+	// If any condition resolves to true, the profile sample will be dropped.
+	// Supports `and`, `or`, and `()`
+	ProfileSampleConditions []string `mapstructure:"profilesample"`
+}
+
 // LogFilters filters by Log properties.
 type LogFilters struct {
 	// Include match properties describe logs that should be included in the Collector Service pipeline,
@@ -303,6 +318,16 @@ func (cfg *Config) Validate() error {
 		errors = multierr.Append(errors, err)
 	}
 
+	if cfg.Profiles.ProfileConditions != nil {
+		_, err := filterottl.NewBoolExprForProfile(cfg.Profiles.ProfileConditions, filterottl.StandardProfileFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+
+	if cfg.Profiles.ProfileSampleConditions != nil {
+		_, err := filterottl.NewBoolExprForProfileSample(cfg.Profiles.ProfileSampleConditions, filterottl.StandardProfileSampleFuncs(), ottl.PropagateError, component.TelemetrySettings{Logger: zap.NewNop()})
+		errors = multierr.Append(errors, err)
+	}
+
 	if cfg.Logs.LogConditions != nil && cfg.Logs.Include != nil {
 		errors = multierr.Append(errors, cfg.Logs.Include.validate())
 	}
@@ -862,6 +862,14 @@ func TestLoadingConfigOTTL(t *testing.T) {
 						`attributes["test"] == "pass"`,
 					},
 				},
+				Profiles: ProfileFilters{
+					ProfileConditions: []string{
+						`original_payload_format == "pass"`,
+					},
+					ProfileSampleConditions: []string{
+						`attributes["test"] == "pass"`,
+					},
+				},
 			},
 		},
 		{
@@ -903,6 +911,12 @@ func TestLoadingConfigOTTL(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_log"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_syntax_profile"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_syntax_profilesample"),
+		},
 	}
 
 	for _, tt := range tests {
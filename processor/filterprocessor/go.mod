@@ -13,11 +13,16 @@ require (
 	go.opentelemetry.io/collector/confmap/xconfmap v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/consumer v1.35.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/consumer/consumertest v0.129.1-0.20250703115036-26a1aed9c04b
+	go.opentelemetry.io/collector/consumer/xconsumer v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/pdata v1.35.1-0.20250703115036-26a1aed9c04b
+	go.opentelemetry.io/collector/pdata/pprofile v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/pipeline v0.129.1-0.20250703115036-26a1aed9c04b
+	go.opentelemetry.io/collector/pipeline/xpipeline v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/processor v1.35.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/processor/processorhelper v0.129.1-0.20250703115036-26a1aed9c04b
+	go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/collector/processor/processortest v0.129.1-0.20250703115036-26a1aed9c04b
+	go.opentelemetry.io/collector/processor/xprocessor v0.129.1-0.20250703115036-26a1aed9c04b
 	go.opentelemetry.io/otel v1.37.0
 	go.opentelemetry.io/otel/metric v1.37.0
 	go.opentelemetry.io/otel/sdk/metric v1.37.0
@@ -49,6 +54,7 @@ require (
 	github.com/hashicorp/golang-lru/v2 v2.0.7 // indirect
 	github.com/iancoleman/strcase v0.3.0 // indirect
 	github.com/json-iterator/go v1.1.12 // indirect
+	github.com/klauspost/compress v1.18.0 // indirect
 	github.com/knadh/koanf/maps v0.1.2 // indirect
 	github.com/knadh/koanf/providers/confmap v1.0.0 // indirect
 	github.com/knadh/koanf/v2 v2.2.1 // indirect
@@ -63,12 +69,9 @@ require (
 	github.com/ua-parser/uap-go v0.0.0-20240611065828-3a4781585db6 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
 	go.opentelemetry.io/collector/component/componentstatus v0.129.1-0.20250703115036-26a1aed9c04b // indirect
-	go.opentelemetry.io/collector/consumer/xconsumer v0.129.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/featuregate v1.35.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/internal/telemetry v0.129.1-0.20250703115036-26a1aed9c04b // indirect
-	go.opentelemetry.io/collector/pdata/pprofile v0.129.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/collector/pdata/testdata v0.129.1-0.20250703115036-26a1aed9c04b // indirect
-	go.opentelemetry.io/collector/processor/xprocessor v0.129.1-0.20250703115036-26a1aed9c04b // indirect
 	go.opentelemetry.io/contrib/bridges/otelzap v0.12.0 // indirect
 	go.opentelemetry.io/otel/log v0.13.0 // indirect
 	go.opentelemetry.io/otel/sdk v1.37.0 // indirect
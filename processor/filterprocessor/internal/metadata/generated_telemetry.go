@@ -28,6 +28,7 @@ type TelemetryBuilder struct {
 	registrations                     []metric.Registration
 	ProcessorFilterDatapointsFiltered metric.Int64Counter
 	ProcessorFilterLogsFiltered       metric.Int64Counter
+	ProcessorFilterSamplesFiltered    metric.Int64Counter
 	ProcessorFilterSpansFiltered      metric.Int64Counter
 }
 
@@ -72,6 +73,12 @@ func NewTelemetryBuilder(settings component.TelemetrySettings, options ...Teleme
 		metric.WithUnit("1"),
 	)
 	errs = errors.Join(errs, err)
+	builder.ProcessorFilterSamplesFiltered, err = builder.meter.Int64Counter(
+		"otelcol_processor_filter_samples.filtered",
+		metric.WithDescription("Number of profile samples dropped by the filter processor"),
+		metric.WithUnit("1"),
+	)
+	errs = errors.Join(errs, err)
 	builder.ProcessorFilterSpansFiltered, err = builder.meter.Int64Counter(
 		"otelcol_processor_filter_spans.filtered",
 		metric.WithDescription("Number of spans dropped by the filter processor"),
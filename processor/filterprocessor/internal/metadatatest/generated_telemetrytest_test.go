@@ -22,6 +22,7 @@ func TestSetupTelemetry(t *testing.T) {
 	defer tb.Shutdown()
 	tb.ProcessorFilterDatapointsFiltered.Add(context.Background(), 1)
 	tb.ProcessorFilterLogsFiltered.Add(context.Background(), 1)
+	tb.ProcessorFilterSamplesFiltered.Add(context.Background(), 1)
 	tb.ProcessorFilterSpansFiltered.Add(context.Background(), 1)
 	AssertEqualProcessorFilterDatapointsFiltered(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
@@ -29,6 +30,9 @@ func TestSetupTelemetry(t *testing.T) {
 	AssertEqualProcessorFilterLogsFiltered(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
+	AssertEqualProcessorFilterSamplesFiltered(t, testTel,
+		[]metricdata.DataPoint[int64]{{Value: 1}},
+		metricdatatest.IgnoreTimestamp())
 	AssertEqualProcessorFilterSpansFiltered(t, testTel,
 		[]metricdata.DataPoint[int64]{{Value: 1}},
 		metricdatatest.IgnoreTimestamp())
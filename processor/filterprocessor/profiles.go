@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pipeline/xpipeline"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
+)
+
+type filterProfileProcessor struct {
+	skipProfileExpr       expr.BoolExpr[ottlprofile.TransformContext]
+	skipProfileSampleExpr expr.BoolExpr[ottlprofilesample.TransformContext]
+	telemetry             *filterTelemetry
+	logger                *zap.Logger
+}
+
+func newFilterProfilesProcessor(set processor.Settings, cfg *Config) (*filterProfileProcessor, error) {
+	var err error
+	fpp := &filterProfileProcessor{
+		logger: set.Logger,
+	}
+
+	fpt, err := newFilterTelemetry(set, xpipeline.SignalProfiles)
+	if err != nil {
+		return nil, fmt.Errorf("error creating filter processor telemetry: %w", err)
+	}
+	fpp.telemetry = fpt
+
+	if cfg.Profiles.ProfileConditions != nil {
+		fpp.skipProfileExpr, err = filterottl.NewBoolExprForProfile(cfg.Profiles.ProfileConditions, filterottl.StandardProfileFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Profiles.ProfileSampleConditions != nil {
+		fpp.skipProfileSampleExpr, err = filterottl.NewBoolExprForProfileSample(cfg.Profiles.ProfileSampleConditions, filterottl.StandardProfileSampleFuncs(), cfg.ErrorMode, set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fpp, nil
+}
+
+// processProfiles filters the given profiles and samples based off the filterProfileProcessor's filters.
+func (fpp *filterProfileProcessor) processProfiles(ctx context.Context, pd pprofile.Profiles) (pprofile.Profiles, error) {
+	if fpp.skipProfileExpr == nil && fpp.skipProfileSampleExpr == nil {
+		return pd, nil
+	}
+
+	sampleCountBeforeFilters := pd.SampleCount()
+
+	var errors error
+	dictionary := pd.ProfilesDictionary()
+	pd.ResourceProfiles().RemoveIf(func(rp pprofile.ResourceProfiles) bool {
+		resource := rp.Resource()
+		rp.ScopeProfiles().RemoveIf(func(sp pprofile.ScopeProfiles) bool {
+			scope := sp.Scope()
+			sp.Profiles().RemoveIf(func(profile pprofile.Profile) bool {
+				if fpp.skipProfileExpr != nil {
+					skip, err := fpp.skipProfileExpr.Eval(ctx, ottlprofile.NewTransformContext(profile, dictionary, scope, resource, sp, rp))
+					if err != nil {
+						errors = multierr.Append(errors, err)
+						return false
+					}
+					if skip {
+						return true
+					}
+				}
+				if fpp.skipProfileSampleExpr != nil {
+					profile.Sample().RemoveIf(func(sample pprofile.Sample) bool {
+						skip, err := fpp.skipProfileSampleExpr.Eval(ctx, ottlprofilesample.NewTransformContext(sample, profile, dictionary, scope, resource, sp, rp))
+						if err != nil {
+							errors = multierr.Append(errors, err)
+							return false
+						}
+						return skip
+					})
+					return profile.Sample().Len() == 0
+				}
+				return false
+			})
+			return sp.Profiles().Len() == 0
+		})
+		return rp.ScopeProfiles().Len() == 0
+	})
+
+	sampleCountAfterFilters := pd.SampleCount()
+	fpp.telemetry.record(ctx, int64(sampleCountBeforeFilters-sampleCountAfterFilters))
+
+	if errors != nil {
+		fpp.logger.Error("failed processing profiles", zap.Error(errors))
+		return pd, errors
+	}
+	if pd.ResourceProfiles().Len() == 0 {
+		return pd, processorhelper.ErrSkipProcessingData
+	}
+	return pd, nil
+}
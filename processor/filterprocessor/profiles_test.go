@@ -0,0 +1,202 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package filterprocessor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/collector/processor/xprocessor"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/filterprocessor/internal/metadatatest"
+)
+
+func TestFilterProfileProcessorWithOTTL(t *testing.T) {
+	tests := []struct {
+		name             string
+		conditions       ProfileFilters
+		filterEverything bool
+		want             func(pd pprofile.Profiles)
+		errorMode        ottl.ErrorMode
+	}{
+		{
+			name: "drop profiles",
+			conditions: ProfileFilters{
+				ProfileConditions: []string{
+					`period == 100`,
+				},
+			},
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().RemoveIf(func(profile pprofile.Profile) bool {
+					return profile.Period() == 100
+				})
+			},
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name: "drop everything by dropping all profiles",
+			conditions: ProfileFilters{
+				ProfileConditions: []string{
+					`period > 0`,
+				},
+			},
+			filterEverything: true,
+			errorMode:        ottl.IgnoreError,
+		},
+		{
+			name: "drop samples",
+			conditions: ProfileFilters{
+				ProfileSampleConditions: []string{
+					`values[0] < 10`,
+				},
+			},
+			want: func(pd pprofile.Profiles) {
+				profiles := pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles()
+				for i := 0; i < profiles.Len(); i++ {
+					profiles.At(i).Sample().RemoveIf(func(sample pprofile.Sample) bool {
+						return sample.Value().At(0) < 10
+					})
+				}
+			},
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name: "drop samples by attribute",
+			conditions: ProfileFilters{
+				ProfileSampleConditions: []string{
+					`attributes["thread.name"] == "gc"`,
+				},
+			},
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(1).Sample().RemoveIf(func(sample pprofile.Sample) bool {
+					return sample.Value().At(0) == 20
+				})
+			},
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name: "drop profiles without samples left",
+			conditions: ProfileFilters{
+				ProfileSampleConditions: []string{
+					`profile.period == 200`,
+				},
+			},
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().RemoveIf(func(profile pprofile.Profile) bool {
+					return profile.Period() == 200
+				})
+			},
+			errorMode: ottl.IgnoreError,
+		},
+		{
+			name: "with error conditions",
+			conditions: ProfileFilters{
+				ProfileConditions: []string{
+					`Substring("", 0, 100) == "test"`,
+				},
+			},
+			want:      func(_ pprofile.Profiles) {},
+			errorMode: ottl.IgnoreError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processor, err := newFilterProfilesProcessor(processortest.NewNopSettings(metadata.Type), &Config{Profiles: tt.conditions, ErrorMode: tt.errorMode})
+			require.NoError(t, err)
+
+			got, err := processor.processProfiles(context.Background(), constructProfiles())
+
+			if tt.filterEverything {
+				assert.Equal(t, processorhelper.ErrSkipProcessingData, err)
+			} else {
+				exPd := constructProfiles()
+				tt.want(exPd)
+				assert.Equal(t, exPd, got)
+			}
+		})
+	}
+}
+
+func TestFilterProfileProcessor_NoConditions(t *testing.T) {
+	processor, err := newFilterProfilesProcessor(processortest.NewNopSettings(metadata.Type), &Config{})
+	require.NoError(t, err)
+
+	got, err := processor.processProfiles(context.Background(), constructProfiles())
+	require.NoError(t, err)
+	assert.Equal(t, constructProfiles(), got)
+}
+
+func TestFilterProfileProcessorTelemetry(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	processor, err := newFilterProfilesProcessor(metadatatest.NewSettings(tel), &Config{
+		Profiles: ProfileFilters{
+			ProfileSampleConditions: []string{
+				`values[0] < 10`,
+			},
+		}, ErrorMode: ottl.IgnoreError,
+	})
+	assert.NoError(t, err)
+
+	_, err = processor.processProfiles(context.Background(), constructProfiles())
+	assert.NoError(t, err)
+
+	metadatatest.AssertEqualProcessorFilterSamplesFiltered(t, tel, []metricdata.DataPoint[int64]{
+		{
+			Value:      2,
+			Attributes: attribute.NewSet(attribute.String("filter", "filter")),
+		},
+	}, metricdatatest.IgnoreTimestamp())
+}
+
+func TestFilterProfileProcessor_Factory(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.Profiles.ProfileConditions = []string{`period == 100`}
+
+	sink := new(consumertest.ProfilesSink)
+	pp, err := factory.(xprocessor.Factory).CreateProfiles(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	require.NoError(t, pp.ConsumeProfiles(context.Background(), constructProfiles()))
+	require.Len(t, sink.AllProfiles(), 1)
+	assert.Equal(t, 1, sink.AllProfiles()[0].ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().Len())
+}
+
+func constructProfiles() pprofile.Profiles {
+	pd := pprofile.NewProfiles()
+	dictionary := pd.ProfilesDictionary()
+	rp := pd.ResourceProfiles().AppendEmpty()
+	rp.Resource().Attributes().PutStr("host.name", "localhost")
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName("scope")
+
+	profileOne := sp.Profiles().AppendEmpty()
+	profileOne.SetPeriod(100)
+	profileOne.Sample().AppendEmpty().Value().FromRaw([]int64{5})
+	profileOne.Sample().AppendEmpty().Value().FromRaw([]int64{50})
+
+	profileTwo := sp.Profiles().AppendEmpty()
+	profileTwo.SetPeriod(200)
+	gcSample := profileTwo.Sample().AppendEmpty()
+	gcSample.Value().FromRaw([]int64{20})
+	_ = pprofile.PutAttribute(dictionary.AttributeTable(), gcSample, "thread.name", pcommon.NewValueStr("gc"))
+	mainSample := profileTwo.Sample().AppendEmpty()
+	mainSample.Value().FromRaw([]int64{2})
+	_ = pprofile.PutAttribute(dictionary.AttributeTable(), mainSample, "thread.name", pcommon.NewValueStr("main"))
+	return pd
+}
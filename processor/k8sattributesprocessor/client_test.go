@@ -29,6 +29,9 @@ type fakeClient struct {
 	Namespaces         map[string]*kube.Namespace
 	Nodes              map[string]*kube.Node
 	Deployments        map[string]*kube.Deployment
+	Owners             map[string]*kube.Owner
+	Services           map[string]*kube.Service
+	ServiceWorkloads   map[string]map[string]string
 	StopCh             chan struct{}
 }
 
@@ -77,6 +80,20 @@ func (f *fakeClient) GetDeployment(deploymentUID string) (*kube.Deployment, bool
 	return d, ok
 }
 
+func (f *fakeClient) GetService(address string, _ int32) (*kube.Service, bool) {
+	s, ok := f.Services[address]
+	return s, ok
+}
+
+func (f *fakeClient) GetServiceWorkload(namespace, name string) map[string]string {
+	return f.ServiceWorkloads[namespace+"/"+name]
+}
+
+func (f *fakeClient) GetOwner(uid string) (*kube.Owner, bool) {
+	o, ok := f.Owners[uid]
+	return o, ok
+}
+
 // Start is a noop for FakeClient.
 func (f *fakeClient) Start() error {
 	if f.Informer != nil {
@@ -4,11 +4,13 @@
 package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor"
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/featuregate"
 	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
 
@@ -47,6 +49,11 @@ type Config struct {
 	// and logs with Pod metadata.
 	Association []PodAssociationConfig `mapstructure:"pod_association"`
 
+	// ServiceAssociation section allows to define rules for tagging spans, metrics,
+	// and logs addressed to a Service, or to an endpoint of a headless Service, with
+	// the Service metadata and the metadata of the workload selected by the Service.
+	ServiceAssociation []ServiceAssociationConfig `mapstructure:"service_association"`
+
 	// Exclude section allows to define names of pod that should be
 	// ignored while tagging.
 	Exclude ExcludeConfig `mapstructure:"exclude"`
@@ -56,6 +63,10 @@ type Config struct {
 
 	// WaitForMetadataTimeout is the maximum time the processor will wait for the k8s metadata to be synced.
 	WaitForMetadataTimeout time.Duration `mapstructure:"wait_for_metadata_timeout"`
+
+	// MetadataCache is the ID of a k8s_metadata_cache extension. When set, the informers are obtained
+	// from the extension and shared with the other components using it, instead of being owned by the processor.
+	MetadataCache *component.ID `mapstructure:"metadata_cache"`
 }
 
 func (cfg *Config) Validate() error {
@@ -69,15 +80,27 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for _, assoc := range cfg.ServiceAssociation {
+		switch assoc.Address.From {
+		case kube.ConnectionSource:
+		case kube.ResourceSource:
+			if assoc.Address.Name == "" {
+				return errors.New("service association address from resource_attribute requires a name")
+			}
+		default:
+			return fmt.Errorf("%s is not a valid choice for service association address from. Must be one of: connection, resource_attribute", assoc.Address.From)
+		}
+	}
+
 	for _, f := range append(cfg.Extract.Labels, cfg.Extract.Annotations...) {
 		if f.Key != "" && f.KeyRegex != "" {
 			return fmt.Errorf("Out of Key or KeyRegex only one option is expected to be configured at a time, currently Key:%s and KeyRegex:%s", f.Key, f.KeyRegex)
 		}
 
 		switch f.From {
-		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode, kube.MetadataFromDeployment:
+		case "", kube.MetadataFromPod, kube.MetadataFromNamespace, kube.MetadataFromNode, kube.MetadataFromDeployment, kube.MetadataFromOwner:
 		default:
-			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, deployment, node", f.From)
+			return fmt.Errorf("%s is not a valid choice for From. Must be one of: pod, namespace, deployment, node, owner", f.From)
 		}
 
 		if f.KeyRegex != "" {
@@ -103,12 +126,18 @@ func (cfg *Config) Validate() error {
 			string(conventions.ContainerImageNameKey), string(conventions.ContainerImageTagKey),
 			string(conventions.ServiceNamespaceKey), string(conventions.ServiceNameKey),
 			string(conventions.ServiceVersionKey), string(conventions.ServiceInstanceIDKey),
-			containerImageRepoDigests, clusterUID:
+			containerImageRepoDigests, clusterUID,
+			metadataOwnerName, metadataOwnerKind, metadataOwnerUID,
+			metadataServiceName, metadataServiceUID:
 		default:
 			return fmt.Errorf("\"%s\" is not a supported metadata field", field)
 		}
 	}
 
+	if err := cfg.Extract.OwnerChain.Validate(); err != nil {
+		return err
+	}
+
 	for _, f := range cfg.Filter.Labels {
 		switch f.Op {
 		case "", filterOPEquals, filterOPNotEquals, filterOPExists, filterOPDoesNotExist:
@@ -143,7 +172,7 @@ type ExtractConfig struct {
 	//   k8s.statefulset.name, k8s.statefulset.uid,
 	//   k8s.container.name, container.id, container.image.name,
 	//   container.image.tag, container.image.repo_digests
-	//   k8s.cluster.uid
+	//   k8s.cluster.uid, k8s.owner.name, k8s.owner.kind, k8s.owner.uid
 	//
 	// Specifying anything other than these values will result in an error.
 	// By default, the following fields are extracted and added to spans, metrics and logs as resource attributes:
@@ -173,6 +202,54 @@ type ExtractConfig struct {
 	// OtelAnnotations extracts all pod annotations with the prefix "resource.opentelemetry.io" as resource attributes
 	// E.g. "resource.opentelemetry.io/foo" becomes "foo"
 	OtelAnnotations bool `mapstructure:"otel_annotations"`
+
+	// OwnerChain configures how the owner references of the pods are walked to find their top-level owner,
+	// used by the k8s.owner.* metadata fields and by the labels and annotations extracted from "owner".
+	OwnerChain OwnerChainConfig `mapstructure:"owner_chain"`
+}
+
+// OwnerChainConfig configures the resolution of the top-level owner of the pods. Starting from the
+// controller of a pod, the owner references are followed through the objects of the watched resources,
+// and the chain ends at the first object that has no controller or isn't watched.
+type OwnerChainConfig struct {
+	// Resources is the list of resources watched to follow the owner references, such as the custom
+	// resources of workload controllers. Each resource is watched with a dynamic informer caching only
+	// the metadata of its objects.
+	// By default, the ReplicaSets, Deployments and Jobs are watched.
+	Resources []OwnerResourceConfig `mapstructure:"resources"`
+
+	// MaxDepth is the maximum number of owner references followed from a pod. The default value is 10.
+	MaxDepth int `mapstructure:"max_depth"`
+
+	// MaxObjectsPerResource is the maximum number of objects of each resource kept in the owners cache.
+	// The objects beyond the limit are ignored, ending the owner chains going through them.
+	// The default value, 0, means no limit.
+	MaxObjectsPerResource int `mapstructure:"max_objects_per_resource"`
+}
+
+func (cfg *OwnerChainConfig) Validate() error {
+	if cfg.MaxDepth < 0 {
+		return fmt.Errorf("owner_chain max_depth must not be negative, got %d", cfg.MaxDepth)
+	}
+	if cfg.MaxObjectsPerResource < 0 {
+		return fmt.Errorf("owner_chain max_objects_per_resource must not be negative, got %d", cfg.MaxObjectsPerResource)
+	}
+	for _, r := range cfg.Resources {
+		if r.Version == "" || r.Resource == "" {
+			return fmt.Errorf("owner_chain resource %q must have a version and a resource", r.Group+"/"+r.Version+"/"+r.Resource)
+		}
+	}
+	return nil
+}
+
+// OwnerResourceConfig identifies a resource whose objects can own pods, or other owners of pods.
+type OwnerResourceConfig struct {
+	// Group is the API group of the resource, empty for the core group.
+	Group string `mapstructure:"group"`
+	// Version is the API version of the resource.
+	Version string `mapstructure:"version"`
+	// Resource is the plural name of the resource, such as "rollouts".
+	Resource string `mapstructure:"resource"`
 }
 
 // FieldExtractConfig allows specifying an extraction rule to extract a resource attribute from pod (or namespace)
@@ -208,7 +285,7 @@ type FieldExtractConfig struct {
 	KeyRegex string `mapstructure:"key_regex"`
 
 	// From represents the source of the labels/annotations.
-	// Allowed values are "pod", "namespace", and "node". The default is pod.
+	// Allowed values are "pod", "namespace", "deployment", "node" and "owner". The default is pod.
 	From string `mapstructure:"from"`
 }
 
@@ -299,6 +376,21 @@ type PodAssociationConfig struct {
 	_ struct{}
 }
 
+// ServiceAssociationConfig contains a single rule how to associate Service metadata
+// with logs, spans and metrics addressed to a Service
+type ServiceAssociationConfig struct {
+	// Address is the source of the IP address the telemetry is addressed to.
+	// It is either the address of the Service, or of an endpoint of a headless Service.
+	Address PodAssociationSourceConfig `mapstructure:"address"`
+
+	// Port is the name of the resource attribute holding the port the telemetry is
+	// addressed to. It is optional, and distinguishes Services sharing an address.
+	Port string `mapstructure:"port"`
+
+	// prevent unkeyed literal initialization
+	_ struct{}
+}
+
 // ExcludeConfig represent a list of Pods to exclude
 type ExcludeConfig struct {
 	Pods []ExcludePodConfig `mapstructure:"pods"`
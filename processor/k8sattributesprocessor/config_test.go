@@ -20,6 +20,7 @@ import (
 )
 
 func TestLoadConfig(t *testing.T) {
+	metadataCacheID := component.MustNewID("k8s_metadata_cache")
 	tests := []struct {
 		id       component.ID
 		expected component.Config
@@ -130,6 +131,65 @@ func TestLoadConfig(t *testing.T) {
 				WaitForMetadataTimeout: 10 * time.Second,
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "owner_chain"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				Extract: ExtractConfig{
+					Metadata: []string{"k8s.pod.name", "k8s.owner.name", "k8s.owner.kind"},
+					Labels: []FieldExtractConfig{
+						{Key: "app.kubernetes.io/part-of", From: kube.MetadataFromOwner},
+					},
+					OwnerChain: OwnerChainConfig{
+						Resources: []OwnerResourceConfig{
+							{Group: "apps", Version: "v1", Resource: "replicasets"},
+							{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+						},
+						MaxDepth:              5,
+						MaxObjectsPerResource: 1000,
+					},
+				},
+				WaitForMetadataTimeout: 10 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "service_association"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				Extract: ExtractConfig{
+					Metadata: []string{"k8s.service.name", "k8s.deployment.name"},
+				},
+				ServiceAssociation: []ServiceAssociationConfig{
+					{
+						Address: PodAssociationSourceConfig{
+							From: "resource_attribute",
+							Name: "server.address",
+						},
+						Port: "server.port",
+					},
+					{
+						Address: PodAssociationSourceConfig{
+							From: "connection",
+						},
+					},
+				},
+				WaitForMetadataTimeout: 10 * time.Second,
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "metadata_cache"),
+			expected: &Config{
+				APIConfig: k8sconfig.APIConfig{AuthType: k8sconfig.AuthTypeServiceAccount},
+				Exclude:   ExcludeConfig{Pods: []ExcludePodConfig{{Name: "jaeger-agent"}, {Name: "jaeger-collector"}}},
+				Extract: ExtractConfig{
+					Metadata: enabledAttributes(),
+				},
+				WaitForMetadataTimeout: 10 * time.Second,
+				MetadataCache:          &metadataCacheID,
+			},
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "too_many_sources"),
 		},
@@ -157,6 +217,18 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_filter_field_op"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_owner_chain_resource"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_owner_chain_max_depth"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_service_association_from"),
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_service_association_name"),
+		},
 	}
 
 	for _, tt := range tests {
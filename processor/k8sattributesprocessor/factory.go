@@ -193,6 +193,7 @@ func createProcessorOpts(cfg component.Config) []option {
 	opts = append(opts, withExtractLabels(oCfg.Extract.Labels...))
 	opts = append(opts, withExtractAnnotations(oCfg.Extract.Annotations...))
 	opts = append(opts, withOtelAnnotations(oCfg.Extract.OtelAnnotations))
+	opts = append(opts, withExtractOwnerChain(oCfg.Extract.OwnerChain))
 
 	// filters
 	opts = append(opts, withFilterNode(oCfg.Filter.Node, oCfg.Filter.NodeFromEnvVar))
@@ -202,6 +203,7 @@ func createProcessorOpts(cfg component.Config) []option {
 	opts = append(opts, withAPIConfig(oCfg.APIConfig))
 
 	opts = append(opts, withExtractPodAssociations(oCfg.Association...))
+	opts = append(opts, withExtractServiceAssociations(oCfg.ServiceAssociation...))
 
 	opts = append(opts, withExcludes(oCfg.Exclude))
 
@@ -210,5 +212,9 @@ func createProcessorOpts(cfg component.Config) []option {
 		opts = append(opts, withWaitForMetadata(true))
 	}
 
+	if oCfg.MetadataCache != nil {
+		opts = append(opts, withMetadataCache(*oCfg.MetadataCache))
+	}
+
 	return opts
 }
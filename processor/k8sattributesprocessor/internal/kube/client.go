@@ -20,13 +20,18 @@ import (
 	"go.uber.org/zap"
 	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	dcommon "github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/docker"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
@@ -48,6 +53,9 @@ const (
 	K8sDeploymentAnnotation = "k8s.deployment.annotation.%s"
 )
 
+// transformPrefix identifies the transforms of the processor in the keys of the shared informers.
+const transformPrefix = "k8sattributes"
+
 // WatchClient is the main interface provided by this package to a kubernetes cluster.
 type WatchClient struct {
 	m                      sync.RWMutex
@@ -59,12 +67,20 @@ type WatchClient struct {
 	nodeInformer           cache.SharedInformer
 	deploymentInformer     cache.SharedInformer
 	replicasetInformer     cache.SharedInformer
+	ownerInformers         map[schema.GroupVersionResource]cache.SharedInformer
+	serviceInformer        cache.SharedInformer
+	endpointSliceInformer  cache.SharedInformer
 	replicasetRegex        *regexp.Regexp
 	cronJobRegex           *regexp.Regexp
 	deleteQueue            []deleteRequest
 	stopCh                 chan struct{}
 	waitForMetadata        bool
 	waitForMetadataTimeout time.Duration
+	// shared is the metadata cache the informers are obtained from, if any.
+	shared k8smetadatacacheextension.InformerProvider
+	// handlers are the event handlers registered on the informers, removed when the client is stopped.
+	handlers    []handlerRegistration
+	handlersMut sync.Mutex
 
 	// A map containing Pod related data, used to associate them with resources.
 	// Key can be either an IP address or Pod UID
@@ -90,6 +106,33 @@ type WatchClient struct {
 	// Key is replicaset uid
 	ReplicaSets map[string]*ReplicaSet
 
+	// A map containing the objects owning Pods, or other owners of Pods, used to find the top-level owner of Pods.
+	// Key is the object uid
+	Owners map[string]*Owner
+	// ownerCounts holds the number of objects of each resource in Owners.
+	ownerCounts map[schema.GroupVersionResource]int
+
+	// A map containing Service related data, used to associate them with resources addressed to services.
+	// Key is the service namespace and name, in the namespace/name format
+	Services map[string]*Service
+
+	// A map containing EndpointSlice related data, used to associate resources with headless services
+	// and services with the pods they select.
+	// Key is endpoint slice uid
+	EndpointSlices map[string]*EndpointSlice
+
+	// serviceAddresses and endpointAddresses index the services and endpoint slices by IP address,
+	// serviceEndpointSlices indexes the endpoint slices by service key and podEndpointSlices by pod uid.
+	serviceAddresses      map[string][]*Service
+	endpointAddresses     map[string][]*EndpointSlice
+	serviceEndpointSlices map[string][]*EndpointSlice
+	podEndpointSlices     map[string][]*EndpointSlice
+
+	// serviceWorkloads holds the workload attributes shared by the pods selected by each service,
+	// updated as the endpoint slices and the pods change.
+	// Key is the service namespace and name, in the namespace/name format
+	serviceWorkloads map[string]map[string]string
+
 	telemetryBuilder *metadata.TelemetryBuilder
 }
 
@@ -104,9 +147,25 @@ var cronJobRegex = regexp.MustCompile(`^(.*)-[0-9]+$`)
 var errCannotRetrieveImage = errors.New("cannot retrieve image name")
 
 type InformersFactoryList struct {
-	newInformer           InformerProvider
-	newNamespaceInformer  InformerProviderNamespace
-	newReplicaSetInformer InformerProviderWorkload
+	newInformer              InformerProvider
+	newNamespaceInformer     InformerProviderNamespace
+	newReplicaSetInformer    InformerProviderWorkload
+	newOwnerInformer         InformerProviderOwner
+	newServiceInformer       InformerProviderWorkload
+	newEndpointSliceInformer InformerProviderWorkload
+	shared                   k8smetadatacacheextension.InformerProvider
+}
+
+// NewSharedInformersFactoryList returns an InformersFactoryList obtaining the informers from the
+// given metadata cache, which shares them with the other components using the same cache.
+func NewSharedInformersFactoryList(shared k8smetadatacacheextension.InformerProvider) InformersFactoryList {
+	return InformersFactoryList{shared: shared}
+}
+
+// handlerRegistration is an event handler registered on an informer.
+type handlerRegistration struct {
+	informer     cache.SharedInformer
+	registration cache.ResourceEventHandlerRegistration
 }
 
 // New initializes a new k8s Client.
@@ -138,6 +197,7 @@ func New(
 		telemetryBuilder:       telemetryBuilder,
 		waitForMetadata:        waitForMetadata,
 		waitForMetadataTimeout: waitForMetadataTimeout,
+		shared:                 informersFactory.shared,
 	}
 	go c.deleteLoop(time.Second*30, defaultPodDeleteGracePeriod)
 
@@ -146,15 +206,28 @@ func New(
 	c.Nodes = map[string]*Node{}
 	c.ReplicaSets = map[string]*ReplicaSet{}
 	c.Deployments = map[string]*Deployment{}
-	if newClientSet == nil {
-		newClientSet = k8sconfig.MakeClient
-	}
+	c.Owners = map[string]*Owner{}
+	c.ownerCounts = map[schema.GroupVersionResource]int{}
+	c.Services = map[string]*Service{}
+	c.EndpointSlices = map[string]*EndpointSlice{}
+	c.serviceAddresses = map[string][]*Service{}
+	c.endpointAddresses = map[string][]*EndpointSlice{}
+	c.serviceEndpointSlices = map[string][]*EndpointSlice{}
+	c.podEndpointSlices = map[string][]*EndpointSlice{}
+	c.serviceWorkloads = map[string]map[string]string{}
+	if c.shared != nil {
+		c.kc = c.shared.Client()
+	} else {
+		if newClientSet == nil {
+			newClientSet = k8sconfig.MakeClient
+		}
 
-	kc, err := newClientSet(apiCfg)
-	if err != nil {
-		return nil, err
+		kc, err := newClientSet(apiCfg)
+		if err != nil {
+			return nil, err
+		}
+		c.kc = kc
 	}
-	c.kc = kc
 
 	labelSelector, fieldSelector, err := selectorsFromFilters(c.Filters)
 	if err != nil {
@@ -184,8 +257,17 @@ func New(
 		}
 	}
 
-	c.informer = informersFactory.newInformer(c.kc, c.Filters.Namespace, labelSelector, fieldSelector)
-	err = c.informer.SetTransform(
+	c.informer, err = c.sharedInformer(
+		k8smetadatacacheextension.InformerKey{
+			Resource:      api_v1.SchemeGroupVersion.WithResource("pods"),
+			Namespace:     c.Filters.Namespace,
+			LabelSelector: labelSelector.String(),
+			FieldSelector: fieldSelector.String(),
+			Transform:     podTransform(c.Rules),
+		},
+		func() cache.SharedInformer {
+			return informersFactory.newInformer(c.kc, c.Filters.Namespace, labelSelector, fieldSelector)
+		},
 		func(object any) (any, error) {
 			originalPod, success := object.(*api_v1.Pod)
 			if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
@@ -199,14 +281,37 @@ func New(
 		return nil, err
 	}
 
-	c.namespaceInformer = informersFactory.newNamespaceInformer(c.kc)
+	newNamespaceInformer := func() cache.SharedInformer {
+		return informersFactory.newNamespaceInformer(c.kc)
+	}
+	namespaceKey := k8smetadatacacheextension.InformerKey{Resource: api_v1.SchemeGroupVersion.WithResource("namespaces")}
+	switch {
+	case c.extractNamespaceLabelsAnnotations():
+		c.namespaceInformer, err = c.sharedInformer(namespaceKey, newNamespaceInformer, nil)
+	case rules.ClusterUID:
+		namespaceKey.FieldSelector = fields.OneTermEqualSelector("metadata.name", kubeSystemNamespace).String()
+		c.namespaceInformer, err = c.sharedInformer(namespaceKey, newNamespaceInformer, nil)
+	default:
+		// the no-op informer watches nothing, so there is nothing to share
+		c.namespaceInformer = newNamespaceInformer()
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	if rules.DeploymentName || rules.DeploymentUID {
 		if informersFactory.newReplicaSetInformer == nil {
 			informersFactory.newReplicaSetInformer = newReplicaSetSharedInformer
 		}
-		c.replicasetInformer = informersFactory.newReplicaSetInformer(c.kc, c.Filters.Namespace)
-		err = c.replicasetInformer.SetTransform(
+		c.replicasetInformer, err = c.sharedInformer(
+			k8smetadatacacheextension.InformerKey{
+				Resource:  apps_v1.SchemeGroupVersion.WithResource("replicasets"),
+				Namespace: c.Filters.Namespace,
+				Transform: transformPrefix,
+			},
+			func() cache.SharedInformer {
+				return informersFactory.newReplicaSetInformer(c.kc, c.Filters.Namespace)
+			},
 			func(object any) (any, error) {
 				originalReplicaset, success := object.(*apps_v1.ReplicaSet)
 				if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
@@ -222,23 +327,148 @@ func New(
 	}
 
 	if c.extractNodeLabelsAnnotations() || c.extractNodeUID() {
-		c.nodeInformer = k8sconfig.NewNodeSharedInformer(c.kc, c.Filters.Node, 5*time.Minute)
+		nodeKey := k8smetadatacacheextension.InformerKey{Resource: api_v1.SchemeGroupVersion.WithResource("nodes")}
+		if c.Filters.Node != "" {
+			nodeKey.FieldSelector = fields.OneTermEqualSelector("metadata.name", c.Filters.Node).String()
+		}
+		c.nodeInformer, err = c.sharedInformer(nodeKey, func() cache.SharedInformer {
+			return k8sconfig.NewNodeSharedInformer(c.kc, c.Filters.Node, 5*time.Minute)
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if c.extractDeploymentLabelsAnnotations() {
-		c.deploymentInformer = newDeploymentSharedInformer(c.kc, c.Filters.Namespace)
+		c.deploymentInformer, err = c.sharedInformer(
+			k8smetadatacacheextension.InformerKey{Resource: apps_v1.SchemeGroupVersion.WithResource("deployments"), Namespace: c.Filters.Namespace},
+			func() cache.SharedInformer {
+				return newDeploymentSharedInformer(c.kc, c.Filters.Namespace)
+			},
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.IncludesOwnerChain() {
+		if err = c.createOwnerInformers(apiCfg, informersFactory.newOwnerInformer); err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.Services {
+		if err = c.createServiceInformers(informersFactory); err != nil {
+			return nil, err
+		}
 	}
 
 	return c, err
 }
 
+// createServiceInformers creates the informers watching the services and their endpoint slices.
+func (c *WatchClient) createServiceInformers(informersFactory InformersFactoryList) error {
+	if informersFactory.newServiceInformer == nil {
+		informersFactory.newServiceInformer = newServiceSharedInformer
+	}
+	if informersFactory.newEndpointSliceInformer == nil {
+		informersFactory.newEndpointSliceInformer = newEndpointSliceSharedInformer
+	}
+
+	var err error
+	c.serviceInformer, err = c.sharedInformer(
+		k8smetadatacacheextension.InformerKey{
+			Resource:  api_v1.SchemeGroupVersion.WithResource("services"),
+			Namespace: c.Filters.Namespace,
+			Transform: transformPrefix,
+		},
+		func() cache.SharedInformer {
+			return informersFactory.newServiceInformer(c.kc, c.Filters.Namespace)
+		},
+		func(object any) (any, error) {
+			originalService, success := object.(*api_v1.Service)
+			if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+				return object, nil
+			}
+
+			return removeUnnecessaryServiceData(originalService), nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	c.endpointSliceInformer, err = c.sharedInformer(
+		k8smetadatacacheextension.InformerKey{
+			Resource:  discovery_v1.SchemeGroupVersion.WithResource("endpointslices"),
+			Namespace: c.Filters.Namespace,
+			Transform: transformPrefix,
+		},
+		func() cache.SharedInformer {
+			return informersFactory.newEndpointSliceInformer(c.kc, c.Filters.Namespace)
+		},
+		func(object any) (any, error) {
+			originalEndpointSlice, success := object.(*discovery_v1.EndpointSlice)
+			if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+				return object, nil
+			}
+
+			return removeUnnecessaryEndpointSliceData(originalEndpointSlice), nil
+		},
+	)
+	return err
+}
+
+// createOwnerInformers creates a dynamic informer for each resource of the owner chain.
+func (c *WatchClient) createOwnerInformers(apiCfg k8sconfig.APIConfig, newOwnerInformer InformerProviderOwner) error {
+	var dc dynamic.Interface
+	if newOwnerInformer == nil {
+		if c.shared != nil {
+			dc = c.shared.DynamicClient()
+		} else {
+			var err error
+			if dc, err = k8sconfig.MakeDynamicClient(apiCfg); err != nil {
+				return err
+			}
+		}
+		newOwnerInformer = newOwnerSharedInformer
+	}
+	resources := c.Rules.OwnerChain.Resources
+	if len(resources) == 0 {
+		resources = DefaultOwnerResources
+	}
+	c.ownerInformers = map[schema.GroupVersionResource]cache.SharedInformer{}
+	for _, gvr := range resources {
+		informer, err := c.sharedInformer(
+			k8smetadatacacheextension.InformerKey{Resource: gvr, Namespace: c.Filters.Namespace, Transform: c.ownerTransform()},
+			func() cache.SharedInformer {
+				return newOwnerInformer(dc, gvr, c.Filters.Namespace)
+			},
+			func(object any) (any, error) {
+				originalObject, success := object.(*unstructured.Unstructured)
+				if !success { // means this is a cache.DeletedFinalStateUnknown, in which case we do nothing
+					return object, nil
+				}
+
+				return c.removeUnnecessaryOwnerData(originalObject), nil
+			},
+		)
+		if err != nil {
+			return err
+		}
+		c.ownerInformers[gvr] = informer
+	}
+	return nil
+}
+
 // Start registers pod event handlers and starts watching the kubernetes cluster for pod changes.
 func (c *WatchClient) Start() error {
 	synced := make([]cache.InformerSynced, 0)
 	// start the replicaSet informer first, as the replica sets need to be
 	// present at the time the pods are handled, to correctly establish the connection between pods and deployments
 	if c.Rules.DeploymentName || c.Rules.DeploymentUID {
-		reg, err := c.replicasetInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		reg, err := c.addEventHandler(c.replicasetInformer, cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleReplicaSetAdd,
 			UpdateFunc: c.handleReplicaSetUpdate,
 			DeleteFunc: c.handleReplicaSetDelete,
@@ -250,7 +480,7 @@ func (c *WatchClient) Start() error {
 		go c.replicasetInformer.Run(c.stopCh)
 	}
 
-	reg, err := c.namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	reg, err := c.addEventHandler(c.namespaceInformer, cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleNamespaceAdd,
 		UpdateFunc: c.handleNamespaceUpdate,
 		DeleteFunc: c.handleNamespaceDelete,
@@ -262,7 +492,7 @@ func (c *WatchClient) Start() error {
 	go c.namespaceInformer.Run(c.stopCh)
 
 	if c.nodeInformer != nil {
-		reg, err = c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		reg, err = c.addEventHandler(c.nodeInformer, cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleNodeAdd,
 			UpdateFunc: c.handleNodeUpdate,
 			DeleteFunc: c.handleNodeDelete,
@@ -275,7 +505,7 @@ func (c *WatchClient) Start() error {
 	}
 
 	if c.deploymentInformer != nil {
-		reg, err = c.deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		reg, err = c.addEventHandler(c.deploymentInformer, cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.handleDeploymentAdd,
 			UpdateFunc: c.handleDeploymentUpdate,
 			DeleteFunc: c.handleDeploymentDelete,
@@ -287,7 +517,47 @@ func (c *WatchClient) Start() error {
 		go c.deploymentInformer.Run(c.stopCh)
 	}
 
-	reg, err = c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+	for gvr, informer := range c.ownerInformers {
+		reg, err = c.addEventHandler(informer, c.ownerEventHandler(gvr))
+		if err != nil {
+			return err
+		}
+		synced = append(synced, reg.HasSynced)
+		go informer.Run(c.stopCh)
+	}
+
+	if c.serviceInformer != nil {
+		reg, err = c.addEventHandler(c.serviceInformer, cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleServiceAdd,
+			UpdateFunc: c.handleServiceUpdate,
+			DeleteFunc: c.handleServiceDelete,
+		})
+		if err != nil {
+			return err
+		}
+		synced = append(synced, reg.HasSynced)
+		go c.serviceInformer.Run(c.stopCh)
+	}
+
+	if c.endpointSliceInformer != nil {
+		reg, err = c.addEventHandler(c.endpointSliceInformer, cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleEndpointSliceAdd,
+			UpdateFunc: c.handleEndpointSliceUpdate,
+			DeleteFunc: c.handleEndpointSliceDelete,
+		})
+		if err != nil {
+			return err
+		}
+		synced = append(synced, reg.HasSynced)
+		go c.endpointSliceInformer.Run(c.stopCh)
+	}
+
+	if c.shared != nil {
+		// the shared pod informer may already be running, so its handler is only added once the
+		// other informers are synced, rather than the informer being run once they are
+		c.waitForDependencies(synced)
+	}
+	reg, err = c.addEventHandler(c.informer, cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handlePodAdd,
 		UpdateFunc: c.handlePodUpdate,
 		DeleteFunc: c.handlePodDelete,
@@ -318,6 +588,43 @@ func (c *WatchClient) Start() error {
 // Stop signals the k8s watcher/informer to stop watching for new events.
 func (c *WatchClient) Stop() {
 	close(c.stopCh)
+	// the informers obtained from a metadata cache keep running for the other components,
+	// their event handlers are removed for them to no longer update this client
+	c.handlersMut.Lock()
+	defer c.handlersMut.Unlock()
+	for _, h := range c.handlers {
+		if err := h.informer.RemoveEventHandler(h.registration); err != nil {
+			c.logger.Warn("failed to remove event handler", zap.Error(err))
+		}
+	}
+	c.handlers = nil
+}
+
+// sharedInformer returns the informer identified by the key from the metadata cache, when one is used,
+// or the informer created by newInformer otherwise. In both cases, the objects of the informer are
+// stripped by transform, if not nil, which key.Transform must identify.
+func (c *WatchClient) sharedInformer(key k8smetadatacacheextension.InformerKey, newInformer func() cache.SharedInformer, transform cache.TransformFunc) (cache.SharedInformer, error) {
+	if c.shared != nil {
+		return c.shared.Informer(key, newInformer, transform), nil
+	}
+	informer := newInformer()
+	if transform == nil {
+		return informer, nil
+	}
+	return informer, informer.SetTransform(transform)
+}
+
+// addEventHandler adds an event handler to the informer and records its registration, for the handler
+// to be removed when the client is stopped.
+func (c *WatchClient) addEventHandler(informer cache.SharedInformer, handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	reg, err := informer.AddEventHandler(handler)
+	if err != nil {
+		return nil, err
+	}
+	c.handlersMut.Lock()
+	c.handlers = append(c.handlers, handlerRegistration{informer: informer, registration: reg})
+	c.handlersMut.Unlock()
+	return reg, nil
 }
 
 func (c *WatchClient) handlePodAdd(obj any) {
@@ -477,6 +784,7 @@ func (c *WatchClient) deleteLoop(interval time.Duration, gracePeriod time.Durati
 					// and the underlying state (ip<>pod mapping) has not changed.
 					if p.Name == d.podName {
 						delete(c.Pods, d.id)
+						c.updatePodServiceWorkloads(p.PodUID)
 					}
 				}
 			}
@@ -698,6 +1006,27 @@ func copyLabel(pod *api_v1.Pod, tags map[string]string, labelKey string, key att
 	}
 }
 
+// podTransform identifies the parts of the Pods kept by removeUnnecessaryPodData for the extraction rules,
+// so that only the processors stripping the Pods the same way share their pod informer.
+func podTransform(rules ExtractionRules) string {
+	kept := []string{transformPrefix}
+	keep := func(part string, needed bool) {
+		if needed {
+			kept = append(kept, part)
+		}
+	}
+	keep("start_time", rules.StartTime)
+	keep("node", rules.Node)
+	keep("hostname", rules.PodHostName)
+	keep("containers", needContainerAttributes(rules))
+	keep("image_ids", rules.ContainerImageRepoDigests)
+	keep("images", rules.ContainerImageName || rules.ContainerImageTag || rules.ServiceVersion)
+	keep("labels", len(rules.Labels) > 0 || rules.ServiceName || rules.ServiceVersion)
+	keep("annotations", len(rules.Annotations) > 0)
+	keep("owners", rules.IncludesOwnerMetadata())
+	return strings.Join(kept, ",")
+}
+
 // This function removes all data from the Pod except what is required by extraction rules and pod association
 func removeUnnecessaryPodData(pod *api_v1.Pod, rules ExtractionRules) *api_v1.Pod {
 	// name, namespace, uid, start time and ip are needed for identifying Pods
@@ -956,6 +1285,10 @@ func (c *WatchClient) podFromAPI(pod *api_v1.Pod) *Pod {
 		}
 	}
 
+	if c.Rules.IncludesOwnerChain() {
+		newPod.Owner = controllerReference(pod.OwnerReferences)
+	}
+
 	if c.shouldIgnorePod(pod) {
 		newPod.Ignore = true
 	} else {
@@ -1091,6 +1424,7 @@ func (c *WatchClient) addOrUpdatePod(pod *api_v1.Pod) {
 		}
 		c.Pods[id] = newPod
 	}
+	c.updatePodServiceWorkloads(newPod.PodUID)
 }
 
 func (c *WatchClient) forgetPod(pod *api_v1.Pod) {
@@ -1365,6 +1699,12 @@ func (c *WatchClient) getReplicaSet(uid string) (*ReplicaSet, bool) {
 // before the informer is started. This is necessary e.g. for the pod informer which requires the replica set informer
 // to be finished to correctly establish the connection to the replicaset/deployment it belongs to.
 func (c *WatchClient) runInformerWithDependencies(informer cache.SharedInformer, dependencies []cache.InformerSynced) {
+	c.waitForDependencies(dependencies)
+	informer.Run(c.stopCh)
+}
+
+// waitForDependencies waits for the given informers to be synced, for at most 5 seconds.
+func (*WatchClient) waitForDependencies(dependencies []cache.InformerSynced) {
 	if len(dependencies) > 0 {
 		timeoutCh := make(chan struct{})
 		// TODO hard coding the timeout for now, check if we should make this configurable
@@ -1374,7 +1714,6 @@ func (c *WatchClient) runInformerWithDependencies(informer cache.SharedInformer,
 		defer t.Stop()
 		cache.WaitForCacheSync(timeoutCh, dependencies...)
 	}
-	informer.Run(c.stopCh)
 }
 
 // ignoreDeletedFinalStateUnknown returns the object wrapped in
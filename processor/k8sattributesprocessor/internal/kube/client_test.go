@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
 	"go.uber.org/zap"
@@ -22,10 +23,12 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 )
 
@@ -185,6 +188,155 @@ func TestClientStartStop(t *testing.T) {
 	assert.True(t, fctr.HasStopped())
 }
 
+// fakeMetadataCache is an InformerProvider recording the informers requested by the clients.
+type fakeMetadataCache struct {
+	component.StartFunc
+	component.ShutdownFunc
+	client    kubernetes.Interface
+	informers map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer
+}
+
+func (f *fakeMetadataCache) Client() kubernetes.Interface {
+	return f.client
+}
+
+func (*fakeMetadataCache) DynamicClient() dynamic.Interface {
+	return nil
+}
+
+func (f *fakeMetadataCache) Informer(key k8smetadatacacheextension.InformerKey, newInformer func() cache.SharedInformer, transform cache.TransformFunc) cache.SharedInformer {
+	if informer, ok := f.informers[key]; ok {
+		return informer
+	}
+	informer := &handlerCountingInformer{SharedInformer: newInformer(), transform: transform}
+	f.informers[key] = informer
+	return informer
+}
+
+// handlerCountingInformer counts the event handlers registered on an informer, and records its transform.
+type handlerCountingInformer struct {
+	cache.SharedInformer
+	handlers  int
+	transform cache.TransformFunc
+}
+
+func (i *handlerCountingInformer) AddEventHandler(handler cache.ResourceEventHandler) (cache.ResourceEventHandlerRegistration, error) {
+	i.handlers++
+	return i.SharedInformer.AddEventHandler(handler)
+}
+
+func (i *handlerCountingInformer) RemoveEventHandler(reg cache.ResourceEventHandlerRegistration) error {
+	i.handlers--
+	return i.SharedInformer.RemoveEventHandler(reg)
+}
+
+func TestClientSharedInformers(t *testing.T) {
+	shared := &fakeMetadataCache{
+		client:    fake.NewSimpleClientset(),
+		informers: map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer{},
+	}
+	factory := NewSharedInformersFactoryList(shared)
+	factory.newInformer = NewFakeInformer
+	factory.newNamespaceInformer = NewFakeNamespaceInformer
+	factory.newReplicaSetInformer = NewFakeReplicaSetInformer
+	rules := ExtractionRules{
+		DeploymentName: true,
+		Labels:         []FieldExtractionRule{{Name: "team", Key: "team", From: MetadataFromNamespace}},
+	}
+	filters := Filters{Namespace: "default", Labels: []LabelFilter{{Key: "app", Value: "checkout", Op: selection.Equals}}}
+
+	clients := make([]*WatchClient, 2)
+	for i := range clients {
+		c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, rules, filters, []Association{}, Excludes{}, nil, factory, false, 10*time.Second)
+		require.NoError(t, err)
+		clients[i] = c.(*WatchClient)
+		assert.Same(t, shared.client, clients[i].kc)
+	}
+	assert.Same(t, clients[0].informer, clients[1].informer)
+	assert.Same(t, clients[0].namespaceInformer, clients[1].namespaceInformer)
+	assert.Same(t, clients[0].replicasetInformer, clients[1].replicasetInformer)
+
+	podsKey := k8smetadatacacheextension.InformerKey{
+		Resource:      api_v1.SchemeGroupVersion.WithResource("pods"),
+		Namespace:     "default",
+		LabelSelector: "app=checkout",
+		Transform:     "k8sattributes,labels,owners",
+	}
+	namespacesKey := k8smetadatacacheextension.InformerKey{Resource: api_v1.SchemeGroupVersion.WithResource("namespaces")}
+	replicaSetsKey := k8smetadatacacheextension.InformerKey{
+		Resource:  apps_v1.SchemeGroupVersion.WithResource("replicasets"),
+		Namespace: "default",
+		Transform: "k8sattributes",
+	}
+	require.Len(t, shared.informers, 3)
+	require.Contains(t, shared.informers, podsKey)
+	require.Contains(t, shared.informers, namespacesKey)
+	require.Contains(t, shared.informers, replicaSetsKey)
+
+	// the objects are stripped by the transforms of the processor before the informers are started
+	require.NotNil(t, shared.informers[podsKey].transform)
+	transformed, err := shared.informers[podsKey].transform(&api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{Name: "pod-1", Annotations: map[string]string{"note": "value"}},
+		Spec:       api_v1.PodSpec{NodeName: "node-1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pod-1", transformed.(*api_v1.Pod).Name)
+	assert.Empty(t, transformed.(*api_v1.Pod).Annotations)
+	assert.Empty(t, transformed.(*api_v1.Pod).Spec.NodeName)
+	assert.NotNil(t, shared.informers[replicaSetsKey].transform)
+	assert.Nil(t, shared.informers[namespacesKey].transform)
+
+	// processors extracting other parts of the pods don't share their pod informer
+	rules.Node = true
+	_, err = New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, rules, filters, []Association{}, Excludes{}, nil, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	otherPodsKey := podsKey
+	otherPodsKey.Transform = "k8sattributes,node,labels,owners"
+	require.Len(t, shared.informers, 4)
+	require.Contains(t, shared.informers, otherPodsKey)
+	delete(shared.informers, otherPodsKey)
+
+	for _, c := range clients {
+		require.NoError(t, c.Start())
+	}
+	for _, informer := range shared.informers {
+		assert.Equal(t, 2, informer.handlers)
+	}
+
+	// stopping a client only removes its own event handlers from the shared informers
+	clients[0].Stop()
+	for _, informer := range shared.informers {
+		assert.Equal(t, 1, informer.handlers)
+	}
+	clients[1].Stop()
+	for _, informer := range shared.informers {
+		assert.Equal(t, 0, informer.handlers)
+	}
+}
+
+func TestClientSharedKubeSystemInformer(t *testing.T) {
+	shared := &fakeMetadataCache{
+		client:    fake.NewSimpleClientset(),
+		informers: map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer{},
+	}
+	factory := NewSharedInformersFactoryList(shared)
+	factory.newInformer = NewFakeInformer
+
+	_, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{ClusterUID: true}, Filters{}, []Association{}, Excludes{}, nil, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	assert.Contains(t, shared.informers, k8smetadatacacheextension.InformerKey{
+		Resource:      api_v1.SchemeGroupVersion.WithResource("namespaces"),
+		FieldSelector: "metadata.name=kube-system",
+	})
+
+	// the no-op namespace informer is not shared
+	shared.informers = map[k8smetadatacacheextension.InformerKey]*handlerCountingInformer{}
+	c, err := New(componenttest.NewNopTelemetrySettings(), k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, []Association{}, Excludes{}, nil, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	assert.Len(t, shared.informers, 1)
+	assert.IsType(t, &NoOpInformer{}, c.(*WatchClient).namespaceInformer)
+}
+
 func TestConstructorErrors(t *testing.T) {
 	er := ExtractionRules{}
 	ff := Filters{}
@@ -9,6 +9,8 @@ import (
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 )
@@ -118,6 +120,34 @@ func (f *FakeReplicaSetInformer) GetController() cache.Controller {
 	return f.FakeController
 }
 
+func NewFakeOwnerInformer(
+	_ dynamic.Interface,
+	_ schema.GroupVersionResource,
+	_ string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
+func NewFakeServiceInformer(
+	_ kubernetes.Interface,
+	_ string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
+func NewFakeEndpointSliceInformer(
+	_ kubernetes.Interface,
+	_ string,
+) cache.SharedInformer {
+	return &FakeInformer{
+		FakeController: &FakeController{},
+	}
+}
+
 type FakeController struct {
 	sync.Mutex
 	stopped bool
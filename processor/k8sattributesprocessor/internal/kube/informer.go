@@ -8,6 +8,7 @@ import (
 
 	apps_v1 "k8s.io/api/apps/v1"
 	api_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
@@ -181,3 +182,57 @@ func deploymentWatchFuncWithSelectors(client kubernetes.Interface, namespace str
 		return client.AppsV1().Deployments(namespace).Watch(context.Background(), opts)
 	}
 }
+
+func newServiceSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  serviceListFuncWithSelectors(client, namespace),
+			WatchFunc: serviceWatchFuncWithSelectors(client, namespace),
+		},
+		&api_v1.Service{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func serviceListFuncWithSelectors(client kubernetes.Interface, namespace string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.CoreV1().Services(namespace).List(context.Background(), opts)
+	}
+}
+
+func serviceWatchFuncWithSelectors(client kubernetes.Interface, namespace string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		return client.CoreV1().Services(namespace).Watch(context.Background(), opts)
+	}
+}
+
+func newEndpointSliceSharedInformer(
+	client kubernetes.Interface,
+	namespace string,
+) cache.SharedInformer {
+	informer := cache.NewSharedInformer(
+		&cache.ListWatch{
+			ListFunc:  endpointSliceListFuncWithSelectors(client, namespace),
+			WatchFunc: endpointSliceWatchFuncWithSelectors(client, namespace),
+		},
+		&discovery_v1.EndpointSlice{},
+		watchSyncPeriod,
+	)
+	return informer
+}
+
+func endpointSliceListFuncWithSelectors(client kubernetes.Interface, namespace string) cache.ListFunc {
+	return func(opts metav1.ListOptions) (runtime.Object, error) {
+		return client.DiscoveryV1().EndpointSlices(namespace).List(context.Background(), opts)
+	}
+}
+
+func endpointSliceWatchFuncWithSelectors(client kubernetes.Interface, namespace string) cache.WatchFunc {
+	return func(opts metav1.ListOptions) (watch.Interface, error) {
+		return client.DiscoveryV1().EndpointSlices(namespace).Watch(context.Background(), opts)
+	}
+}
@@ -40,6 +40,20 @@ func Test_newSharedDeploymentInformer(t *testing.T) {
 	assert.NotNil(t, informer)
 }
 
+func Test_newSharedServiceInformer(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	informer := newServiceSharedInformer(client, "ns")
+	assert.NotNil(t, informer)
+}
+
+func Test_newSharedEndpointSliceInformer(t *testing.T) {
+	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	informer := newEndpointSliceSharedInformer(client, "ns")
+	assert.NotNil(t, informer)
+}
+
 func Test_newKubeSystemSharedInformer(t *testing.T) {
 	client, err := newFakeAPIClientset(k8sconfig.APIConfig{})
 	require.NoError(t, err)
@@ -121,6 +135,26 @@ func Test_namespaceInformerWatchFunc(t *testing.T) {
 	assert.NotNil(t, obj)
 }
 
+func Test_serviceAndEndpointSliceListWatchFuncs(t *testing.T) {
+	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
+	require.NoError(t, err)
+	opts := metav1.ListOptions{}
+
+	obj, err := serviceListFuncWithSelectors(c, "test-ns")(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	w, err := serviceWatchFuncWithSelectors(c, "test-ns")(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, w)
+
+	obj, err = endpointSliceListFuncWithSelectors(c, "test-ns")(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, obj)
+	w, err = endpointSliceWatchFuncWithSelectors(c, "test-ns")(opts)
+	assert.NoError(t, err)
+	assert.NotNil(t, w)
+}
+
 func Test_fakeInformer(t *testing.T) {
 	// nothing real to test here. just to make coverage happy
 	c, err := newFakeAPIClientset(k8sconfig.APIConfig{})
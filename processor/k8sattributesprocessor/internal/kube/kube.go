@@ -31,6 +31,8 @@ const (
 	MetadataFromNode = "node"
 	// MetadataFromDeployment is used to specify to extract metadata/labels/annotations from deployment
 	MetadataFromDeployment = "deployment"
+	// MetadataFromOwner is used to specify to extract labels/annotations from the top-level owner of the pod
+	MetadataFromOwner      = "owner"
 	PodIdentifierMaxLength = 4
 
 	ResourceSource   = "resource_attribute"
@@ -94,6 +96,9 @@ type Client interface {
 	GetNamespace(string) (*Namespace, bool)
 	GetNode(string) (*Node, bool)
 	GetDeployment(string) (*Deployment, bool)
+	GetOwner(string) (*Owner, bool)
+	GetService(string, int32) (*Service, bool)
+	GetServiceWorkload(string, string) map[string]string
 	Start() error
 	Stop()
 }
@@ -118,6 +123,9 @@ type Pod struct {
 	DeploymentUID string
 	HostNetwork   bool
 
+	// Owner is the controller owner reference of the pod. It is only set when the owner chain is extracted.
+	Owner *OwnerReference
+
 	// Containers specifies all containers in this pod.
 	Containers PodContainers
 
@@ -248,6 +256,18 @@ type ExtractionRules struct {
 	ServiceName               bool
 	ServiceVersion            bool
 	ServiceInstanceID         bool
+	OwnerName                 bool
+	OwnerKind                 bool
+	OwnerUID                  bool
+	K8sServiceName            bool
+	K8sServiceUID             bool
+
+	// Services determines whether the services and their endpoint slices are watched, to associate
+	// resources with the services they are addressed to.
+	Services bool
+
+	// OwnerChain configures the resolution of the top-level owner of the pods.
+	OwnerChain OwnerChainRules
 
 	Annotations []FieldExtractionRule
 	Labels      []FieldExtractionRule
@@ -273,7 +293,20 @@ func (rules *ExtractionRules) IncludesOwnerMetadata() bool {
 			return true
 		}
 	}
-	return rules.ServiceName
+	return rules.ServiceName || rules.IncludesOwnerChain()
+}
+
+// IncludesOwnerChain determines whether the ExtractionRules include metadata about the top-level owner of Pods
+func (rules *ExtractionRules) IncludesOwnerChain() bool {
+	if rules.OwnerName || rules.OwnerKind || rules.OwnerUID {
+		return true
+	}
+	for _, r := range append(rules.Labels, rules.Annotations...) {
+		if r.From == MetadataFromOwner {
+			return true
+		}
+	}
+	return false
 }
 
 // FieldExtractionRule is used to specify which fields to extract from pod fields
@@ -295,6 +328,7 @@ type FieldExtractionRule struct {
 	//  - namespace
 	//  - node
 	//  - deployment
+	//  - owner
 	From string
 }
 
@@ -323,6 +357,12 @@ func (r *FieldExtractionRule) extractFromDeploymentMetadata(metadata map[string]
 	}
 }
 
+func (r *FieldExtractionRule) extractFromOwnerMetadata(metadata map[string]string, tags map[string]string, formatter string) {
+	if r.From == MetadataFromOwner {
+		r.extractFromMetadata(metadata, tags, formatter)
+	}
+}
+
 func (r *FieldExtractionRule) extractFromMetadata(metadata map[string]string, tags map[string]string, formatter string) {
 	if r.KeyRegex != nil {
 		for k, v := range metadata {
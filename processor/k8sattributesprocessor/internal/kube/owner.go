@@ -0,0 +1,246 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// K8sOwnerLabel and K8sOwnerAnnotation are the formats of the attributes extracted from the
+	// labels and annotations of the top-level owner of a pod.
+	K8sOwnerLabel      = "k8s.owner.label.%s"
+	K8sOwnerAnnotation = "k8s.owner.annotation.%s"
+
+	defaultOwnerChainMaxDepth = 10
+)
+
+// DefaultOwnerResources are the owner resources watched when the owner chain is extracted and
+// no resources are configured. They cover the controllers of the built-in workloads.
+var DefaultOwnerResources = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "replicasets"},
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "batch", Version: "v1", Resource: "jobs"},
+}
+
+// InformerProviderOwner defines a function type that returns a new SharedInformer watching the
+// objects of a resource that can own pods, or other owners of pods.
+type InformerProviderOwner func(
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	namespace string,
+) cache.SharedInformer
+
+// OwnerChainRules configures how the owner references of the pods are walked to find their
+// top-level owner.
+type OwnerChainRules struct {
+	// Resources are the resources watched to follow the owner references. An owner reference
+	// pointing to an object that isn't watched ends the chain.
+	Resources []schema.GroupVersionResource
+	// MaxDepth is the maximum number of owner references followed from a pod.
+	MaxDepth int
+	// MaxObjectsPerResource is the maximum number of objects of each resource kept in the owners
+	// table. Zero means no limit.
+	MaxObjectsPerResource int
+}
+
+// OwnerReference identifies the owner of a kubernetes object.
+type OwnerReference struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	UID        string
+}
+
+// Owner represents a kubernetes object owning pods, or other owners of pods.
+type Owner struct {
+	Name       string
+	Namespace  string
+	UID        string
+	Attributes map[string]string
+	// Controller is the controller owner reference of the object, if any.
+	Controller *OwnerReference
+
+	resource schema.GroupVersionResource
+}
+
+func newOwnerSharedInformer(
+	client dynamic.Interface,
+	gvr schema.GroupVersionResource,
+	namespace string,
+) cache.SharedInformer {
+	return dynamicinformer.NewFilteredDynamicInformer(client, gvr, namespace, watchSyncPeriod, cache.Indexers{}, nil).Informer()
+}
+
+// controllerReference returns the controller owner reference among the given references, or nil.
+func controllerReference(refs []meta_v1.OwnerReference) *OwnerReference {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			return &OwnerReference{
+				APIVersion: ref.APIVersion,
+				Kind:       ref.Kind,
+				Name:       ref.Name,
+				UID:        string(ref.UID),
+			}
+		}
+	}
+	return nil
+}
+
+// GetOwner takes the UID of an object owning a pod and returns the object, if it is watched.
+func (c *WatchClient) GetOwner(uid string) (*Owner, bool) {
+	c.m.RLock()
+	owner, ok := c.Owners[uid]
+	c.m.RUnlock()
+	if ok {
+		return owner, ok
+	}
+	return nil, false
+}
+
+// TopLevelOwner walks the owner references of a pod, starting from its controller, and returns the
+// reference to its top-level owner along with the owner object when it is watched.
+func TopLevelOwner(client Client, pod *Pod, maxDepth int) (*OwnerReference, *Owner) {
+	ref := pod.Owner
+	if ref == nil {
+		return nil, nil
+	}
+	if maxDepth <= 0 {
+		maxDepth = defaultOwnerChainMaxDepth
+	}
+	owner, _ := client.GetOwner(ref.UID)
+	for depth := 1; owner != nil && owner.Controller != nil && depth < maxDepth; depth++ {
+		ref = owner.Controller
+		owner, _ = client.GetOwner(ref.UID)
+	}
+	return ref, owner
+}
+
+func (c *WatchClient) ownerEventHandler(gvr schema.GroupVersionResource) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			c.handleOwnerAdd(gvr, obj)
+		},
+		UpdateFunc: func(_, newObj any) {
+			c.handleOwnerAdd(gvr, newObj)
+		},
+		DeleteFunc: func(obj any) {
+			c.handleOwnerDelete(obj)
+		},
+	}
+}
+
+func (c *WatchClient) handleOwnerAdd(gvr schema.GroupVersionResource, obj any) {
+	if object, ok := obj.(*unstructured.Unstructured); ok {
+		c.addOrUpdateOwner(gvr, object)
+	} else {
+		c.logger.Error("object received was not of type unstructured.Unstructured", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleOwnerDelete(obj any) {
+	if object, ok := ignoreDeletedFinalStateUnknown(obj).(*unstructured.Unstructured); ok {
+		c.m.Lock()
+		if owner, ok := c.Owners[string(object.GetUID())]; ok {
+			delete(c.Owners, owner.UID)
+			c.ownerCounts[owner.resource]--
+		}
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type unstructured.Unstructured", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateOwner(gvr schema.GroupVersionResource, object *unstructured.Unstructured) {
+	if object.GetUID() == "" {
+		return
+	}
+	newOwner := &Owner{
+		Name:       object.GetName(),
+		Namespace:  object.GetNamespace(),
+		UID:        string(object.GetUID()),
+		Controller: controllerReference(object.GetOwnerReferences()),
+		resource:   gvr,
+	}
+	newOwner.Attributes = c.extractOwnerAttributes(object)
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	if _, ok := c.Owners[newOwner.UID]; !ok {
+		limit := c.Rules.OwnerChain.MaxObjectsPerResource
+		if limit > 0 && c.ownerCounts[gvr] >= limit {
+			c.logger.Debug("owners table is full, ignoring object",
+				zap.String("resource", gvr.String()), zap.String("name", newOwner.Name), zap.Int("limit", limit))
+			return
+		}
+		c.ownerCounts[gvr]++
+	}
+	c.Owners[newOwner.UID] = newOwner
+}
+
+func (c *WatchClient) extractOwnerAttributes(object *unstructured.Unstructured) map[string]string {
+	tags := map[string]string{}
+
+	for _, r := range c.Rules.Labels {
+		r.extractFromOwnerMetadata(object.GetLabels(), tags, K8sOwnerLabel)
+	}
+
+	for _, r := range c.Rules.Annotations {
+		r.extractFromOwnerMetadata(object.GetAnnotations(), tags, K8sOwnerAnnotation)
+	}
+
+	return tags
+}
+
+func (c *WatchClient) extractOwnerLabelsAnnotations() (labels bool, annotations bool) {
+	for _, r := range c.Rules.Labels {
+		if r.From == MetadataFromOwner {
+			labels = true
+		}
+	}
+	for _, r := range c.Rules.Annotations {
+		if r.From == MetadataFromOwner {
+			annotations = true
+		}
+	}
+	return labels, annotations
+}
+
+// ownerTransform identifies the parts of the owner objects kept by removeUnnecessaryOwnerData, so that
+// only the processors stripping the owners the same way share their owner informers.
+func (c *WatchClient) ownerTransform() string {
+	labels, annotations := c.extractOwnerLabelsAnnotations()
+	return fmt.Sprintf("%s,labels=%t,annotations=%t", transformPrefix, labels, annotations)
+}
+
+// removeUnnecessaryOwnerData removes all data from an owner object except the metadata required
+// to walk the owner chain and by the extraction rules. This bounds the size of the informer caches,
+// which would otherwise hold the whole spec and status of arbitrary custom resources.
+func (c *WatchClient) removeUnnecessaryOwnerData(object *unstructured.Unstructured) *unstructured.Unstructured {
+	transformed := &unstructured.Unstructured{Object: map[string]any{}}
+	transformed.SetAPIVersion(object.GetAPIVersion())
+	transformed.SetKind(object.GetKind())
+	transformed.SetName(object.GetName())
+	transformed.SetNamespace(object.GetNamespace())
+	transformed.SetUID(object.GetUID())
+	transformed.SetResourceVersion(object.GetResourceVersion())
+	transformed.SetOwnerReferences(object.GetOwnerReferences())
+
+	labels, annotations := c.extractOwnerLabelsAnnotations()
+	if labels {
+		transformed.SetLabels(object.GetLabels())
+	}
+	if annotations {
+		transformed.SetAnnotations(object.GetAnnotations())
+	}
+	return transformed
+}
@@ -0,0 +1,354 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	api_v1 "k8s.io/api/core/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+var (
+	replicaSetsResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+	rolloutsResource    = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+)
+
+func newOwnerObject(apiVersion, kind, name, uid string, controller *meta_v1.OwnerReference) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]any{}}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetName(name)
+	object.SetNamespace("default")
+	object.SetUID(types.UID(uid))
+	if controller != nil {
+		object.SetOwnerReferences([]meta_v1.OwnerReference{*controller})
+	}
+	return object
+}
+
+func controllerOf(apiVersion, kind, name, uid string) *meta_v1.OwnerReference {
+	isController := true
+	return &meta_v1.OwnerReference{
+		APIVersion: apiVersion,
+		Kind:       kind,
+		Name:       name,
+		UID:        types.UID(uid),
+		Controller: &isController,
+	}
+}
+
+func TestOwnerHandler(t *testing.T) {
+	c, _ := newTestClient(t)
+	handler := c.ownerEventHandler(replicaSetsResource)
+	assert.Empty(t, c.Owners)
+
+	// objects without uid are ignored
+	handler.OnAdd(&unstructured.Unstructured{Object: map[string]any{}}, false)
+	assert.Empty(t, c.Owners)
+
+	replicaset := newOwnerObject("apps/v1", "ReplicaSet", "rollout-aaa", "rs-uid", controllerOf("argoproj.io/v1alpha1", "Rollout", "rollout", "rollout-uid"))
+	handler.OnAdd(replicaset, false)
+	require.Len(t, c.Owners, 1)
+	got, ok := c.GetOwner("rs-uid")
+	require.True(t, ok)
+	assert.Equal(t, "rollout-aaa", got.Name)
+	assert.Equal(t, "default", got.Namespace)
+	assert.Equal(t, &OwnerReference{
+		APIVersion: "argoproj.io/v1alpha1",
+		Kind:       "Rollout",
+		Name:       "rollout",
+		UID:        "rollout-uid",
+	}, got.Controller)
+	assert.Equal(t, 1, c.ownerCounts[replicaSetsResource])
+
+	updated := replicaset.DeepCopy()
+	updated.SetOwnerReferences(nil)
+	handler.OnUpdate(replicaset, updated)
+	require.Len(t, c.Owners, 1)
+	got, ok = c.GetOwner("rs-uid")
+	require.True(t, ok)
+	assert.Nil(t, got.Controller)
+	assert.Equal(t, 1, c.ownerCounts[replicaSetsResource])
+
+	handler.OnDelete(updated)
+	assert.Empty(t, c.Owners)
+	assert.Equal(t, 0, c.ownerCounts[replicaSetsResource])
+
+	// test delete when DeletedFinalStateUnknown
+	handler.OnAdd(replicaset, false)
+	require.Len(t, c.Owners, 1)
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Obj: replicaset})
+	assert.Empty(t, c.Owners)
+
+	_, ok = c.GetOwner("rs-uid")
+	assert.False(t, ok)
+}
+
+func TestOwnerHandlerWrongType(t *testing.T) {
+	c, logs := newTestClientWithRulesAndFilters(t, Filters{})
+	handler := c.ownerEventHandler(replicaSetsResource)
+	handler.OnAdd(1, false)
+	handler.OnDelete(1)
+	assert.Equal(t, 2, logs.Len())
+	for _, l := range logs.All() {
+		assert.Equal(t, "object received was not of type unstructured.Unstructured", l.Message)
+	}
+}
+
+func TestOwnerTableLimit(t *testing.T) {
+	c, _ := newTestClient(t)
+	c.Rules.OwnerChain.MaxObjectsPerResource = 2
+	replicaSets := c.ownerEventHandler(replicaSetsResource)
+	rollouts := c.ownerEventHandler(rolloutsResource)
+
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "a", "a", nil), false)
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "b", "b", nil), false)
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "c", "c", nil), false)
+	rollouts.OnAdd(newOwnerObject("argoproj.io/v1alpha1", "Rollout", "d", "d", nil), false)
+	assert.Len(t, c.Owners, 3)
+	_, ok := c.GetOwner("c")
+	assert.False(t, ok)
+
+	// updates of the objects in the table are applied
+	b := newOwnerObject("apps/v1", "ReplicaSet", "b-renamed", "b", nil)
+	replicaSets.OnUpdate(b, b)
+	got, ok := c.GetOwner("b")
+	require.True(t, ok)
+	assert.Equal(t, "b-renamed", got.Name)
+
+	// a deletion frees a slot of the table
+	replicaSets.OnDelete(newOwnerObject("apps/v1", "ReplicaSet", "a", "a", nil))
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "c", "c", nil), false)
+	_, ok = c.GetOwner("c")
+	assert.True(t, ok)
+}
+
+func TestOwnerExtractionRules(t *testing.T) {
+	c, _ := newTestClient(t)
+
+	object := newOwnerObject("argoproj.io/v1alpha1", "Rollout", "rollout", "rollout-uid", nil)
+	object.SetLabels(map[string]string{"label1": "lv1"})
+	object.SetAnnotations(map[string]string{"annotation1": "av1"})
+
+	testCases := []struct {
+		name       string
+		rules      ExtractionRules
+		attributes map[string]string
+	}{
+		{
+			name:       "no-rules",
+			rules:      ExtractionRules{},
+			attributes: nil,
+		},
+		{
+			name: "labels and annotations",
+			rules: ExtractionRules{
+				Annotations: []FieldExtractionRule{
+					{
+						Name: "a1",
+						Key:  "annotation1",
+						From: MetadataFromOwner,
+					},
+				},
+				Labels: []FieldExtractionRule{
+					{
+						Name: "l1",
+						Key:  "label1",
+						From: MetadataFromOwner,
+					},
+					{
+						Name: "pod-label",
+						Key:  "label1",
+						From: MetadataFromPod,
+					},
+				},
+			},
+			attributes: map[string]string{
+				"l1": "lv1",
+				"a1": "av1",
+			},
+		},
+		{
+			name: "all-labels-and-annotations",
+			rules: ExtractionRules{
+				Labels: []FieldExtractionRule{
+					{
+						KeyRegex: regexp.MustCompile("^(?:la.*)$"),
+						From:     MetadataFromOwner,
+					},
+				},
+				Annotations: []FieldExtractionRule{
+					{
+						KeyRegex: regexp.MustCompile("^(?:an.*)$"),
+						From:     MetadataFromOwner,
+					},
+				},
+			},
+			attributes: map[string]string{
+				"k8s.owner.label.label1":           "lv1",
+				"k8s.owner.annotation.annotation1": "av1",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.Rules = tc.rules
+			c.handleOwnerAdd(rolloutsResource, object)
+			owner, ok := c.GetOwner("rollout-uid")
+			require.True(t, ok)
+
+			assert.Len(t, owner.Attributes, len(tc.attributes))
+			for k, v := range tc.attributes {
+				got, ok := owner.Attributes[k]
+				assert.True(t, ok)
+				assert.Equal(t, v, got)
+			}
+		})
+	}
+}
+
+func TestTopLevelOwner(t *testing.T) {
+	c, _ := newTestClient(t)
+	replicaSets := c.ownerEventHandler(replicaSetsResource)
+	rollouts := c.ownerEventHandler(rolloutsResource)
+
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "rollout-aaa", "rs-uid", controllerOf("argoproj.io/v1alpha1", "Rollout", "rollout", "rollout-uid")), false)
+	rollouts.OnAdd(newOwnerObject("argoproj.io/v1alpha1", "Rollout", "rollout", "rollout-uid", nil), false)
+	// the ReplicaSet owned by an object that isn't watched
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "custom-aaa", "custom-rs-uid", controllerOf("example.com/v1", "Custom", "custom", "custom-uid")), false)
+	// a cycle of owner references
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "cycle-a", "cycle-a", controllerOf("apps/v1", "ReplicaSet", "cycle-b", "cycle-b")), false)
+	replicaSets.OnAdd(newOwnerObject("apps/v1", "ReplicaSet", "cycle-b", "cycle-b", controllerOf("apps/v1", "ReplicaSet", "cycle-a", "cycle-a")), false)
+
+	testCases := []struct {
+		name      string
+		owner     *OwnerReference
+		maxDepth  int
+		wantRef   *OwnerReference
+		wantFound bool
+	}{
+		{
+			name: "no owner",
+		},
+		{
+			name:      "chain of watched owners",
+			owner:     &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rollout-aaa", UID: "rs-uid"},
+			wantRef:   &OwnerReference{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "rollout", UID: "rollout-uid"},
+			wantFound: true,
+		},
+		{
+			name:      "max depth",
+			owner:     &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rollout-aaa", UID: "rs-uid"},
+			maxDepth:  1,
+			wantRef:   &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rollout-aaa", UID: "rs-uid"},
+			wantFound: true,
+		},
+		{
+			name:    "owner not watched",
+			owner:   &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "custom-aaa", UID: "custom-rs-uid"},
+			wantRef: &OwnerReference{APIVersion: "example.com/v1", Kind: "Custom", Name: "custom", UID: "custom-uid"},
+		},
+		{
+			name:      "cycle",
+			owner:     &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "cycle-a", UID: "cycle-a"},
+			wantRef:   &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "cycle-b", UID: "cycle-b"},
+			wantFound: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ref, owner := TopLevelOwner(c, &Pod{Owner: tc.owner}, tc.maxDepth)
+			assert.Equal(t, tc.wantRef, ref)
+			if tc.wantFound {
+				require.NotNil(t, owner)
+				assert.Equal(t, tc.wantRef.UID, owner.UID)
+			} else {
+				assert.Nil(t, owner)
+			}
+		})
+	}
+}
+
+func TestPodOwner(t *testing.T) {
+	c, _ := newTestClient(t)
+	pod := &api_v1.Pod{}
+	pod.Name = "rollout-aaa-bbb"
+	pod.UID = "pod-uid"
+	isNotController := false
+	pod.OwnerReferences = []meta_v1.OwnerReference{
+		{Kind: "Custom", Name: "not-controller", UID: "not-controller-uid", Controller: &isNotController},
+		*controllerOf("apps/v1", "ReplicaSet", "rollout-aaa", "rs-uid"),
+	}
+
+	assert.Nil(t, c.podFromAPI(pod).Owner)
+
+	c.Rules.OwnerKind = true
+	assert.Equal(t, &OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rollout-aaa", UID: "rs-uid"}, c.podFromAPI(pod).Owner)
+	assert.Equal(t, pod.OwnerReferences, removeUnnecessaryPodData(pod, c.Rules).OwnerReferences)
+}
+
+func TestRemoveUnnecessaryOwnerData(t *testing.T) {
+	c, _ := newTestClient(t)
+	object := newOwnerObject("argoproj.io/v1alpha1", "Rollout", "rollout", "rollout-uid", controllerOf("example.com/v1", "Custom", "custom", "custom-uid"))
+	object.SetLabels(map[string]string{"label1": "lv1"})
+	object.SetAnnotations(map[string]string{"annotation1": "av1"})
+	object.Object["spec"] = map[string]any{"replicas": int64(3)}
+
+	transformed := c.removeUnnecessaryOwnerData(object)
+	assert.NotContains(t, transformed.Object, "spec")
+	assert.Equal(t, "rollout", transformed.GetName())
+	assert.Equal(t, "default", transformed.GetNamespace())
+	assert.Equal(t, "Rollout", transformed.GetKind())
+	assert.Equal(t, object.GetOwnerReferences(), transformed.GetOwnerReferences())
+	assert.Empty(t, transformed.GetLabels())
+	assert.Empty(t, transformed.GetAnnotations())
+
+	c.Rules.Labels = []FieldExtractionRule{{Name: "l1", Key: "label1", From: MetadataFromOwner}}
+	transformed = c.removeUnnecessaryOwnerData(object)
+	assert.Equal(t, object.GetLabels(), transformed.GetLabels())
+	assert.Empty(t, transformed.GetAnnotations())
+}
+
+func TestOwnerInformers(t *testing.T) {
+	set := componenttest.NewNopTelemetrySettings()
+	factory := InformersFactoryList{
+		newInformer:          NewFakeInformer,
+		newNamespaceInformer: NewFakeNamespaceInformer,
+		newOwnerInformer:     NewFakeOwnerInformer,
+	}
+
+	c, err := New(set, k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, nil, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	assert.Empty(t, c.(*WatchClient).ownerInformers)
+
+	rules := ExtractionRules{OwnerName: true}
+	c, err = New(set, k8sconfig.APIConfig{}, rules, Filters{}, nil, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	assert.Len(t, c.(*WatchClient).ownerInformers, len(DefaultOwnerResources))
+
+	rules.OwnerChain.Resources = []schema.GroupVersionResource{rolloutsResource}
+	c, err = New(set, k8sconfig.APIConfig{}, rules, Filters{}, nil, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	wc := c.(*WatchClient)
+	require.Contains(t, wc.ownerInformers, rolloutsResource)
+	assert.Len(t, wc.ownerInformers, 1)
+
+	require.NoError(t, c.Start())
+	c.Stop()
+	assert.Eventually(t, func() bool {
+		return wc.ownerInformers[rolloutsResource].GetController().(*FakeController).HasStopped()
+	}, 10*time.Second, 10*time.Millisecond)
+}
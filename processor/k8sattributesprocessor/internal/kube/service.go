@@ -0,0 +1,363 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
+
+import (
+	"slices"
+
+	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"go.uber.org/zap"
+	api_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workloadAttributes are the pod attributes describing the workload the pod belongs to.
+var workloadAttributes = []string{
+	string(conventions.K8SDeploymentNameKey), string(conventions.K8SDeploymentUIDKey),
+	string(conventions.K8SReplicaSetNameKey), string(conventions.K8SReplicaSetUIDKey),
+	string(conventions.K8SStatefulSetNameKey), string(conventions.K8SStatefulSetUIDKey),
+	string(conventions.K8SDaemonSetNameKey), string(conventions.K8SDaemonSetUIDKey),
+	string(conventions.K8SJobNameKey), string(conventions.K8SJobUIDKey),
+	string(conventions.K8SCronJobNameKey),
+}
+
+// ServiceAssociation represents one rule to find the address, and optionally the port, a resource
+// is addressed to.
+type ServiceAssociation struct {
+	Address AssociationSource
+	// Port is the name of the resource attribute holding the port. It is optional.
+	Port string
+}
+
+// Service represents a kubernetes service.
+type Service struct {
+	Name      string
+	Namespace string
+	UID       string
+	// Headless is true for services without a cluster IP, whose endpoints are addressed directly.
+	Headless bool
+	// Addresses are the cluster IPs, external IPs and load balancer IPs of the service.
+	Addresses []string
+	// Ports are the ports exposed by the service.
+	Ports []int32
+}
+
+// EndpointSlice represents a kubernetes endpoint slice, a subset of the endpoints of a service.
+type EndpointSlice struct {
+	UID string
+	// Service is the key of the service the slice belongs to, in the namespace/name format.
+	Service string
+	// Ports are the ports exposed by the endpoints of the slice.
+	Ports     []int32
+	Endpoints []Endpoint
+}
+
+// Endpoint represents the addresses of one backend of a service, usually a pod.
+type Endpoint struct {
+	Addresses []string
+	// PodUID is the UID of the pod the endpoint refers to, if any.
+	PodUID string
+}
+
+func serviceKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// hasPort returns whether the port is one of the given ports. A zero port matches any ports.
+func hasPort(ports []int32, port int32) bool {
+	return port == 0 || slices.Contains(ports, port)
+}
+
+// GetService takes an IP address and an optional port and returns the service addressed by them.
+// The address is either an address of the service, or the address of an endpoint of a headless service.
+func (c *WatchClient) GetService(address string, port int32) (*Service, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	for _, service := range c.serviceAddresses[address] {
+		if hasPort(service.Ports, port) {
+			return service, true
+		}
+	}
+	for _, endpointSlice := range c.endpointAddresses[address] {
+		if service, ok := c.Services[endpointSlice.Service]; ok && service.Headless && hasPort(endpointSlice.Ports, port) {
+			return service, true
+		}
+	}
+	return nil, false
+}
+
+// GetServiceWorkload returns the workload attributes shared by all the known pods selected by a service.
+// The returned map must not be modified.
+func (c *WatchClient) GetServiceWorkload(namespace, name string) map[string]string {
+	c.m.RLock()
+	defer c.m.RUnlock()
+	return c.serviceWorkloads[serviceKey(namespace, name)]
+}
+
+// updateServiceWorkload computes the workload attributes shared by all the known pods selected by a
+// service. Attributes differing between the pods, e.g. when the service selects several deployments,
+// are left out. It must be called with the lock held.
+func (c *WatchClient) updateServiceWorkload(key string) {
+	var attrs map[string]string
+	for _, endpointSlice := range c.serviceEndpointSlices[key] {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.PodUID == "" {
+				continue
+			}
+			pod, ok := c.Pods[PodIdentifier{
+				PodIdentifierAttributeFromResourceAttribute(string(conventions.K8SPodUIDKey), endpoint.PodUID),
+			}]
+			if !ok || pod.Ignore {
+				continue
+			}
+			if attrs == nil {
+				attrs = map[string]string{}
+				for _, attr := range workloadAttributes {
+					if val, ok := pod.Attributes[attr]; ok {
+						attrs[attr] = val
+					}
+				}
+				continue
+			}
+			for attr, val := range attrs {
+				if pod.Attributes[attr] != val {
+					delete(attrs, attr)
+				}
+			}
+		}
+	}
+	if len(attrs) == 0 {
+		delete(c.serviceWorkloads, key)
+		return
+	}
+	// the map is replaced rather than updated, as it is returned to the callers of GetServiceWorkload
+	c.serviceWorkloads[key] = attrs
+}
+
+// updatePodServiceWorkloads updates the workload attributes of the services selecting a pod. It must be
+// called with the lock held.
+func (c *WatchClient) updatePodServiceWorkloads(podUID string) {
+	for _, endpointSlice := range c.podEndpointSlices[podUID] {
+		c.updateServiceWorkload(endpointSlice.Service)
+	}
+}
+
+func (c *WatchClient) handleServiceAdd(obj any) {
+	if service, ok := obj.(*api_v1.Service); ok {
+		c.addOrUpdateService(service)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleServiceUpdate(_, newService any) {
+	if service, ok := newService.(*api_v1.Service); ok {
+		c.addOrUpdateService(service)
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", newService))
+	}
+}
+
+func (c *WatchClient) handleServiceDelete(obj any) {
+	if service, ok := ignoreDeletedFinalStateUnknown(obj).(*api_v1.Service); ok {
+		c.m.Lock()
+		c.removeService(serviceKey(service.Namespace, service.Name))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type api_v1.Service", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateService(service *api_v1.Service) {
+	newService := &Service{
+		Name:      service.Name,
+		Namespace: service.Namespace,
+		UID:       string(service.UID),
+		Headless:  service.Spec.ClusterIP == api_v1.ClusterIPNone,
+	}
+	for _, ip := range service.Spec.ClusterIPs {
+		if ip != "" && ip != api_v1.ClusterIPNone {
+			newService.Addresses = append(newService.Addresses, ip)
+		}
+	}
+	newService.Addresses = append(newService.Addresses, service.Spec.ExternalIPs...)
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.IP != "" {
+			newService.Addresses = append(newService.Addresses, ingress.IP)
+		}
+	}
+	for _, port := range service.Spec.Ports {
+		newService.Ports = append(newService.Ports, port.Port)
+	}
+
+	key := serviceKey(newService.Namespace, newService.Name)
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.removeService(key)
+	c.Services[key] = newService
+	for _, address := range newService.Addresses {
+		c.serviceAddresses[address] = append(c.serviceAddresses[address], newService)
+	}
+}
+
+// removeService removes a service and its addresses. It must be called with the lock held.
+func (c *WatchClient) removeService(key string) {
+	service, ok := c.Services[key]
+	if !ok {
+		return
+	}
+	delete(c.Services, key)
+	for _, address := range service.Addresses {
+		c.serviceAddresses[address] = slices.DeleteFunc(c.serviceAddresses[address], func(s *Service) bool {
+			return s == service
+		})
+		if len(c.serviceAddresses[address]) == 0 {
+			delete(c.serviceAddresses, address)
+		}
+	}
+}
+
+func (c *WatchClient) handleEndpointSliceAdd(obj any) {
+	if endpointSlice, ok := obj.(*discovery_v1.EndpointSlice); ok {
+		c.addOrUpdateEndpointSlice(endpointSlice)
+	} else {
+		c.logger.Error("object received was not of type discovery_v1.EndpointSlice", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) handleEndpointSliceUpdate(_, newEndpointSlice any) {
+	if endpointSlice, ok := newEndpointSlice.(*discovery_v1.EndpointSlice); ok {
+		c.addOrUpdateEndpointSlice(endpointSlice)
+	} else {
+		c.logger.Error("object received was not of type discovery_v1.EndpointSlice", zap.Any("received", newEndpointSlice))
+	}
+}
+
+func (c *WatchClient) handleEndpointSliceDelete(obj any) {
+	if endpointSlice, ok := ignoreDeletedFinalStateUnknown(obj).(*discovery_v1.EndpointSlice); ok {
+		c.m.Lock()
+		c.removeEndpointSlice(string(endpointSlice.UID))
+		c.m.Unlock()
+	} else {
+		c.logger.Error("object received was not of type discovery_v1.EndpointSlice", zap.Any("received", obj))
+	}
+}
+
+func (c *WatchClient) addOrUpdateEndpointSlice(endpointSlice *discovery_v1.EndpointSlice) {
+	serviceName := endpointSlice.Labels[discovery_v1.LabelServiceName]
+	if serviceName == "" {
+		return
+	}
+	newEndpointSlice := &EndpointSlice{
+		UID:     string(endpointSlice.UID),
+		Service: serviceKey(endpointSlice.Namespace, serviceName),
+	}
+	for _, port := range endpointSlice.Ports {
+		if port.Port != nil {
+			newEndpointSlice.Ports = append(newEndpointSlice.Ports, *port.Port)
+		}
+	}
+	for _, endpoint := range endpointSlice.Endpoints {
+		newEndpoint := Endpoint{Addresses: endpoint.Addresses}
+		if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+			newEndpoint.PodUID = string(endpoint.TargetRef.UID)
+		}
+		newEndpointSlice.Endpoints = append(newEndpointSlice.Endpoints, newEndpoint)
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.removeEndpointSlice(newEndpointSlice.UID)
+	c.EndpointSlices[newEndpointSlice.UID] = newEndpointSlice
+	c.serviceEndpointSlices[newEndpointSlice.Service] = append(c.serviceEndpointSlices[newEndpointSlice.Service], newEndpointSlice)
+	for _, endpoint := range newEndpointSlice.Endpoints {
+		for _, address := range endpoint.Addresses {
+			c.endpointAddresses[address] = append(c.endpointAddresses[address], newEndpointSlice)
+		}
+		if endpoint.PodUID != "" {
+			c.podEndpointSlices[endpoint.PodUID] = append(c.podEndpointSlices[endpoint.PodUID], newEndpointSlice)
+		}
+	}
+	c.updateServiceWorkload(newEndpointSlice.Service)
+}
+
+// removeEndpointSlice removes an endpoint slice and its addresses. It must be called with the lock held.
+func (c *WatchClient) removeEndpointSlice(uid string) {
+	endpointSlice, ok := c.EndpointSlices[uid]
+	if !ok {
+		return
+	}
+	isSlice := func(s *EndpointSlice) bool {
+		return s == endpointSlice
+	}
+	delete(c.EndpointSlices, uid)
+	c.serviceEndpointSlices[endpointSlice.Service] = slices.DeleteFunc(c.serviceEndpointSlices[endpointSlice.Service], isSlice)
+	if len(c.serviceEndpointSlices[endpointSlice.Service]) == 0 {
+		delete(c.serviceEndpointSlices, endpointSlice.Service)
+	}
+	for _, endpoint := range endpointSlice.Endpoints {
+		for _, address := range endpoint.Addresses {
+			c.endpointAddresses[address] = slices.DeleteFunc(c.endpointAddresses[address], isSlice)
+			if len(c.endpointAddresses[address]) == 0 {
+				delete(c.endpointAddresses, address)
+			}
+		}
+		if endpoint.PodUID != "" {
+			c.podEndpointSlices[endpoint.PodUID] = slices.DeleteFunc(c.podEndpointSlices[endpoint.PodUID], isSlice)
+			if len(c.podEndpointSlices[endpoint.PodUID]) == 0 {
+				delete(c.podEndpointSlices, endpoint.PodUID)
+			}
+		}
+	}
+	c.updateServiceWorkload(endpointSlice.Service)
+}
+
+// This function removes all data from the Service except what is required by the service association.
+func removeUnnecessaryServiceData(service *api_v1.Service) *api_v1.Service {
+	transformedService := api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      service.GetName(),
+			Namespace: service.GetNamespace(),
+			UID:       service.GetUID(),
+		},
+		Spec: api_v1.ServiceSpec{
+			ClusterIP:   service.Spec.ClusterIP,
+			ClusterIPs:  service.Spec.ClusterIPs,
+			ExternalIPs: service.Spec.ExternalIPs,
+		},
+	}
+	for _, port := range service.Spec.Ports {
+		transformedService.Spec.Ports = append(transformedService.Spec.Ports, api_v1.ServicePort{Port: port.Port})
+	}
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		transformedService.Status.LoadBalancer.Ingress = append(transformedService.Status.LoadBalancer.Ingress, api_v1.LoadBalancerIngress{IP: ingress.IP})
+	}
+	return &transformedService
+}
+
+// This function removes all data from the EndpointSlice except what is required by the service association.
+func removeUnnecessaryEndpointSliceData(endpointSlice *discovery_v1.EndpointSlice) *discovery_v1.EndpointSlice {
+	transformedEndpointSlice := discovery_v1.EndpointSlice{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      endpointSlice.GetName(),
+			Namespace: endpointSlice.GetNamespace(),
+			UID:       endpointSlice.GetUID(),
+		},
+	}
+	if serviceName, ok := endpointSlice.Labels[discovery_v1.LabelServiceName]; ok {
+		transformedEndpointSlice.Labels = map[string]string{discovery_v1.LabelServiceName: serviceName}
+	}
+	for _, port := range endpointSlice.Ports {
+		transformedEndpointSlice.Ports = append(transformedEndpointSlice.Ports, discovery_v1.EndpointPort{Port: port.Port})
+	}
+	for _, endpoint := range endpointSlice.Endpoints {
+		transformedEndpoint := discovery_v1.Endpoint{Addresses: endpoint.Addresses}
+		if endpoint.TargetRef != nil {
+			transformedEndpoint.TargetRef = &api_v1.ObjectReference{Kind: endpoint.TargetRef.Kind, UID: endpoint.TargetRef.UID}
+		}
+		transformedEndpointSlice.Endpoints = append(transformedEndpointSlice.Endpoints, transformedEndpoint)
+	}
+	return &transformedEndpointSlice
+}
@@ -0,0 +1,302 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	api_v1 "k8s.io/api/core/v1"
+	discovery_v1 "k8s.io/api/discovery/v1"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
+)
+
+func newService(name, clusterIP string, ports ...int32) *api_v1.Service {
+	service := &api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name + "-uid"),
+		},
+		Spec: api_v1.ServiceSpec{
+			ClusterIP:  clusterIP,
+			ClusterIPs: []string{clusterIP},
+		},
+	}
+	for _, port := range ports {
+		service.Spec.Ports = append(service.Spec.Ports, api_v1.ServicePort{Port: port})
+	}
+	return service
+}
+
+func newEndpointSlice(name, service string, ports []int32, podUIDs map[string]string) *discovery_v1.EndpointSlice {
+	endpointSlice := &discovery_v1.EndpointSlice{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name + "-uid"),
+			Labels:    map[string]string{discovery_v1.LabelServiceName: service},
+		},
+	}
+	for _, port := range ports {
+		endpointSlice.Ports = append(endpointSlice.Ports, discovery_v1.EndpointPort{Port: &port})
+	}
+	for address, uid := range podUIDs {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discovery_v1.Endpoint{
+			Addresses: []string{address},
+			TargetRef: &api_v1.ObjectReference{Kind: "Pod", UID: types.UID(uid)},
+		})
+	}
+	return endpointSlice
+}
+
+func TestServiceHandler(t *testing.T) {
+	c, _ := newTestClient(t)
+	assert.Empty(t, c.Services)
+
+	service := newService("checkout", "10.96.0.10", 80, 443)
+	service.Spec.ExternalIPs = []string{"203.0.113.10"}
+	service.Status.LoadBalancer.Ingress = []api_v1.LoadBalancerIngress{{IP: "198.51.100.10"}}
+	c.handleServiceAdd(service)
+	require.Len(t, c.Services, 1)
+
+	for _, address := range []string{"10.96.0.10", "203.0.113.10", "198.51.100.10"} {
+		got, ok := c.GetService(address, 0)
+		require.True(t, ok, address)
+		assert.Equal(t, "checkout", got.Name)
+		assert.Equal(t, "default", got.Namespace)
+		assert.Equal(t, "checkout-uid", got.UID)
+		assert.False(t, got.Headless)
+	}
+	_, ok := c.GetService("10.96.0.10", 443)
+	assert.True(t, ok)
+	_, ok = c.GetService("10.96.0.10", 8080)
+	assert.False(t, ok)
+
+	// the addresses of the previous version of the service are forgotten
+	updated := service.DeepCopy()
+	updated.Spec.ExternalIPs = nil
+	c.handleServiceUpdate(service, updated)
+	require.Len(t, c.Services, 1)
+	_, ok = c.GetService("203.0.113.10", 0)
+	assert.False(t, ok)
+	_, ok = c.GetService("10.96.0.10", 0)
+	assert.True(t, ok)
+
+	c.handleServiceDelete(cache.DeletedFinalStateUnknown{Obj: updated})
+	assert.Empty(t, c.Services)
+	assert.Empty(t, c.serviceAddresses)
+}
+
+func TestServiceSharedAddress(t *testing.T) {
+	c, _ := newTestClient(t)
+	web := newService("web", "", 80)
+	web.Spec.ClusterIPs = nil
+	web.Spec.ExternalIPs = []string{"203.0.113.10"}
+	admin := newService("admin", "", 8443)
+	admin.Spec.ClusterIPs = nil
+	admin.Spec.ExternalIPs = []string{"203.0.113.10"}
+	c.handleServiceAdd(web)
+	c.handleServiceAdd(admin)
+
+	got, ok := c.GetService("203.0.113.10", 8443)
+	require.True(t, ok)
+	assert.Equal(t, "admin", got.Name)
+	got, ok = c.GetService("203.0.113.10", 80)
+	require.True(t, ok)
+	assert.Equal(t, "web", got.Name)
+}
+
+func TestHeadlessService(t *testing.T) {
+	c, _ := newTestClient(t)
+	c.handleServiceAdd(newService("kafka", api_v1.ClusterIPNone, 9092))
+	c.handleServiceAdd(newService("kafka-metrics", api_v1.ClusterIPNone, 9404))
+	c.handleServiceAdd(newService("kafka-bootstrap", "10.96.0.20", 9092))
+
+	pods := map[string]string{"10.0.0.1": "pod-1", "10.0.0.2": "pod-2"}
+	c.handleEndpointSliceAdd(newEndpointSlice("kafka-abc", "kafka", []int32{9092}, pods))
+	c.handleEndpointSliceAdd(newEndpointSlice("kafka-metrics-abc", "kafka-metrics", []int32{9404}, pods))
+	c.handleEndpointSliceAdd(newEndpointSlice("kafka-bootstrap-abc", "kafka-bootstrap", []int32{9092}, pods))
+
+	got, ok := c.GetService("10.0.0.1", 9092)
+	require.True(t, ok)
+	assert.Equal(t, "kafka", got.Name)
+	assert.True(t, got.Headless)
+	got, ok = c.GetService("10.0.0.2", 9404)
+	require.True(t, ok)
+	assert.Equal(t, "kafka-metrics", got.Name)
+	_, ok = c.GetService("10.0.0.1", 8080)
+	assert.False(t, ok)
+
+	require.Len(t, c.serviceEndpointSlices["default/kafka"], 1)
+	endpoints := c.serviceEndpointSlices["default/kafka"][0].Endpoints
+	require.Len(t, endpoints, 2)
+	assert.ElementsMatch(t, []string{"pod-1", "pod-2"}, []string{endpoints[0].PodUID, endpoints[1].PodUID})
+
+	c.handleEndpointSliceDelete(newEndpointSlice("kafka-abc", "kafka", nil, nil))
+	assert.Empty(t, c.serviceEndpointSlices["default/kafka"])
+	got, ok = c.GetService("10.0.0.1", 0)
+	require.True(t, ok)
+	assert.Equal(t, "kafka-metrics", got.Name)
+
+	c.handleEndpointSliceDelete(newEndpointSlice("kafka-metrics-abc", "kafka-metrics", nil, nil))
+	c.handleEndpointSliceDelete(newEndpointSlice("kafka-bootstrap-abc", "kafka-bootstrap", nil, nil))
+	assert.Empty(t, c.EndpointSlices)
+	assert.Empty(t, c.endpointAddresses)
+	assert.Empty(t, c.serviceEndpointSlices)
+}
+
+func newReplicaSetPod(uid, replicaset string) *api_v1.Pod {
+	return &api_v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      uid,
+			Namespace: "default",
+			UID:       types.UID(uid),
+			OwnerReferences: []meta_v1.OwnerReference{
+				{Kind: "ReplicaSet", Name: replicaset, UID: types.UID(replicaset + "-uid")},
+			},
+		},
+	}
+}
+
+func TestServiceWorkload(t *testing.T) {
+	c, _ := newTestClient(t)
+	c.Rules = ExtractionRules{ReplicaSetName: true, ReplicaSetID: true}
+
+	// the pods aren't known yet
+	pods := map[string]string{"10.0.0.1": "pod-1", "10.0.0.2": "pod-2"}
+	c.handleEndpointSliceAdd(newEndpointSlice("checkout-abc", "checkout", []int32{8080}, pods))
+	assert.Nil(t, c.GetServiceWorkload("default", "checkout"))
+
+	c.handlePodAdd(newReplicaSetPod("pod-1", "checkout-7d9c8"))
+	c.handlePodAdd(newReplicaSetPod("pod-2", "checkout-7d9c8"))
+	assert.Equal(t, map[string]string{
+		"k8s.replicaset.name": "checkout-7d9c8",
+		"k8s.replicaset.uid":  "checkout-7d9c8-uid",
+	}, c.GetServiceWorkload("default", "checkout"))
+
+	// the attributes differing between the pods are left out
+	c.handlePodUpdate(nil, newReplicaSetPod("pod-2", "checkout-5f6b7"))
+	assert.Nil(t, c.GetServiceWorkload("default", "checkout"))
+
+	// the pods no longer selected by the service are ignored
+	updated := newEndpointSlice("checkout-abc", "checkout", []int32{8080}, map[string]string{"10.0.0.2": "pod-2"})
+	c.handleEndpointSliceUpdate(nil, updated)
+	assert.Equal(t, map[string]string{
+		"k8s.replicaset.name": "checkout-5f6b7",
+		"k8s.replicaset.uid":  "checkout-5f6b7-uid",
+	}, c.GetServiceWorkload("default", "checkout"))
+	assert.NotContains(t, c.podEndpointSlices, "pod-1")
+
+	c.handleEndpointSliceDelete(updated)
+	assert.Nil(t, c.GetServiceWorkload("default", "checkout"))
+	assert.Empty(t, c.serviceWorkloads)
+	assert.Empty(t, c.podEndpointSlices)
+}
+
+func TestEndpointSliceWithoutService(t *testing.T) {
+	c, _ := newTestClient(t)
+	endpointSlice := newEndpointSlice("orphan", "", nil, map[string]string{"10.0.0.1": "pod-1"})
+	endpointSlice.Labels = nil
+	c.handleEndpointSliceAdd(endpointSlice)
+	assert.Empty(t, c.EndpointSlices)
+}
+
+func TestServiceHandlersWrongType(t *testing.T) {
+	c, logs := newTestClientWithRulesAndFilters(t, Filters{})
+	c.handleServiceAdd(1)
+	c.handleServiceUpdate(nil, 1)
+	c.handleServiceDelete(1)
+	c.handleEndpointSliceAdd(1)
+	c.handleEndpointSliceUpdate(nil, 1)
+	c.handleEndpointSliceDelete(1)
+	require.Equal(t, 6, logs.Len())
+	for i, l := range logs.All() {
+		if i < 3 {
+			assert.Equal(t, "object received was not of type api_v1.Service", l.Message)
+		} else {
+			assert.Equal(t, "object received was not of type discovery_v1.EndpointSlice", l.Message)
+		}
+	}
+}
+
+func TestRemoveUnnecessaryServiceData(t *testing.T) {
+	service := newService("checkout", "10.96.0.10", 80)
+	service.Labels = map[string]string{"app": "checkout"}
+	service.Spec.Selector = map[string]string{"app": "checkout"}
+	service.Spec.Ports[0].Name = "http"
+
+	transformed := removeUnnecessaryServiceData(service)
+	assert.Equal(t, &api_v1.Service{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "checkout",
+			Namespace: "default",
+			UID:       "checkout-uid",
+		},
+		Spec: api_v1.ServiceSpec{
+			ClusterIP:  "10.96.0.10",
+			ClusterIPs: []string{"10.96.0.10"},
+			Ports:      []api_v1.ServicePort{{Port: 80}},
+		},
+	}, transformed)
+}
+
+func TestRemoveUnnecessaryEndpointSliceData(t *testing.T) {
+	endpointSlice := newEndpointSlice("checkout-abc", "checkout", []int32{80}, map[string]string{"10.0.0.1": "pod-1"})
+	endpointSlice.Labels["endpointslice.kubernetes.io/managed-by"] = "endpointslice-controller.k8s.io"
+	endpointSlice.Endpoints[0].TargetRef.Name = "checkout-7d9c8-x2b4l"
+	nodeName := "node-1"
+	endpointSlice.Endpoints[0].NodeName = &nodeName
+
+	transformed := removeUnnecessaryEndpointSliceData(endpointSlice)
+	port := int32(80)
+	assert.Equal(t, &discovery_v1.EndpointSlice{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:      "checkout-abc",
+			Namespace: "default",
+			UID:       "checkout-abc-uid",
+			Labels:    map[string]string{discovery_v1.LabelServiceName: "checkout"},
+		},
+		Ports: []discovery_v1.EndpointPort{{Port: &port}},
+		Endpoints: []discovery_v1.Endpoint{{
+			Addresses: []string{"10.0.0.1"},
+			TargetRef: &api_v1.ObjectReference{Kind: "Pod", UID: "pod-1"},
+		}},
+	}, transformed)
+}
+
+func TestServiceInformers(t *testing.T) {
+	set := componenttest.NewNopTelemetrySettings()
+	factory := InformersFactoryList{
+		newInformer:              NewFakeInformer,
+		newNamespaceInformer:     NewFakeNamespaceInformer,
+		newServiceInformer:       NewFakeServiceInformer,
+		newEndpointSliceInformer: NewFakeEndpointSliceInformer,
+	}
+
+	c, err := New(set, k8sconfig.APIConfig{}, ExtractionRules{}, Filters{}, nil, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	assert.Nil(t, c.(*WatchClient).serviceInformer)
+	assert.Nil(t, c.(*WatchClient).endpointSliceInformer)
+
+	c, err = New(set, k8sconfig.APIConfig{}, ExtractionRules{Services: true}, Filters{}, nil, Excludes{}, newFakeAPIClientset, factory, false, 10*time.Second)
+	require.NoError(t, err)
+	wc := c.(*WatchClient)
+	require.NotNil(t, wc.serviceInformer)
+	require.NotNil(t, wc.endpointSliceInformer)
+
+	require.NoError(t, c.Start())
+	c.Stop()
+	assert.Eventually(t, func() bool {
+		return wc.serviceInformer.GetController().(*FakeController).HasStopped() &&
+			wc.endpointSliceInformer.GetController().(*FakeController).HasStopped()
+	}, 10*time.Second, 10*time.Millisecond)
+}
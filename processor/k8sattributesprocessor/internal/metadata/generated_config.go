@@ -43,6 +43,9 @@ type ResourceAttributesConfig struct {
 	K8sNamespaceName          ResourceAttributeConfig `mapstructure:"k8s.namespace.name"`
 	K8sNodeName               ResourceAttributeConfig `mapstructure:"k8s.node.name"`
 	K8sNodeUID                ResourceAttributeConfig `mapstructure:"k8s.node.uid"`
+	K8sOwnerKind              ResourceAttributeConfig `mapstructure:"k8s.owner.kind"`
+	K8sOwnerName              ResourceAttributeConfig `mapstructure:"k8s.owner.name"`
+	K8sOwnerUID               ResourceAttributeConfig `mapstructure:"k8s.owner.uid"`
 	K8sPodHostname            ResourceAttributeConfig `mapstructure:"k8s.pod.hostname"`
 	K8sPodIP                  ResourceAttributeConfig `mapstructure:"k8s.pod.ip"`
 	K8sPodName                ResourceAttributeConfig `mapstructure:"k8s.pod.name"`
@@ -50,6 +53,8 @@ type ResourceAttributesConfig struct {
 	K8sPodUID                 ResourceAttributeConfig `mapstructure:"k8s.pod.uid"`
 	K8sReplicasetName         ResourceAttributeConfig `mapstructure:"k8s.replicaset.name"`
 	K8sReplicasetUID          ResourceAttributeConfig `mapstructure:"k8s.replicaset.uid"`
+	K8sServiceName            ResourceAttributeConfig `mapstructure:"k8s.service.name"`
+	K8sServiceUID             ResourceAttributeConfig `mapstructure:"k8s.service.uid"`
 	K8sStatefulsetName        ResourceAttributeConfig `mapstructure:"k8s.statefulset.name"`
 	K8sStatefulsetUID         ResourceAttributeConfig `mapstructure:"k8s.statefulset.uid"`
 	ServiceInstanceID         ResourceAttributeConfig `mapstructure:"service.instance.id"`
@@ -108,6 +113,15 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sNodeUID: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		K8sOwnerKind: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sOwnerName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sOwnerUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sPodHostname: ResourceAttributeConfig{
 			Enabled: false,
 		},
@@ -129,6 +143,12 @@ func DefaultResourceAttributesConfig() ResourceAttributesConfig {
 		K8sReplicasetUID: ResourceAttributeConfig{
 			Enabled: false,
 		},
+		K8sServiceName: ResourceAttributeConfig{
+			Enabled: false,
+		},
+		K8sServiceUID: ResourceAttributeConfig{
+			Enabled: false,
+		},
 		K8sStatefulsetName: ResourceAttributeConfig{
 			Enabled: false,
 		},
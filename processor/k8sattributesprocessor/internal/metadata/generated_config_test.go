@@ -41,6 +41,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceName:          ResourceAttributeConfig{Enabled: true},
 				K8sNodeName:               ResourceAttributeConfig{Enabled: true},
 				K8sNodeUID:                ResourceAttributeConfig{Enabled: true},
+				K8sOwnerKind:              ResourceAttributeConfig{Enabled: true},
+				K8sOwnerName:              ResourceAttributeConfig{Enabled: true},
+				K8sOwnerUID:               ResourceAttributeConfig{Enabled: true},
 				K8sPodHostname:            ResourceAttributeConfig{Enabled: true},
 				K8sPodIP:                  ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                ResourceAttributeConfig{Enabled: true},
@@ -48,6 +51,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPodUID:                 ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetName:         ResourceAttributeConfig{Enabled: true},
 				K8sReplicasetUID:          ResourceAttributeConfig{Enabled: true},
+				K8sServiceName:            ResourceAttributeConfig{Enabled: true},
+				K8sServiceUID:             ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetName:        ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetUID:         ResourceAttributeConfig{Enabled: true},
 				ServiceInstanceID:         ResourceAttributeConfig{Enabled: true},
@@ -75,6 +80,9 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceName:          ResourceAttributeConfig{Enabled: false},
 				K8sNodeName:               ResourceAttributeConfig{Enabled: false},
 				K8sNodeUID:                ResourceAttributeConfig{Enabled: false},
+				K8sOwnerKind:              ResourceAttributeConfig{Enabled: false},
+				K8sOwnerName:              ResourceAttributeConfig{Enabled: false},
+				K8sOwnerUID:               ResourceAttributeConfig{Enabled: false},
 				K8sPodHostname:            ResourceAttributeConfig{Enabled: false},
 				K8sPodIP:                  ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                ResourceAttributeConfig{Enabled: false},
@@ -82,6 +90,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sPodUID:                 ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetName:         ResourceAttributeConfig{Enabled: false},
 				K8sReplicasetUID:          ResourceAttributeConfig{Enabled: false},
+				K8sServiceName:            ResourceAttributeConfig{Enabled: false},
+				K8sServiceUID:             ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetName:        ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetUID:         ResourceAttributeConfig{Enabled: false},
 				ServiceInstanceID:         ResourceAttributeConfig{Enabled: false},
@@ -133,6 +133,27 @@ func (rb *ResourceBuilder) SetK8sNodeUID(val string) {
 	}
 }
 
+// SetK8sOwnerKind sets provided value as "k8s.owner.kind" attribute.
+func (rb *ResourceBuilder) SetK8sOwnerKind(val string) {
+	if rb.config.K8sOwnerKind.Enabled {
+		rb.res.Attributes().PutStr("k8s.owner.kind", val)
+	}
+}
+
+// SetK8sOwnerName sets provided value as "k8s.owner.name" attribute.
+func (rb *ResourceBuilder) SetK8sOwnerName(val string) {
+	if rb.config.K8sOwnerName.Enabled {
+		rb.res.Attributes().PutStr("k8s.owner.name", val)
+	}
+}
+
+// SetK8sOwnerUID sets provided value as "k8s.owner.uid" attribute.
+func (rb *ResourceBuilder) SetK8sOwnerUID(val string) {
+	if rb.config.K8sOwnerUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.owner.uid", val)
+	}
+}
+
 // SetK8sPodHostname sets provided value as "k8s.pod.hostname" attribute.
 func (rb *ResourceBuilder) SetK8sPodHostname(val string) {
 	if rb.config.K8sPodHostname.Enabled {
@@ -182,6 +203,20 @@ func (rb *ResourceBuilder) SetK8sReplicasetUID(val string) {
 	}
 }
 
+// SetK8sServiceName sets provided value as "k8s.service.name" attribute.
+func (rb *ResourceBuilder) SetK8sServiceName(val string) {
+	if rb.config.K8sServiceName.Enabled {
+		rb.res.Attributes().PutStr("k8s.service.name", val)
+	}
+}
+
+// SetK8sServiceUID sets provided value as "k8s.service.uid" attribute.
+func (rb *ResourceBuilder) SetK8sServiceUID(val string) {
+	if rb.config.K8sServiceUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.service.uid", val)
+	}
+}
+
 // SetK8sStatefulsetName sets provided value as "k8s.statefulset.name" attribute.
 func (rb *ResourceBuilder) SetK8sStatefulsetName(val string) {
 	if rb.config.K8sStatefulsetName.Enabled {
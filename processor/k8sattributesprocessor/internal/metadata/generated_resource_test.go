@@ -29,6 +29,9 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sNamespaceName("k8s.namespace.name-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sOwnerKind("k8s.owner.kind-val")
+			rb.SetK8sOwnerName("k8s.owner.name-val")
+			rb.SetK8sOwnerUID("k8s.owner.uid-val")
 			rb.SetK8sPodHostname("k8s.pod.hostname-val")
 			rb.SetK8sPodIP("k8s.pod.ip-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
@@ -36,6 +39,8 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sPodUID("k8s.pod.uid-val")
 			rb.SetK8sReplicasetName("k8s.replicaset.name-val")
 			rb.SetK8sReplicasetUID("k8s.replicaset.uid-val")
+			rb.SetK8sServiceName("k8s.service.name-val")
+			rb.SetK8sServiceUID("k8s.service.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
 			rb.SetServiceInstanceID("service.instance.id-val")
@@ -50,7 +55,7 @@ func TestResourceBuilder(t *testing.T) {
 			case "default":
 				assert.Equal(t, 8, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 29, res.Attributes().Len())
+				assert.Equal(t, 34, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -138,6 +143,21 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.node.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.owner.kind")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.owner.kind-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.owner.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.owner.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.owner.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.owner.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.hostname")
 			assert.Equal(t, tt == "all_set", ok)
 			if ok {
@@ -173,6 +193,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.replicaset.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.service.name")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.service.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.service.uid")
+			assert.Equal(t, tt == "all_set", ok)
+			if ok {
+				assert.Equal(t, "k8s.service.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.statefulset.name")
 			assert.Equal(t, tt == "all_set", ok)
 			if ok {
@@ -9,7 +9,9 @@ import (
 	"regexp"
 	"time"
 
+	"go.opentelemetry.io/collector/component"
 	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -31,6 +33,11 @@ const (
 	//   replace containerRepoDigests with string(conventions.ContainerImageRepoDigestsKey)
 	clusterUID                = "k8s.cluster.uid"
 	containerImageRepoDigests = "container.image.repo_digests"
+	metadataOwnerName         = "k8s.owner.name"
+	metadataOwnerKind         = "k8s.owner.kind"
+	metadataOwnerUID          = "k8s.owner.uid"
+	metadataServiceName       = "k8s.service.name"
+	metadataServiceUID        = "k8s.service.uid"
 )
 
 // option represents a configuration option that can be passes.
@@ -106,6 +113,15 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sNodeUID.Enabled {
 		attributes = append(attributes, string(conventions.K8SNodeUIDKey))
 	}
+	if defaultConfig.K8sOwnerKind.Enabled {
+		attributes = append(attributes, metadataOwnerKind)
+	}
+	if defaultConfig.K8sOwnerName.Enabled {
+		attributes = append(attributes, metadataOwnerName)
+	}
+	if defaultConfig.K8sOwnerUID.Enabled {
+		attributes = append(attributes, metadataOwnerUID)
+	}
 	if defaultConfig.K8sPodHostname.Enabled {
 		attributes = append(attributes, specPodHostName)
 	}
@@ -127,6 +143,12 @@ func enabledAttributes() (attributes []string) {
 	if defaultConfig.K8sReplicasetUID.Enabled {
 		attributes = append(attributes, string(conventions.K8SReplicaSetUIDKey))
 	}
+	if defaultConfig.K8sServiceName.Enabled {
+		attributes = append(attributes, metadataServiceName)
+	}
+	if defaultConfig.K8sServiceUID.Enabled {
+		attributes = append(attributes, metadataServiceUID)
+	}
 	if defaultConfig.K8sStatefulsetName.Enabled {
 		attributes = append(attributes, string(conventions.K8SStatefulSetNameKey))
 	}
@@ -212,12 +234,38 @@ func withExtractMetadata(fields ...string) option {
 				p.rules.ServiceVersion = true
 			case string(conventions.ServiceInstanceIDKey):
 				p.rules.ServiceInstanceID = true
+			case metadataOwnerName:
+				p.rules.OwnerName = true
+			case metadataOwnerKind:
+				p.rules.OwnerKind = true
+			case metadataOwnerUID:
+				p.rules.OwnerUID = true
+			case metadataServiceName:
+				p.rules.K8sServiceName = true
+			case metadataServiceUID:
+				p.rules.K8sServiceUID = true
 			}
 		}
 		return nil
 	}
 }
 
+// withExtractOwnerChain allows specifying options to control the resolution of the top-level owner of pods.
+func withExtractOwnerChain(cfg OwnerChainConfig) option {
+	return func(p *kubernetesprocessor) error {
+		resources := make([]schema.GroupVersionResource, 0, len(cfg.Resources))
+		for _, r := range cfg.Resources {
+			resources = append(resources, schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Resource})
+		}
+		p.rules.OwnerChain = kube.OwnerChainRules{
+			Resources:             resources,
+			MaxDepth:              cfg.MaxDepth,
+			MaxObjectsPerResource: cfg.MaxObjectsPerResource,
+		}
+		return nil
+	}
+}
+
 func withOtelAnnotations(enabled bool) option {
 	return func(p *kubernetesprocessor) error {
 		if enabled {
@@ -386,6 +434,28 @@ func withExtractPodAssociations(podAssociations ...PodAssociationConfig) option
 	}
 }
 
+// withExtractServiceAssociations allows specifying options to associate service metadata with incoming resource
+func withExtractServiceAssociations(serviceAssociations ...ServiceAssociationConfig) option {
+	return func(p *kubernetesprocessor) error {
+		associations := make([]kube.ServiceAssociation, 0, len(serviceAssociations))
+		for _, association := range serviceAssociations {
+			assoc := kube.ServiceAssociation{
+				Address: kube.AssociationSource{
+					From: association.Address.From,
+				},
+				Port: association.Port,
+			}
+			if association.Address.From != kube.ConnectionSource {
+				assoc.Address.Name = association.Address.Name
+			}
+			associations = append(associations, assoc)
+		}
+		p.serviceAssociations = associations
+		p.rules.Services = len(associations) > 0
+		return nil
+	}
+}
+
 // withExcludes allows specifying pods to exclude
 func withExcludes(podExclude ExcludeConfig) option {
 	return func(p *kubernetesprocessor) error {
@@ -418,3 +488,11 @@ func withWaitForMetadataTimeout(timeout time.Duration) option {
 		return nil
 	}
 }
+
+// withMetadataCache allows specifying the k8s_metadata_cache extension the informers are obtained from.
+func withMetadataCache(id component.ID) option {
+	return func(p *kubernetesprocessor) error {
+		p.metadataCache = &id
+		return nil
+	}
+}
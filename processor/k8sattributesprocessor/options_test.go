@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	conventions "go.opentelemetry.io/otel/semconv/v1.6.1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
@@ -364,6 +365,37 @@ func TestWithExtractMetadata(t *testing.T) {
 	assert.False(t, p.rules.Node)
 }
 
+func TestWithExtractOwnerChain(t *testing.T) {
+	p := &kubernetesprocessor{}
+	assert.NoError(t, withExtractMetadata(metadataOwnerName, metadataOwnerKind, metadataOwnerUID)(p))
+	assert.True(t, p.rules.OwnerName)
+	assert.True(t, p.rules.OwnerKind)
+	assert.True(t, p.rules.OwnerUID)
+	assert.True(t, p.rules.IncludesOwnerChain())
+
+	assert.NoError(t, withExtractOwnerChain(OwnerChainConfig{
+		Resources: []OwnerResourceConfig{
+			{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+			{Version: "v1", Resource: "replicationcontrollers"},
+		},
+		MaxDepth:              3,
+		MaxObjectsPerResource: 100,
+	})(p))
+	assert.Equal(t, kube.OwnerChainRules{
+		Resources: []schema.GroupVersionResource{
+			{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+			{Version: "v1", Resource: "replicationcontrollers"},
+		},
+		MaxDepth:              3,
+		MaxObjectsPerResource: 100,
+	}, p.rules.OwnerChain)
+
+	p = &kubernetesprocessor{}
+	assert.NoError(t, withExtractLabels(FieldExtractConfig{Key: "team", From: kube.MetadataFromOwner})(p))
+	assert.True(t, p.rules.IncludesOwnerChain())
+	assert.Equal(t, "k8s.owner.labels.team", p.rules.Labels[0].Name)
+}
+
 func TestWithFilterLabels(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -705,6 +737,72 @@ func TestWithExtractPodAssociation(t *testing.T) {
 	}
 }
 
+func TestWithExtractServiceAssociation(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []ServiceAssociationConfig
+		want         []kube.ServiceAssociation
+		wantServices bool
+	}{
+		{
+			"empty",
+			[]ServiceAssociationConfig{},
+			[]kube.ServiceAssociation{},
+			false,
+		},
+		{
+			"resource_attribute",
+			[]ServiceAssociationConfig{
+				{
+					Address: PodAssociationSourceConfig{
+						From: "resource_attribute",
+						Name: "server.address",
+					},
+					Port: "server.port",
+				},
+			},
+			[]kube.ServiceAssociation{
+				{
+					Address: kube.AssociationSource{
+						From: "resource_attribute",
+						Name: "server.address",
+					},
+					Port: "server.port",
+				},
+			},
+			true,
+		},
+		{
+			"connection",
+			[]ServiceAssociationConfig{
+				{
+					Address: PodAssociationSourceConfig{
+						From: "connection",
+						Name: "ip",
+					},
+				},
+			},
+			[]kube.ServiceAssociation{
+				{
+					Address: kube.AssociationSource{
+						From: "connection",
+					},
+				},
+			},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &kubernetesprocessor{}
+			opt := withExtractServiceAssociations(tt.args...)
+			assert.NoError(t, opt(p))
+			assert.Equal(t, tt.want, p.serviceAssociations)
+			assert.Equal(t, tt.wantServices, p.rules.Services)
+		})
+	}
+}
+
 func TestWithExcludes(t *testing.T) {
 	tests := []struct {
 		name string
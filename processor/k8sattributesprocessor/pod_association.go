@@ -5,6 +5,7 @@ package k8sattributesprocessor // import "github.com/open-telemetry/opentelemetr
 
 import (
 	"context"
+	"math"
 	"net"
 
 	"go.opentelemetry.io/collector/client"
@@ -64,6 +65,34 @@ func extractPodID(ctx context.Context, attrs pcommon.Map, associations []kube.As
 	return kube.PodIdentifier{}
 }
 
+// extractServiceAddress returns the IP address and the port, if any, of the first service association
+// whose address is resolved
+func extractServiceAddress(ctx context.Context, attrs pcommon.Map, associations []kube.ServiceAssociation) (string, int32) {
+	for _, asso := range associations {
+		var address string
+		switch asso.Address.From {
+		case kube.ConnectionSource:
+			address = clientutil.Address(client.FromContext(ctx))
+		case kube.ResourceSource:
+			address = stringAttributeFromMap(attrs, asso.Address.Name)
+		}
+		if net.ParseIP(address) == nil {
+			continue
+		}
+
+		var port int32
+		if asso.Port != "" {
+			if val, ok := attrs.Get(asso.Port); ok {
+				if p, err := intFromAttribute(val); err == nil && p > 0 && p <= math.MaxUint16 {
+					port = int32(p)
+				}
+			}
+		}
+		return address, port
+	}
+	return "", 0
+}
+
 // extractPodIds returns pod identifier for first association matching all sources
 func extractPodIDNoAssociations(ctx context.Context, attrs pcommon.Map) kube.PodIdentifier {
 	var podIP, labelIP string
@@ -19,6 +19,7 @@ import (
 	conventions "go.opentelemetry.io/otel/semconv/v1.8.0"
 	"go.uber.org/zap"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 )
@@ -38,9 +39,12 @@ type kubernetesprocessor struct {
 	rules                  kube.ExtractionRules
 	filters                kube.Filters
 	podAssociations        []kube.Association
+	serviceAssociations    []kube.ServiceAssociation
 	podIgnore              kube.Excludes
 	waitForMetadata        bool
 	waitForMetadataTimeout time.Duration
+	metadataCache          *component.ID
+	informersFactory       kube.InformersFactoryList
 }
 
 func (kp *kubernetesprocessor) initKubeClient(set component.TelemetrySettings, kubeClient kube.ClientProvider) error {
@@ -48,7 +52,7 @@ func (kp *kubernetesprocessor) initKubeClient(set component.TelemetrySettings, k
 		kubeClient = kube.New
 	}
 	if !kp.passthroughMode {
-		kc, err := kubeClient(set, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, kube.InformersFactoryList{}, kp.waitForMetadata, kp.waitForMetadataTimeout)
+		kc, err := kubeClient(set, kp.apiConfig, kp.rules, kp.filters, kp.podAssociations, kp.podIgnore, nil, kp.informersFactory, kp.waitForMetadata, kp.waitForMetadataTimeout)
 		if err != nil {
 			return err
 		}
@@ -70,6 +74,14 @@ func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) err
 
 	// This might have been set by an option already
 	if kp.kc == nil {
+		if kp.metadataCache != nil && !kp.passthroughMode {
+			shared, err := getMetadataCache(host, *kp.metadataCache)
+			if err != nil {
+				componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(err))
+				return err
+			}
+			kp.informersFactory = kube.NewSharedInformersFactoryList(shared)
+		}
 		err := kp.initKubeClient(kp.telemetrySettings, kubeClientProvider)
 		if err != nil {
 			kp.logger.Error("Could not initialize kube client", zap.Error(err))
@@ -87,6 +99,19 @@ func (kp *kubernetesprocessor) Start(_ context.Context, host component.Host) err
 	return nil
 }
 
+// getMetadataCache returns the k8s_metadata_cache extension with the given ID.
+func getMetadataCache(host component.Host, id component.ID) (k8smetadatacacheextension.InformerProvider, error) {
+	ext := host.GetExtensions()[id]
+	if ext == nil {
+		return nil, fmt.Errorf("unknown k8s metadata cache %q", id)
+	}
+	shared, ok := ext.(k8smetadatacacheextension.InformerProvider)
+	if !ok {
+		return nil, fmt.Errorf("the extension %T does not implement k8smetadatacacheextension.InformerProvider", ext)
+	}
+	return shared, nil
+}
+
 func (kp *kubernetesprocessor) Shutdown(context.Context) error {
 	if kp.kc == nil {
 		return nil
@@ -162,9 +187,16 @@ func (kp *kubernetesprocessor) processResource(ctx context.Context, resource pco
 				setResourceAttribute(resource.Attributes(), key, val)
 			}
 			kp.addContainerAttributes(resource.Attributes(), pod)
+			if kp.rules.IncludesOwnerChain() {
+				kp.addOwnerAttributes(resource.Attributes(), pod)
+			}
 		}
 	}
 
+	if len(kp.serviceAssociations) > 0 {
+		kp.addServiceAttributes(ctx, resource.Attributes())
+	}
+
 	namespace := getNamespace(pod, resource.Attributes())
 	if namespace != "" {
 		attrsToAdd := kp.getAttributesForPodsNamespace(namespace)
@@ -302,6 +334,55 @@ func (kp *kubernetesprocessor) addContainerAttributes(attrs pcommon.Map, pod *ku
 	}
 }
 
+// addOwnerAttributes adds the attributes of the top-level owner of the pod
+func (kp *kubernetesprocessor) addOwnerAttributes(attrs pcommon.Map, pod *kube.Pod) {
+	ref, owner := kube.TopLevelOwner(kp.kc, pod, kp.rules.OwnerChain.MaxDepth)
+	if ref == nil {
+		return
+	}
+	if kp.rules.OwnerName {
+		setResourceAttribute(attrs, metadataOwnerName, ref.Name)
+	}
+	if kp.rules.OwnerKind {
+		setResourceAttribute(attrs, metadataOwnerKind, ref.Kind)
+	}
+	if kp.rules.OwnerUID {
+		setResourceAttribute(attrs, metadataOwnerUID, ref.UID)
+	}
+	if owner != nil {
+		for key, val := range owner.Attributes {
+			setResourceAttribute(attrs, key, val)
+		}
+	}
+}
+
+// addServiceAttributes looks up the service the resource is addressed to and adds the attributes of
+// the service and of the workload selected by it
+func (kp *kubernetesprocessor) addServiceAttributes(ctx context.Context, attrs pcommon.Map) {
+	address, port := extractServiceAddress(ctx, attrs, kp.serviceAssociations)
+	if address == "" {
+		return
+	}
+	service, ok := kp.kc.GetService(address, port)
+	if !ok {
+		return
+	}
+	kp.logger.Debug("getting the service", zap.Any("service", service))
+
+	if kp.rules.K8sServiceName {
+		setResourceAttribute(attrs, metadataServiceName, service.Name)
+	}
+	if kp.rules.K8sServiceUID {
+		setResourceAttribute(attrs, metadataServiceUID, service.UID)
+	}
+	if kp.rules.Namespace {
+		setResourceAttribute(attrs, string(conventions.K8SNamespaceNameKey), service.Namespace)
+	}
+	for key, val := range kp.kc.GetServiceWorkload(service.Namespace, service.Name) {
+		setResourceAttribute(attrs, key, val)
+	}
+}
+
 func (kp *kubernetesprocessor) getAttributesForPodsNamespace(namespace string) map[string]string {
 	ns, ok := kp.kc.GetNamespace(namespace)
 	if !ok {
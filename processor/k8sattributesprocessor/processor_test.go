@@ -28,7 +28,11 @@ import (
 	"go.opentelemetry.io/collector/processor/processortest"
 	"go.opentelemetry.io/collector/processor/xprocessor"
 	conventions "go.opentelemetry.io/otel/semconv/v1.8.0"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/k8smetadatacacheextension"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/k8sconfig"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/kube"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/k8sattributesprocessor/internal/metadata"
@@ -871,6 +875,202 @@ func TestAddNamespaceLabels(t *testing.T) {
 	})
 }
 
+func TestAddOwnerAttributes(t *testing.T) {
+	m := newMultiTest(
+		t,
+		func() component.Config {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Extract.Metadata = []string{metadataOwnerName, metadataOwnerKind, metadataOwnerUID}
+			cfg.Extract.Labels = []FieldExtractConfig{
+				{
+					TagName: "team",
+					From:    kube.MetadataFromOwner,
+					Key:     "team",
+				},
+			}
+			return cfg
+		}(),
+		nil,
+	)
+
+	podIP := "1.1.1.1"
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.podAssociations = []kube.Association{
+			{
+				Sources: []kube.AssociationSource{
+					{
+						From: "connection",
+					},
+				},
+			},
+		}
+	})
+
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		pi := kube.PodIdentifier{
+			kube.PodIdentifierAttributeFromConnection(podIP),
+		}
+		kp.kc.(*fakeClient).Pods[pi] = &kube.Pod{
+			Name:      "checkout-7d9c8-x2b4l",
+			Namespace: "namespace-1",
+			Owner: &kube.OwnerReference{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "checkout-7d9c8",
+				UID:        "rs-uid",
+			},
+		}
+		kp.kc.(*fakeClient).Owners = map[string]*kube.Owner{
+			"rs-uid": {
+				Name:      "checkout-7d9c8",
+				Namespace: "namespace-1",
+				UID:       "rs-uid",
+				Controller: &kube.OwnerReference{
+					APIVersion: "argoproj.io/v1alpha1",
+					Kind:       "Rollout",
+					Name:       "checkout",
+					UID:        "rollout-uid",
+				},
+			},
+			"rollout-uid": {
+				Name:       "checkout",
+				Namespace:  "namespace-1",
+				UID:        "rollout-uid",
+				Attributes: map[string]string{"team": "payments"},
+			},
+		}
+	})
+
+	ctx := client.NewContext(context.Background(), client.Info{
+		Addr: &net.IPAddr{
+			IP: net.ParseIP(podIP),
+		},
+	})
+	m.testConsume(
+		ctx,
+		generateTraces(),
+		generateMetrics(),
+		generateLogs(),
+		generateProfiles(),
+		func(err error) {
+			assert.NoError(t, err)
+		})
+
+	m.assertBatchesLen(1)
+	m.assertResourceObjectLen(0)
+	m.assertResource(0, func(res pcommon.Resource) {
+		assert.Equal(t, 5, res.Attributes().Len())
+		assertResourceHasStringAttribute(t, res, "k8s.pod.ip", podIP)
+		assertResourceHasStringAttribute(t, res, "k8s.owner.name", "checkout")
+		assertResourceHasStringAttribute(t, res, "k8s.owner.kind", "Rollout")
+		assertResourceHasStringAttribute(t, res, "k8s.owner.uid", "rollout-uid")
+		assertResourceHasStringAttribute(t, res, "team", "payments")
+	})
+}
+
+func TestAddServiceAttributes(t *testing.T) {
+	m := newMultiTest(
+		t,
+		func() component.Config {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Extract.Metadata = []string{
+				metadataServiceName, metadataServiceUID,
+				string(conventions.K8SNamespaceNameKey),
+				string(conventions.K8SDeploymentNameKey), string(conventions.K8SReplicaSetNameKey),
+			}
+			cfg.ServiceAssociation = []ServiceAssociationConfig{
+				{
+					Address: PodAssociationSourceConfig{
+						From: kube.ResourceSource,
+						Name: "server.address",
+					},
+					Port: "server.port",
+				},
+			}
+			return cfg
+		}(),
+		nil,
+	)
+
+	m.kubernetesProcessorOperation(func(kp *kubernetesprocessor) {
+		kp.kc.(*fakeClient).Services = map[string]*kube.Service{
+			"10.96.0.10": {
+				Name:      "checkout",
+				Namespace: "namespace-1",
+				UID:       "service-uid",
+			},
+		}
+		kp.kc.(*fakeClient).ServiceWorkloads = map[string]map[string]string{
+			"namespace-1/checkout": {"k8s.deployment.name": "checkout"},
+		}
+	})
+
+	withServerAddress := func(res pcommon.Resource) {
+		res.Attributes().PutStr("server.address", "10.96.0.10")
+		res.Attributes().PutInt("server.port", 8080)
+	}
+	m.testConsume(
+		context.Background(),
+		generateTraces(withServerAddress),
+		generateMetrics(withServerAddress),
+		generateLogs(withServerAddress),
+		generateProfiles(withServerAddress),
+		func(err error) {
+			assert.NoError(t, err)
+		})
+
+	m.assertBatchesLen(1)
+	m.assertResourceObjectLen(0)
+	m.assertResource(0, func(res pcommon.Resource) {
+		assert.Equal(t, 6, res.Attributes().Len())
+		assertResourceHasStringAttribute(t, res, "k8s.service.name", "checkout")
+		assertResourceHasStringAttribute(t, res, "k8s.service.uid", "service-uid")
+		assertResourceHasStringAttribute(t, res, "k8s.namespace.name", "namespace-1")
+		assertResourceHasStringAttribute(t, res, "k8s.deployment.name", "checkout")
+		_, found := res.Attributes().Get("k8s.replicaset.name")
+		assert.False(t, found)
+	})
+}
+
+func TestExtractServiceAddress(t *testing.T) {
+	associations := []kube.ServiceAssociation{
+		{
+			Address: kube.AssociationSource{From: kube.ResourceSource, Name: "server.address"},
+			Port:    "server.port",
+		},
+		{
+			Address: kube.AssociationSource{From: kube.ConnectionSource},
+		},
+	}
+	ctx := client.NewContext(context.Background(), client.Info{
+		Addr: &net.IPAddr{
+			IP: net.ParseIP("10.0.0.1"),
+		},
+	})
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr("server.address", "10.96.0.10")
+	attrs.PutStr("server.port", "8080")
+	address, port := extractServiceAddress(ctx, attrs, associations)
+	assert.Equal(t, "10.96.0.10", address)
+	assert.Equal(t, int32(8080), port)
+
+	// an invalid port is ignored
+	attrs.PutStr("server.port", "http")
+	address, port = extractServiceAddress(ctx, attrs, associations)
+	assert.Equal(t, "10.96.0.10", address)
+	assert.Zero(t, port)
+
+	// addresses that are not IPs fall back to the next association
+	attrs.PutStr("server.address", "checkout.namespace-1.svc")
+	address, port = extractServiceAddress(ctx, attrs, associations)
+	assert.Equal(t, "10.0.0.1", address)
+	assert.Zero(t, port)
+
+	address, _ = extractServiceAddress(context.Background(), attrs, associations)
+	assert.Empty(t, address)
+}
+
 func TestAddNodeLabels(t *testing.T) {
 	m := newMultiTest(
 		t,
@@ -1697,6 +1897,94 @@ func TestStartStop(t *testing.T) {
 	assert.True(t, controller.HasStopped())
 }
 
+// fakeMetadataCache is a k8s metadata cache extension whose informers are never used, as the kube client is faked.
+type fakeMetadataCache struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
+func (*fakeMetadataCache) Client() kubernetes.Interface {
+	return nil
+}
+
+func (*fakeMetadataCache) DynamicClient() dynamic.Interface {
+	return nil
+}
+
+func (*fakeMetadataCache) Informer(_ k8smetadatacacheextension.InformerKey, newInformer func() cache.SharedInformer, _ cache.TransformFunc) cache.SharedInformer {
+	return newInformer()
+}
+
+type extensionsHost map[component.ID]component.Component
+
+func (h extensionsHost) GetExtensions() map[component.ID]component.Component {
+	return h
+}
+
+func TestStartWithMetadataCache(t *testing.T) {
+	cacheID := component.MustNewID("k8s_metadata_cache")
+	metadataCache := &fakeMetadataCache{}
+	host := extensionsHost{
+		cacheID:                    metadataCache,
+		component.MustNewID("nop"): &fakeHealthCheck{},
+	}
+
+	var informersFactory kube.InformersFactoryList
+	originalProvider := kubeClientProvider
+	defer func() {
+		kubeClientProvider = originalProvider
+	}()
+	kubeClientProvider = func(set component.TelemetrySettings, apiCfg k8sconfig.APIConfig, rules kube.ExtractionRules, filters kube.Filters, associations []kube.Association, exclude kube.Excludes, newClientSet kube.APIClientsetProvider, factory kube.InformersFactoryList, waitForMetadata bool, waitForMetadataTimeout time.Duration) (kube.Client, error) {
+		informersFactory = factory
+		return newFakeClient(set, apiCfg, rules, filters, associations, exclude, newClientSet, factory, waitForMetadata, waitForMetadataTimeout)
+	}
+
+	tests := []struct {
+		name          string
+		metadataCache component.ID
+		wantErr       string
+	}{
+		{
+			name:          "valid",
+			metadataCache: cacheID,
+		},
+		{
+			name:          "unknown",
+			metadataCache: component.MustNewIDWithName("k8s_metadata_cache", "unknown"),
+			wantErr:       `unknown k8s metadata cache "k8s_metadata_cache/unknown"`,
+		},
+		{
+			name:          "wrong_type",
+			metadataCache: component.MustNewID("nop"),
+			wantErr:       "the extension *k8sattributesprocessor.fakeHealthCheck does not implement k8smetadatacacheextension.InformerProvider",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			informersFactory = kube.InformersFactoryList{}
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			cfg.MetadataCache = &tt.metadataCache
+			p, err := createTracesProcessorWithOptions(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+
+			err = p.Start(context.Background(), host)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, kube.NewSharedInformersFactoryList(metadataCache), informersFactory)
+			assert.NoError(t, p.Shutdown(context.Background()))
+		})
+	}
+}
+
+// fakeHealthCheck is an extension which is not a k8s metadata cache.
+type fakeHealthCheck struct {
+	component.StartFunc
+	component.ShutdownFunc
+}
+
 func assertResourceHasStringAttribute(t *testing.T, r pcommon.Resource, k, v string) {
 	got, ok := r.Attributes().Get(k)
 	require.Truef(t, ok, "resource does not contain attribute %s", k)
@@ -18,6 +18,8 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
@@ -41,18 +43,28 @@ type Config struct {
 	// The default value is `propagate`.
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
 
-	TraceStatements  []common.ContextStatements `mapstructure:"trace_statements"`
-	MetricStatements []common.ContextStatements `mapstructure:"metric_statements"`
-	LogStatements    []common.ContextStatements `mapstructure:"log_statements"`
+	TraceStatements   []common.ContextStatements `mapstructure:"trace_statements"`
+	MetricStatements  []common.ContextStatements `mapstructure:"metric_statements"`
+	LogStatements     []common.ContextStatements `mapstructure:"log_statements"`
+	ProfileStatements []common.ContextStatements `mapstructure:"profile_statements"`
+
+	// LogAggregations groups the log records of each batch by key and writes reductions of their values
+	// back to the records, after the log statements are executed.
+	LogAggregations []common.LogAggregation `mapstructure:"log_aggregations"`
+
+	// StatementTelemetry configures the telemetry recorded for each statement.
+	StatementTelemetry common.StatementTelemetryConfig `mapstructure:"statement_telemetry"`
 
 	FlattenData bool `mapstructure:"flatten_data"`
 	logger      *zap.Logger
 
-	dataPointFunctions map[string]ottl.Factory[ottldatapoint.TransformContext]
-	logFunctions       map[string]ottl.Factory[ottllog.TransformContext]
-	metricFunctions    map[string]ottl.Factory[ottlmetric.TransformContext]
-	spanEventFunctions map[string]ottl.Factory[ottlspanevent.TransformContext]
-	spanFunctions      map[string]ottl.Factory[ottlspan.TransformContext]
+	dataPointFunctions     map[string]ottl.Factory[ottldatapoint.TransformContext]
+	logFunctions           map[string]ottl.Factory[ottllog.TransformContext]
+	metricFunctions        map[string]ottl.Factory[ottlmetric.TransformContext]
+	profileFunctions       map[string]ottl.Factory[ottlprofile.TransformContext]
+	profileSampleFunctions map[string]ottl.Factory[ottlprofilesample.TransformContext]
+	spanEventFunctions     map[string]ottl.Factory[ottlspanevent.TransformContext]
+	spanFunctions          map[string]ottl.Factory[ottlspan.TransformContext]
 }
 
 // Unmarshal is used internally by mapstructure to parse the transformprocessor configuration (Config),
@@ -80,9 +92,10 @@ func (c *Config) Unmarshal(conf *confmap.Conf) error {
 	}
 
 	contextStatementsFields := map[string]*[]common.ContextStatements{
-		"trace_statements":  &c.TraceStatements,
-		"metric_statements": &c.MetricStatements,
-		"log_statements":    &c.LogStatements,
+		"trace_statements":   &c.TraceStatements,
+		"metric_statements":  &c.MetricStatements,
+		"log_statements":     &c.LogStatements,
+		"profile_statements": &c.ProfileStatements,
 	}
 
 	contextStatementsPatch := map[string]any{}
@@ -179,6 +192,26 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	for _, aggregation := range c.LogAggregations {
+		_, err := common.NewLogAggregator(aggregation, c.ErrorMode, component.TelemetrySettings{Logger: zap.NewNop()}, c.logFunctions)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+		}
+	}
+
+	if len(c.ProfileStatements) > 0 {
+		pc, err := common.NewProfileParserCollection(component.TelemetrySettings{Logger: zap.NewNop()}, common.WithProfileParser(c.profileFunctions), common.WithProfileSampleParser(c.profileSampleFunctions))
+		if err != nil {
+			return err
+		}
+		for _, cs := range c.ProfileStatements {
+			_, err = pc.ParseContextStatements(cs)
+			if err != nil {
+				errors = multierr.Append(errors, err)
+			}
+		}
+	}
+
 	if c.FlattenData && !flatLogsFeatureGate.IsEnabled() {
 		errors = multierr.Append(errors, errFlatLogsGateDisabled)
 	}
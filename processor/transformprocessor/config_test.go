@@ -75,6 +75,20 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				ProfileStatements: []common.ContextStatements{
+					{
+						Context: "profilesample",
+						Statements: []string{
+							`set(attributes["name"], "bear") where values[0] > 100`,
+						},
+					},
+					{
+						Context: "resource",
+						Statements: []string{
+							`set(attributes["name"], "bear")`,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -108,6 +122,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				ProfileStatements: []common.ContextStatements{},
 			},
 		},
 		{
@@ -122,8 +137,9 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
-				MetricStatements: []common.ContextStatements{},
-				LogStatements:    []common.ContextStatements{},
+				MetricStatements:  []common.ContextStatements{},
+				LogStatements:     []common.ContextStatements{},
+				ProfileStatements: []common.ContextStatements{},
 			},
 		},
 		{
@@ -135,6 +151,9 @@ func TestLoadConfig(t *testing.T) {
 		{
 			id: component.NewIDWithName(metadata.Type, "bad_syntax_metric"),
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "bad_syntax_profile"),
+		},
 		{
 			id: component.NewIDWithName(metadata.Type, "unknown_function_metric"),
 		},
@@ -174,6 +193,7 @@ func TestLoadConfig(t *testing.T) {
 						Statements: []string{`set(log.body, "bear") where log.attributes["http.path"] == "/animal"`},
 					},
 				},
+				ProfileStatements: []common.ContextStatements{},
 			},
 		},
 		{
@@ -204,6 +224,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				ProfileStatements: []common.ContextStatements{},
 			},
 		},
 		{
@@ -234,6 +255,7 @@ func TestLoadConfig(t *testing.T) {
 						},
 					},
 				},
+				ProfileStatements: []common.ContextStatements{},
 			},
 		},
 		{
@@ -270,6 +292,61 @@ func TestLoadConfig(t *testing.T) {
 						ErrorMode:  "",
 					},
 				},
+				ProfileStatements: []common.ContextStatements{},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "statement_telemetry"),
+			expected: &Config{
+				ErrorMode:        ottl.PropagateError,
+				TraceStatements:  []common.ContextStatements{},
+				MetricStatements: []common.ContextStatements{},
+				LogStatements: []common.ContextStatements{
+					{
+						Statements: []string{`set(resource.attributes["name"], "bear")`},
+					},
+				},
+				ProfileStatements: []common.ContextStatements{},
+				StatementTelemetry: common.StatementTelemetryConfig{
+					Metrics:               true,
+					DebugSamplingInterval: 100,
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "log_aggregations"),
+			expected: &Config{
+				ErrorMode:         ottl.PropagateError,
+				TraceStatements:   []common.ContextStatements{},
+				MetricStatements:  []common.ContextStatements{},
+				LogStatements:     []common.ContextStatements{},
+				ProfileStatements: []common.ContextStatements{},
+				LogAggregations: []common.LogAggregation{
+					{
+						GroupBy:    []string{`log.attributes["request.id"]`},
+						Conditions: []string{`log.severity_number >= SEVERITY_NUMBER_INFO`},
+						Boundary:   common.ResourceBoundary,
+						Output:     common.NewRecordOutput,
+						Reductions: []common.Reduction{
+							{
+								Function:  common.ConcatReduction,
+								Value:     `log.body`,
+								Separator: "\n",
+								Target:    `log.body`,
+							},
+							{
+								Function: common.CountReduction,
+								Target:   `log.attributes["request.size"]`,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			id: component.NewIDWithName(metadata.Type, "log_aggregations_bad_target"),
+			errors: []error{
+				errors.New(`invalid reduction target "Len(log.body)"`),
 			},
 		},
 	}
@@ -342,3 +419,17 @@ func Test_MixedConfigurationStyles(t *testing.T) {
 	assert.NoError(t, err)
 	assert.ErrorContains(t, sub.Unmarshal(cfg), "configuring multiple configuration styles is not supported")
 }
+
+func Test_UnknownReductionFunction(t *testing.T) {
+	id := component.NewIDWithName(metadata.Type, "log_aggregations_bad_function")
+
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	assert.NoError(t, err)
+
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+
+	sub, err := cm.Sub(id.String())
+	assert.NoError(t, err)
+	assert.ErrorContains(t, sub.Unmarshal(cfg), "unknown reduction function sum")
+}
@@ -9,36 +9,46 @@ import (
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/xconsumer"
 	"go.opentelemetry.io/collector/processor"
 	"go.opentelemetry.io/collector/processor/processorhelper"
+	"go.opentelemetry.io/collector/processor/processorhelper/xprocessorhelper"
+	"go.opentelemetry.io/collector/processor/xprocessor"
 	"go.uber.org/zap"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/logs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metrics"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/profiles"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/traces"
 )
 
 var processorCapabilities = consumer.Capabilities{MutatesData: true}
 
 type transformProcessorFactory struct {
-	dataPointFunctions                  map[string]ottl.Factory[ottldatapoint.TransformContext]
-	logFunctions                        map[string]ottl.Factory[ottllog.TransformContext]
-	metricFunctions                     map[string]ottl.Factory[ottlmetric.TransformContext]
-	spanEventFunctions                  map[string]ottl.Factory[ottlspanevent.TransformContext]
-	spanFunctions                       map[string]ottl.Factory[ottlspan.TransformContext]
-	defaultDataPointFunctionsOverridden bool
-	defaultLogFunctionsOverridden       bool
-	defaultMetricFunctionsOverridden    bool
-	defaultSpanEventFunctionsOverridden bool
-	defaultSpanFunctionsOverridden      bool
+	dataPointFunctions                      map[string]ottl.Factory[ottldatapoint.TransformContext]
+	logFunctions                            map[string]ottl.Factory[ottllog.TransformContext]
+	metricFunctions                         map[string]ottl.Factory[ottlmetric.TransformContext]
+	profileFunctions                        map[string]ottl.Factory[ottlprofile.TransformContext]
+	profileSampleFunctions                  map[string]ottl.Factory[ottlprofilesample.TransformContext]
+	spanEventFunctions                      map[string]ottl.Factory[ottlspanevent.TransformContext]
+	spanFunctions                           map[string]ottl.Factory[ottlspan.TransformContext]
+	defaultDataPointFunctionsOverridden     bool
+	defaultLogFunctionsOverridden           bool
+	defaultMetricFunctionsOverridden        bool
+	defaultProfileFunctionsOverridden       bool
+	defaultProfileSampleFunctionsOverridden bool
+	defaultSpanEventFunctionsOverridden     bool
+	defaultSpanFunctionsOverridden          bool
 }
 
 // FactoryOption applies changes to transformProcessorFactory.
@@ -80,6 +90,30 @@ func WithMetricFunctions(metricFunctions []ottl.Factory[ottlmetric.TransformCont
 	}
 }
 
+// WithProfileFunctions will override the default OTTL profile context functions with the provided profileFunctions in the resulting processor.
+// Subsequent uses of WithProfileFunctions will merge the provided profileFunctions with the previously registered functions.
+func WithProfileFunctions(profileFunctions []ottl.Factory[ottlprofile.TransformContext]) FactoryOption {
+	return func(factory *transformProcessorFactory) {
+		if !factory.defaultProfileFunctionsOverridden {
+			factory.profileFunctions = map[string]ottl.Factory[ottlprofile.TransformContext]{}
+			factory.defaultProfileFunctionsOverridden = true
+		}
+		factory.profileFunctions = mergeFunctionsToMap(factory.profileFunctions, profileFunctions)
+	}
+}
+
+// WithProfileSampleFunctions will override the default OTTL profilesample context functions with the provided profileSampleFunctions in the resulting processor.
+// Subsequent uses of WithProfileSampleFunctions will merge the provided profileSampleFunctions with the previously registered functions.
+func WithProfileSampleFunctions(profileSampleFunctions []ottl.Factory[ottlprofilesample.TransformContext]) FactoryOption {
+	return func(factory *transformProcessorFactory) {
+		if !factory.defaultProfileSampleFunctionsOverridden {
+			factory.profileSampleFunctions = map[string]ottl.Factory[ottlprofilesample.TransformContext]{}
+			factory.defaultProfileSampleFunctionsOverridden = true
+		}
+		factory.profileSampleFunctions = mergeFunctionsToMap(factory.profileSampleFunctions, profileSampleFunctions)
+	}
+}
+
 // WithSpanEventFunctions will override the default OTTL spanevent context functions with the provided spanEventFunctions in the resulting processor.
 // Subsequent uses of WithSpanEventFunctions will merge the provided spanEventFunctions with the previously registered functions.
 func WithSpanEventFunctions(spanEventFunctions []ottl.Factory[ottlspanevent.TransformContext]) FactoryOption {
@@ -111,36 +145,42 @@ func NewFactory() processor.Factory {
 // NewFactoryWithOptions can receive FactoryOption like With*Functions to register non-default OTTL functions in the resulting processor.
 func NewFactoryWithOptions(options ...FactoryOption) processor.Factory {
 	f := &transformProcessorFactory{
-		dataPointFunctions: defaultDataPointFunctionsMap(),
-		logFunctions:       defaultLogFunctionsMap(),
-		metricFunctions:    defaultMetricFunctionsMap(),
-		spanEventFunctions: defaultSpanEventFunctionsMap(),
-		spanFunctions:      defaultSpanFunctionsMap(),
+		dataPointFunctions:     defaultDataPointFunctionsMap(),
+		logFunctions:           defaultLogFunctionsMap(),
+		metricFunctions:        defaultMetricFunctionsMap(),
+		profileFunctions:       defaultProfileFunctionsMap(),
+		profileSampleFunctions: defaultProfileSampleFunctionsMap(),
+		spanEventFunctions:     defaultSpanEventFunctionsMap(),
+		spanFunctions:          defaultSpanFunctionsMap(),
 	}
 	for _, o := range options {
 		o(f)
 	}
 
-	return processor.NewFactory(
+	return xprocessor.NewFactory(
 		metadata.Type,
 		f.createDefaultConfig,
-		processor.WithLogs(f.createLogsProcessor, metadata.LogsStability),
-		processor.WithTraces(f.createTracesProcessor, metadata.TracesStability),
-		processor.WithMetrics(f.createMetricsProcessor, metadata.MetricsStability),
+		xprocessor.WithLogs(f.createLogsProcessor, metadata.LogsStability),
+		xprocessor.WithTraces(f.createTracesProcessor, metadata.TracesStability),
+		xprocessor.WithMetrics(f.createMetricsProcessor, metadata.MetricsStability),
+		xprocessor.WithProfiles(f.createProfilesProcessor, metadata.ProfilesStability),
 	)
 }
 
 func (f *transformProcessorFactory) createDefaultConfig() component.Config {
 	return &Config{
-		ErrorMode:          ottl.PropagateError,
-		TraceStatements:    []common.ContextStatements{},
-		MetricStatements:   []common.ContextStatements{},
-		LogStatements:      []common.ContextStatements{},
-		dataPointFunctions: f.dataPointFunctions,
-		logFunctions:       f.logFunctions,
-		metricFunctions:    f.metricFunctions,
-		spanEventFunctions: f.spanEventFunctions,
-		spanFunctions:      f.spanFunctions,
+		ErrorMode:              ottl.PropagateError,
+		TraceStatements:        []common.ContextStatements{},
+		MetricStatements:       []common.ContextStatements{},
+		LogStatements:          []common.ContextStatements{},
+		ProfileStatements:      []common.ContextStatements{},
+		dataPointFunctions:     f.dataPointFunctions,
+		logFunctions:           f.logFunctions,
+		metricFunctions:        f.metricFunctions,
+		profileFunctions:       f.profileFunctions,
+		profileSampleFunctions: f.profileSampleFunctions,
+		spanEventFunctions:     f.spanEventFunctions,
+		spanFunctions:          f.spanFunctions,
 	}
 }
 
@@ -151,10 +191,14 @@ func (f *transformProcessorFactory) createLogsProcessor(
 	nextConsumer consumer.Logs,
 ) (processor.Logs, error) {
 	oCfg := cfg.(*Config)
+	telemetry, err := newStatementTelemetry(set, oCfg.StatementTelemetry)
+	if err != nil {
+		return nil, err
+	}
 	if f.defaultLogFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL log functions have been registered in the \"transform\" processor", zap.Bool("log", f.defaultLogFunctionsOverridden))
 	}
-	proc, err := logs.NewProcessor(oCfg.LogStatements, oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions)
+	proc, err := logs.NewProcessor(common.WithStatementTelemetry(oCfg.LogStatements, telemetry), oCfg.ErrorMode, oCfg.FlattenData, set.TelemetrySettings, f.logFunctions, oCfg.LogAggregations)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -174,13 +218,17 @@ func (f *transformProcessorFactory) createTracesProcessor(
 	nextConsumer consumer.Traces,
 ) (processor.Traces, error) {
 	oCfg := cfg.(*Config)
+	telemetry, err := newStatementTelemetry(set, oCfg.StatementTelemetry)
+	if err != nil {
+		return nil, err
+	}
 	if f.defaultSpanEventFunctionsOverridden || f.defaultSpanFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL trace functions have been registered in the \"transform\" processor",
 			zap.Bool("span", f.defaultSpanFunctionsOverridden),
 			zap.Bool("spanevent", f.defaultSpanEventFunctionsOverridden),
 		)
 	}
-	proc, err := traces.NewProcessor(oCfg.TraceStatements, oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions)
+	proc, err := traces.NewProcessor(common.WithStatementTelemetry(oCfg.TraceStatements, telemetry), oCfg.ErrorMode, set.TelemetrySettings, f.spanFunctions, f.spanEventFunctions)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -200,6 +248,10 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 	nextConsumer consumer.Metrics,
 ) (processor.Metrics, error) {
 	oCfg := cfg.(*Config)
+	telemetry, err := newStatementTelemetry(set, oCfg.StatementTelemetry)
+	if err != nil {
+		return nil, err
+	}
 	oCfg.logger = set.Logger
 	if f.defaultDataPointFunctionsOverridden || f.defaultMetricFunctionsOverridden {
 		set.Logger.Debug("non-default OTTL metric functions have been registered in the \"transform\" processor",
@@ -207,7 +259,7 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 			zap.Bool("metric", f.defaultMetricFunctionsOverridden),
 		)
 	}
-	proc, err := metrics.NewProcessor(oCfg.MetricStatements, oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions)
+	proc, err := metrics.NewProcessor(common.WithStatementTelemetry(oCfg.MetricStatements, telemetry), oCfg.ErrorMode, set.TelemetrySettings, f.metricFunctions, f.dataPointFunctions)
 	if err != nil {
 		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
 	}
@@ -219,3 +271,47 @@ func (f *transformProcessorFactory) createMetricsProcessor(
 		proc.ProcessMetrics,
 		processorhelper.WithCapabilities(processorCapabilities))
 }
+
+func (f *transformProcessorFactory) createProfilesProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer xconsumer.Profiles,
+) (xprocessor.Profiles, error) {
+	oCfg := cfg.(*Config)
+	telemetry, err := newStatementTelemetry(set, oCfg.StatementTelemetry)
+	if err != nil {
+		return nil, err
+	}
+	if f.defaultProfileFunctionsOverridden || f.defaultProfileSampleFunctionsOverridden {
+		set.Logger.Debug("non-default OTTL profile functions have been registered in the \"transform\" processor",
+			zap.Bool("profile", f.defaultProfileFunctionsOverridden),
+			zap.Bool("profilesample", f.defaultProfileSampleFunctionsOverridden),
+		)
+	}
+	proc, err := profiles.NewProcessor(common.WithStatementTelemetry(oCfg.ProfileStatements, telemetry), oCfg.ErrorMode, set.TelemetrySettings, f.profileFunctions, f.profileSampleFunctions)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config for \"transform\" processor %w", err)
+	}
+	return xprocessorhelper.NewProfiles(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.ProcessProfiles,
+		xprocessorhelper.WithCapabilities(processorCapabilities))
+}
+
+// newStatementTelemetry returns the telemetry recorded for the statements of a processor,
+// only creating the statement metrics when they are enabled.
+func newStatementTelemetry(set processor.Settings, config common.StatementTelemetryConfig) (*common.StatementTelemetry, error) {
+	var telemetryBuilder *metadata.TelemetryBuilder
+	if config.Metrics {
+		var err error
+		telemetryBuilder, err = metadata.NewTelemetryBuilder(set.TelemetrySettings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return common.NewStatementTelemetry(config, telemetryBuilder), nil
+}
@@ -14,13 +14,16 @@ import (
 	"go.opentelemetry.io/collector/consumer/consumertest"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/collector/processor/xprocessor"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottldatapoint"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlmetric"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspan"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlspanevent"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
@@ -44,6 +47,12 @@ func assertConfigContainsDefaultFunctions(t *testing.T, config Config) {
 	for _, f := range DefaultSpanEventFunctions() {
 		assert.Contains(t, config.spanEventFunctions, f.Name(), "missing span event function %v", f.Name())
 	}
+	for _, f := range DefaultProfileFunctions() {
+		assert.Contains(t, config.profileFunctions, f.Name(), "missing profile function %v", f.Name())
+	}
+	for _, f := range DefaultProfileSampleFunctions() {
+		assert.Contains(t, config.profileSampleFunctions, f.Name(), "missing profile sample function %v", f.Name())
+	}
 }
 
 func TestFactory_Type(t *testing.T) {
@@ -55,10 +64,11 @@ func TestFactory_CreateDefaultConfig(t *testing.T) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
 	assert.EqualExportedValues(t, &Config{
-		ErrorMode:        ottl.PropagateError,
-		TraceStatements:  []common.ContextStatements{},
-		MetricStatements: []common.ContextStatements{},
-		LogStatements:    []common.ContextStatements{},
+		ErrorMode:         ottl.PropagateError,
+		TraceStatements:   []common.ContextStatements{},
+		MetricStatements:  []common.ContextStatements{},
+		LogStatements:     []common.ContextStatements{},
+		ProfileStatements: []common.ContextStatements{},
 	}, cfg)
 	assertConfigContainsDefaultFunctions(t, *cfg.(*Config))
 	assert.NoError(t, componenttest.CheckConfigStruct(cfg))
@@ -216,6 +226,52 @@ func TestFactoryCreateLogs_InvalidActions(t *testing.T) {
 	assert.Nil(t, ap)
 }
 
+func TestFactoryCreateProfiles(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.ErrorMode = ottl.IgnoreError
+	oCfg.ProfileStatements = []common.ContextStatements{
+		{
+			Context: "profilesample",
+			Statements: []string{
+				`set(attributes["test"], "pass") where values[0] > 10`,
+				`set(attributes["test error mode"], ParseJSON(1)) where values[0] > 10`,
+			},
+		},
+	}
+	pp, err := factory.(xprocessor.Factory).CreateProfiles(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.NotNil(t, pp)
+	assert.NoError(t, err)
+
+	pd := pprofile.NewProfiles()
+	sample := pd.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty().Sample().AppendEmpty()
+	sample.Value().FromRaw([]int64{20})
+
+	err = pp.ConsumeProfiles(context.Background(), pd)
+	assert.NoError(t, err)
+
+	attributes := pprofile.FromAttributeIndices(pd.ProfilesDictionary().AttributeTable(), sample)
+	val, ok := attributes.Get("test")
+	assert.True(t, ok)
+	assert.Equal(t, "pass", val.Str())
+}
+
+func TestFactoryCreateProfiles_InvalidActions(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.ProfileStatements = []common.ContextStatements{
+		{
+			Context:    "profile",
+			Statements: []string{`set(123`},
+		},
+	}
+	pp, err := factory.(xprocessor.Factory).CreateProfiles(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	assert.Error(t, err)
+	assert.Nil(t, pp)
+}
+
 func TestFactoryCreateLogProcessor(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1146,3 +1202,86 @@ func Test_FactoryWithFunctions_CreateMetrics(t *testing.T) {
 		})
 	}
 }
+
+func Test_FactoryWithFunctions_CreateProfiles(t *testing.T) {
+	type testCase struct {
+		name           string
+		statements     []common.ContextStatements
+		factoryOptions []FactoryOption
+		wantErrorWith  string
+	}
+
+	tests := []testCase{
+		{
+			name: "with profile sample functions : statement with added profile sample func",
+			statements: []common.ContextStatements{
+				{
+					Context:    common.ContextID("profilesample"),
+					Statements: []string{`set(cache["attr"], TestProfileSampleFunc())`},
+				},
+			},
+			factoryOptions: []FactoryOption{
+				WithProfileSampleFunctions(DefaultProfileSampleFunctions()),
+				WithProfileSampleFunctions([]ottl.Factory[ottlprofilesample.TransformContext]{createTestFuncFactory[ottlprofilesample.TransformContext]("TestProfileSampleFunc")}),
+			},
+		},
+		{
+			name: "with profile sample functions : statement with missing profile sample func",
+			statements: []common.ContextStatements{
+				{
+					Context:    common.ContextID("profilesample"),
+					Statements: []string{`set(cache["attr"], TestProfileSampleFunc())`},
+				},
+			},
+			wantErrorWith: `undefined function "TestProfileSampleFunc"`,
+			factoryOptions: []FactoryOption{
+				WithProfileSampleFunctions(DefaultProfileSampleFunctions()),
+			},
+		},
+		{
+			name: "with profile sample functions : missing default functions",
+			statements: []common.ContextStatements{
+				{
+					Context:    common.ContextID("profilesample"),
+					Statements: []string{`set(cache["attr"], TestProfileSampleFunc())`},
+				},
+			},
+			wantErrorWith: `undefined function "set"`,
+			factoryOptions: []FactoryOption{
+				WithProfileSampleFunctions([]ottl.Factory[ottlprofilesample.TransformContext]{createTestFuncFactory[ottlprofilesample.TransformContext]("TestProfileSampleFunc")}),
+			},
+		},
+		{
+			name: "with profile functions : profile sample functions are not affected",
+			statements: []common.ContextStatements{
+				{
+					Context:    common.ContextID("profilesample"),
+					Statements: []string{`set(cache["attr"], "value")`},
+				},
+			},
+			factoryOptions: []FactoryOption{
+				WithProfileFunctions(nil),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory := NewFactoryWithOptions(tt.factoryOptions...)
+			cfg := factory.CreateDefaultConfig()
+			oCfg := cfg.(*Config)
+			oCfg.ErrorMode = ottl.IgnoreError
+			oCfg.ProfileStatements = tt.statements
+
+			_, err := factory.(xprocessor.Factory).CreateProfiles(context.Background(), processortest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+			if tt.wantErrorWith != "" {
+				if err == nil {
+					t.Errorf("expected error containing '%s', got: <nil>", tt.wantErrorWith)
+				}
+				assert.Contains(t, err.Error(), tt.wantErrorWith)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
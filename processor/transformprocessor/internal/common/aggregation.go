@@ -0,0 +1,464 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllog"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+)
+
+type AggregationBoundary string
+
+const (
+	// ScopeBoundary groups the log records of a ScopeLogs.
+	ScopeBoundary AggregationBoundary = "scope"
+	// ResourceBoundary groups the log records of a ResourceLogs.
+	ResourceBoundary AggregationBoundary = "resource"
+)
+
+func (b *AggregationBoundary) UnmarshalText(text []byte) error {
+	str := AggregationBoundary(strings.ToLower(string(text)))
+	switch str {
+	case ScopeBoundary, ResourceBoundary:
+		*b = str
+		return nil
+	default:
+		return fmt.Errorf("unknown aggregation boundary %v", str)
+	}
+}
+
+type AggregationOutput string
+
+const (
+	// RecordsOutput writes the reductions of a group to each of its log records.
+	RecordsOutput AggregationOutput = "records"
+	// NewRecordOutput writes the reductions of a group to a new log record.
+	NewRecordOutput AggregationOutput = "new_record"
+)
+
+func (o *AggregationOutput) UnmarshalText(text []byte) error {
+	str := AggregationOutput(strings.ToLower(string(text)))
+	switch str {
+	case RecordsOutput, NewRecordOutput:
+		*o = str
+		return nil
+	default:
+		return fmt.Errorf("unknown aggregation output %v", str)
+	}
+}
+
+type ReductionFunction string
+
+const (
+	// FirstReduction keeps the first value of a group that isn't nil.
+	FirstReduction ReductionFunction = "first"
+	// LastReduction keeps the last value of a group that isn't nil.
+	LastReduction ReductionFunction = "last"
+	// ConcatReduction concatenates the string representation of the values of a group that aren't nil.
+	ConcatReduction ReductionFunction = "concat"
+	// CountReduction counts the log records of a group, or its values that aren't nil.
+	CountReduction ReductionFunction = "count"
+)
+
+func (f *ReductionFunction) UnmarshalText(text []byte) error {
+	str := ReductionFunction(strings.ToLower(string(text)))
+	switch str {
+	case FirstReduction, LastReduction, ConcatReduction, CountReduction:
+		*f = str
+		return nil
+	default:
+		return fmt.Errorf("unknown reduction function %v", str)
+	}
+}
+
+// LogAggregation groups the log records of a batch sharing the same key, and writes reductions
+// of the values of the records of each group back to them or to a new log record.
+type LogAggregation struct {
+	// GroupBy is the list of OTTL value expressions making the key of a log record. Records for
+	// which any of the expressions is nil are not aggregated.
+	GroupBy []string `mapstructure:"group_by"`
+	// Conditions is a list of OTTL conditions selecting the aggregated log records. When empty,
+	// all the log records are aggregated.
+	Conditions []string `mapstructure:"conditions"`
+	// Boundary is the level at which the log records are grouped, either `scope` or `resource`.
+	// The default value is `scope`.
+	Boundary AggregationBoundary `mapstructure:"boundary"`
+	// Reductions is the list of values computed for each group.
+	Reductions []Reduction `mapstructure:"reductions"`
+	// Output is where the reductions are written, either `records` or `new_record`.
+	// The default value is `records`.
+	Output AggregationOutput `mapstructure:"output"`
+}
+
+// Reduction computes a value from the log records of a group and writes it to a path.
+type Reduction struct {
+	// Function is the reduction function, one of `first`, `last`, `concat` or `count`.
+	Function ReductionFunction `mapstructure:"function"`
+	// Value is the OTTL value expression reduced. It is optional for the `count` function.
+	Value string `mapstructure:"value"`
+	// Separator is the separator placed between the values concatenated by the `concat` function.
+	Separator string `mapstructure:"separator"`
+	// Target is the OTTL path the result of the reduction is written to.
+	Target string `mapstructure:"target"`
+}
+
+// aggregatedValueKey is the context key holding the value set by the target statements of the reductions.
+type aggregatedValueKey struct{}
+
+// aggregatedValueFunctions are the functions available to the statements setting the result of
+// a reduction: set, and the AggregatedValue converter returning the result.
+func aggregatedValueFunctions() map[string]ottl.Factory[ottllog.TransformContext] {
+	return ottl.CreateFactoryMap(
+		ottlfuncs.NewSetFactory[ottllog.TransformContext](),
+		ottl.NewFactory("AggregatedValue", nil, func(ottl.FunctionContext, ottl.Arguments) (ottl.ExprFunc[ottllog.TransformContext], error) {
+			return func(ctx context.Context, _ ottllog.TransformContext) (any, error) {
+				return ctx.Value(aggregatedValueKey{}), nil
+			}, nil
+		}),
+	)
+}
+
+// LogAggregator executes a LogAggregation on batches of logs.
+type LogAggregator struct {
+	groupBy    []*ottl.ValueExpression[ottllog.TransformContext]
+	condition  *ottl.ConditionSequence[ottllog.TransformContext]
+	boundary   AggregationBoundary
+	reductions []reducer
+	output     AggregationOutput
+	errorMode  ottl.ErrorMode
+	logger     *zap.Logger
+}
+
+type reducer struct {
+	function  ReductionFunction
+	value     *ottl.ValueExpression[ottllog.TransformContext]
+	separator string
+	target    *ottl.Statement[ottllog.TransformContext]
+}
+
+// NewLogAggregator returns a LogAggregator executing the given LogAggregation. The paths of its
+// expressions must be prefixed with their context, such as `log.attributes`.
+func NewLogAggregator(aggregation LogAggregation, errorMode ottl.ErrorMode, settings component.TelemetrySettings, functions map[string]ottl.Factory[ottllog.TransformContext]) (*LogAggregator, error) {
+	if len(aggregation.GroupBy) == 0 {
+		return nil, errors.New("an aggregation must have at least one group_by expression")
+	}
+	if len(aggregation.Reductions) == 0 {
+		return nil, errors.New("an aggregation must have at least one reduction")
+	}
+	parser, err := ottllog.NewParser(functions, settings, ottllog.EnablePathContextNames())
+	if err != nil {
+		return nil, err
+	}
+	targetParser, err := ottllog.NewParser(aggregatedValueFunctions(), settings, ottllog.EnablePathContextNames())
+	if err != nil {
+		return nil, err
+	}
+
+	a := &LogAggregator{
+		boundary:  aggregation.Boundary,
+		output:    aggregation.Output,
+		errorMode: errorMode,
+		logger:    settings.Logger,
+	}
+	if a.boundary == "" {
+		a.boundary = ScopeBoundary
+	}
+	if a.output == "" {
+		a.output = RecordsOutput
+	}
+	a.groupBy, err = parser.ParseValueExpressions(aggregation.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	if len(aggregation.Conditions) > 0 {
+		conditions, err := parser.ParseConditions(aggregation.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		condition := ottl.NewConditionSequence(conditions, settings, ottl.WithConditionSequenceErrorMode[ottllog.TransformContext](errorMode))
+		a.condition = &condition
+	}
+
+	var errs error
+	for _, r := range aggregation.Reductions {
+		red, err := newReducer(r, &parser, &targetParser)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		a.reductions = append(a.reductions, red)
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return a, nil
+}
+
+func newReducer(r Reduction, parser, targetParser *ottl.Parser[ottllog.TransformContext]) (reducer, error) {
+	red := reducer{function: r.Function, separator: r.Separator}
+	if r.Function == "" {
+		return reducer{}, fmt.Errorf("missing function for the reduction targeting %q", r.Target)
+	}
+	if r.Value == "" && r.Function != CountReduction {
+		return reducer{}, fmt.Errorf("the %s reduction targeting %q requires a value", r.Function, r.Target)
+	}
+	if r.Value != "" {
+		value, err := parser.ParseValueExpression(r.Value)
+		if err != nil {
+			return reducer{}, fmt.Errorf("unable to parse OTTL value expression %q: %w", r.Value, err)
+		}
+		red.value = value
+	}
+	if r.Target == "" {
+		return reducer{}, fmt.Errorf("missing target for the %s reduction", r.Function)
+	}
+	target, err := targetParser.ParseStatement(fmt.Sprintf("set(%s, AggregatedValue())", r.Target))
+	if err != nil {
+		return reducer{}, fmt.Errorf("invalid reduction target %q: %w", r.Target, err)
+	}
+	red.target = target
+	return red, nil
+}
+
+// aggregationGroup holds the log records of a group and the state of its reductions.
+type aggregationGroup struct {
+	records []ottllog.TransformContext
+	results []reduction
+	// scopeLogs and resourceLogs hold the first log record of the group.
+	scopeLogs    plog.ScopeLogs
+	resourceLogs plog.ResourceLogs
+}
+
+type reduction struct {
+	value any
+	parts []string
+	count int64
+}
+
+func (l *LogAggregator) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		rlogs := ld.ResourceLogs().At(i)
+		var groups []*aggregationGroup
+		index := map[string]*aggregationGroup{}
+		for j := 0; j < rlogs.ScopeLogs().Len(); j++ {
+			slogs := rlogs.ScopeLogs().At(j)
+			logs := slogs.LogRecords()
+			for k := 0; k < logs.Len(); k++ {
+				tCtx := ottllog.NewTransformContext(logs.At(k), slogs.Scope(), rlogs.Resource(), slogs, rlogs)
+				var err error
+				groups, err = l.addRecord(ctx, tCtx, slogs, rlogs, index, groups)
+				if err != nil {
+					return err
+				}
+			}
+			if l.boundary == ScopeBoundary {
+				if err := l.writeResults(ctx, groups); err != nil {
+					return err
+				}
+				groups = nil
+				clear(index)
+			}
+		}
+		if err := l.writeResults(ctx, groups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addRecord adds a log record to its group, creating the group if needed.
+func (l *LogAggregator) addRecord(ctx context.Context, tCtx ottllog.TransformContext, slogs plog.ScopeLogs, rlogs plog.ResourceLogs, index map[string]*aggregationGroup, groups []*aggregationGroup) ([]*aggregationGroup, error) {
+	if l.condition != nil {
+		matched, err := l.condition.Eval(ctx, tCtx)
+		if err != nil || !matched {
+			return groups, err
+		}
+	}
+	key, err := l.groupKey(ctx, tCtx)
+	if err != nil || key == nil {
+		return groups, l.handleError(err)
+	}
+	group, ok := index[*key]
+	if !ok {
+		group = &aggregationGroup{
+			results:      make([]reduction, len(l.reductions)),
+			scopeLogs:    slogs,
+			resourceLogs: rlogs,
+		}
+		index[*key] = group
+		groups = append(groups, group)
+	}
+	group.records = append(group.records, tCtx)
+	for i, r := range l.reductions {
+		if err := r.add(ctx, tCtx, &group.results[i]); err != nil {
+			if err = l.handleError(err); err != nil {
+				return groups, err
+			}
+		}
+	}
+	return groups, nil
+}
+
+// groupKey returns the key of a log record, or nil if any of the group_by expressions is nil.
+func (l *LogAggregator) groupKey(ctx context.Context, tCtx ottllog.TransformContext) (*string, error) {
+	var key strings.Builder
+	for _, expr := range l.groupBy {
+		val, err := expr.Eval(ctx, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		part, ok := groupKeyPart(val)
+		if !ok {
+			return nil, nil
+		}
+		// The parts are prefixed with their length so that different keys can't collide.
+		key.WriteString(strconv.Itoa(len(part)))
+		key.WriteByte(':')
+		key.WriteString(part)
+	}
+	result := key.String()
+	return &result, nil
+}
+
+func isNilValue(val any) bool {
+	v, ok := val.(pcommon.Value)
+	return val == nil || ok && v.Type() == pcommon.ValueTypeEmpty
+}
+
+func groupKeyPart(val any) (string, bool) {
+	if isNilValue(val) {
+		return "", false
+	}
+	switch v := val.(type) {
+	case pcommon.Value:
+		return v.Type().String() + v.AsString(), true
+	case pcommon.Map:
+		return "Map" + fmt.Sprint(v.AsRaw()), true
+	case pcommon.Slice:
+		return "Slice" + fmt.Sprint(v.AsRaw()), true
+	default:
+		return fmt.Sprintf("%T%v", v, v), true
+	}
+}
+
+func (r reducer) add(ctx context.Context, tCtx ottllog.TransformContext, result *reduction) error {
+	if r.value == nil {
+		result.count++
+		return nil
+	}
+	val, err := r.value.Eval(ctx, tCtx)
+	if err != nil {
+		return err
+	}
+	if isNilValue(val) {
+		return nil
+	}
+	switch r.function {
+	case FirstReduction:
+		if result.value == nil {
+			result.value = detachValue(val)
+		}
+	case LastReduction:
+		result.value = detachValue(val)
+	case ConcatReduction:
+		str, err := ottl.StandardStringLikeGetter[any]{
+			Getter: func(context.Context, any) (any, error) {
+				return val, nil
+			},
+		}.Get(ctx, nil)
+		if err != nil {
+			return err
+		}
+		result.parts = append(result.parts, *str)
+	case CountReduction:
+		result.count++
+	}
+	return nil
+}
+
+func (r reducer) result(result *reduction) any {
+	switch r.function {
+	case ConcatReduction:
+		return strings.Join(result.parts, r.separator)
+	case CountReduction:
+		return result.count
+	default:
+		return result.value
+	}
+}
+
+// detachValue copies the values referencing the data of a log record, which may be modified
+// before the result of the reduction is written.
+func detachValue(val any) any {
+	switch v := val.(type) {
+	case pcommon.Value:
+		detached := pcommon.NewValueEmpty()
+		v.CopyTo(detached)
+		return detached
+	case pcommon.Map:
+		detached := pcommon.NewMap()
+		v.CopyTo(detached)
+		return detached
+	case pcommon.Slice:
+		detached := pcommon.NewSlice()
+		v.CopyTo(detached)
+		return detached
+	case pcommon.ByteSlice:
+		detached := pcommon.NewByteSlice()
+		v.CopyTo(detached)
+		return detached
+	default:
+		return v
+	}
+}
+
+// writeResults writes the results of the reductions of the groups to their log records, or to
+// new log records appended to the ScopeLogs of the first record of each group.
+func (l *LogAggregator) writeResults(ctx context.Context, groups []*aggregationGroup) error {
+	for _, group := range groups {
+		records := group.records
+		if l.output == NewRecordOutput {
+			slogs, rlogs := group.scopeLogs, group.resourceLogs
+			records = []ottllog.TransformContext{
+				ottllog.NewTransformContext(slogs.LogRecords().AppendEmpty(), slogs.Scope(), rlogs.Resource(), slogs, rlogs),
+			}
+		}
+		for i, r := range l.reductions {
+			valueCtx := context.WithValue(ctx, aggregatedValueKey{}, r.result(&group.results[i]))
+			for _, tCtx := range records {
+				if _, _, err := r.target.Execute(valueCtx, tCtx); err != nil {
+					if err = l.handleError(err); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (l *LogAggregator) handleError(err error) error {
+	if err == nil {
+		return nil
+	}
+	switch l.errorMode {
+	case ottl.PropagateError:
+		return fmt.Errorf("failed to aggregate logs: %w", err)
+	case ottl.IgnoreError:
+		l.logger.Warn("failed to aggregate logs", zap.Error(err))
+	}
+	return nil
+}
@@ -15,19 +15,21 @@ var _ ottl.StatementsGetter = (*ContextStatements)(nil)
 type ContextID string
 
 const (
-	Resource  ContextID = "resource"
-	Scope     ContextID = "scope"
-	Span      ContextID = "span"
-	SpanEvent ContextID = "spanevent"
-	Metric    ContextID = "metric"
-	DataPoint ContextID = "datapoint"
-	Log       ContextID = "log"
+	Resource      ContextID = "resource"
+	Scope         ContextID = "scope"
+	Span          ContextID = "span"
+	SpanEvent     ContextID = "spanevent"
+	Metric        ContextID = "metric"
+	DataPoint     ContextID = "datapoint"
+	Log           ContextID = "log"
+	Profile       ContextID = "profile"
+	ProfileSample ContextID = "profilesample"
 )
 
 func (c *ContextID) UnmarshalText(text []byte) error {
 	str := ContextID(strings.ToLower(string(text)))
 	switch str {
-	case Resource, Scope, Span, SpanEvent, Metric, DataPoint, Log:
+	case Resource, Scope, Span, SpanEvent, Metric, DataPoint, Log, Profile, ProfileSample:
 		*c = str
 		return nil
 	default:
@@ -42,12 +44,25 @@ type ContextStatements struct {
 	// ErrorMode determines how the processor reacts to errors that occur while processing
 	// this group of statements. When provided, it overrides the default Config ErrorMode.
 	ErrorMode ottl.ErrorMode `mapstructure:"error_mode"`
+
+	// telemetry records the execution of the statements, if set.
+	telemetry *StatementTelemetry
 }
 
 func (c ContextStatements) GetStatements() []string {
 	return c.Statements
 }
 
+// StatementTelemetryConfig configures the telemetry recorded for each statement of the processor.
+type StatementTelemetryConfig struct {
+	// Metrics enables the statement metrics: the number of executions, condition matches and errors,
+	// and the cumulative execution duration of each statement.
+	Metrics bool `mapstructure:"metrics"`
+	// DebugSamplingInterval enables logging the changes made by each statement to one out of every
+	// DebugSamplingInterval items processed by a group of statements. 0 disables the logging.
+	DebugSamplingInterval uint64 `mapstructure:"debug_sampling_interval"`
+}
+
 func toContextStatements(statements any) (*ContextStatements, error) {
 	contextStatements, ok := statements.(ContextStatements)
 	if !ok {
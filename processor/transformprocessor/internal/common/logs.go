@@ -59,7 +59,7 @@ type LogParserCollectionOption ottl.ParserCollectionOption[LogsConsumer]
 
 func WithLogParser(functions map[string]ottl.Factory[ottllog.TransformContext]) LogParserCollectionOption {
 	return func(pc *ottl.ParserCollection[LogsConsumer]) error {
-		logParser, err := ottllog.NewParser(functions, pc.Settings, ottllog.EnablePathContextNames())
+		logParser, err := ottllog.NewParser(functions, pc.Settings, ottllog.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottllog.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -107,7 +107,7 @@ func convertLogStatements(pc *ottl.ParserCollection[LogsConsumer], statements ot
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	lStatements := ottllog.NewStatementSequence(parsedStatements, pc.Settings, ottllog.WithStatementSequenceErrorMode(errorMode))
+	lStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottllog.TransformContext](contextStatements, Log, errorMode)...)
 	return logStatements{lStatements, globalExpr}, nil
 }
 
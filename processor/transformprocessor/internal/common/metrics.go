@@ -169,7 +169,7 @@ type MetricParserCollectionOption ottl.ParserCollectionOption[MetricsConsumer]
 
 func WithMetricParser(functions map[string]ottl.Factory[ottlmetric.TransformContext]) MetricParserCollectionOption {
 	return func(pc *ottl.ParserCollection[MetricsConsumer]) error {
-		metricParser, err := ottlmetric.NewParser(functions, pc.Settings, ottlmetric.EnablePathContextNames())
+		metricParser, err := ottlmetric.NewParser(functions, pc.Settings, ottlmetric.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlmetric.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -179,7 +179,7 @@ func WithMetricParser(functions map[string]ottl.Factory[ottlmetric.TransformCont
 
 func WithDataPointParser(functions map[string]ottl.Factory[ottldatapoint.TransformContext]) MetricParserCollectionOption {
 	return func(pc *ottl.ParserCollection[MetricsConsumer]) error {
-		dataPointParser, err := ottldatapoint.NewParser(functions, pc.Settings, ottldatapoint.EnablePathContextNames())
+		dataPointParser, err := ottldatapoint.NewParser(functions, pc.Settings, ottldatapoint.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottldatapoint.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -227,7 +227,7 @@ func convertMetricStatements(pc *ottl.ParserCollection[MetricsConsumer], stateme
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	mStatements := ottlmetric.NewStatementSequence(parsedStatements, pc.Settings, ottlmetric.WithStatementSequenceErrorMode(errorMode))
+	mStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlmetric.TransformContext](contextStatements, Metric, errorMode)...)
 	return metricStatements{mStatements, globalExpr}, nil
 }
 
@@ -248,7 +248,7 @@ func convertDataPointStatements(pc *ottl.ParserCollection[MetricsConsumer], stat
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	dpStatements := ottldatapoint.NewStatementSequence(parsedStatements, pc.Settings, ottldatapoint.WithStatementSequenceErrorMode(errorMode))
+	dpStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottldatapoint.TransformContext](contextStatements, DataPoint, errorMode)...)
 	return dataPointStatements{dpStatements, globalExpr}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
@@ -83,6 +84,24 @@ func (r resourceStatements) ConsumeLogs(ctx context.Context, ld plog.Logs) error
 	return nil
 }
 
+func (r resourceStatements) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		rprofiles := pd.ResourceProfiles().At(i)
+		tCtx := ottlresource.NewTransformContext(rprofiles.Resource(), rprofiles)
+		condition, err := r.Eval(ctx, tCtx)
+		if err != nil {
+			return err
+		}
+		if condition {
+			err := r.Execute(ctx, tCtx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 var _ baseContext = &scopeStatements{}
 
 type scopeStatements struct {
@@ -157,19 +176,41 @@ func (s scopeStatements) ConsumeLogs(ctx context.Context, ld plog.Logs) error {
 	return nil
 }
 
+func (s scopeStatements) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		rprofiles := pd.ResourceProfiles().At(i)
+		for j := 0; j < rprofiles.ScopeProfiles().Len(); j++ {
+			sprofiles := rprofiles.ScopeProfiles().At(j)
+			tCtx := ottlscope.NewTransformContext(sprofiles.Scope(), rprofiles.Resource(), sprofiles)
+			condition, err := s.Eval(ctx, tCtx)
+			if err != nil {
+				return err
+			}
+			if condition {
+				err := s.Execute(ctx, tCtx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
 type baseContext interface {
 	TracesConsumer
 	MetricsConsumer
 	LogsConsumer
+	ProfilesConsumer
 }
 
 func withCommonContextParsers[R any]() ottl.ParserCollectionOption[R] {
 	return func(pc *ottl.ParserCollection[R]) error {
-		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames())
+		rp, err := ottlresource.NewParser(ResourceFunctions(), pc.Settings, ottlresource.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlresource.TransformContext]())
 		if err != nil {
 			return err
 		}
-		sp, err := ottlscope.NewParser(ScopeFunctions(), pc.Settings, ottlscope.EnablePathContextNames())
+		sp, err := ottlscope.NewParser(ScopeFunctions(), pc.Settings, ottlscope.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlscope.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -209,7 +250,7 @@ func parseResourceContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	rStatements := ottlresource.NewStatementSequence(parsedStatements, pc.Settings, ottlresource.WithStatementSequenceErrorMode(errorMode))
+	rStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlresource.TransformContext](contextStatements, Resource, errorMode)...)
 	result := (baseContext)(resourceStatements{rStatements, globalExpr})
 	return result.(R), nil
 }
@@ -235,7 +276,7 @@ func parseScopeContextStatements[R any](
 	if errGlobalBoolExpr != nil {
 		return *new(R), errGlobalBoolExpr
 	}
-	sStatements := ottlscope.NewStatementSequence(parsedStatements, pc.Settings, ottlscope.WithStatementSequenceErrorMode(errorMode))
+	sStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlscope.TransformContext](contextStatements, Scope, errorMode)...)
 	result := (baseContext)(scopeStatements{sStatements, globalExpr})
 	return result.(R), nil
 }
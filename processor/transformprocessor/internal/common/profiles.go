@@ -0,0 +1,191 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/expr"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/filter/filterottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofile"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottlprofilesample"
+)
+
+type ProfilesConsumer interface {
+	Context() ContextID
+	ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error
+}
+
+type profileStatements struct {
+	ottl.StatementSequence[ottlprofile.TransformContext]
+	expr.BoolExpr[ottlprofile.TransformContext]
+}
+
+func (p profileStatements) Context() ContextID {
+	return Profile
+}
+
+func (p profileStatements) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	dictionary := pd.ProfilesDictionary()
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		rprofiles := pd.ResourceProfiles().At(i)
+		for j := 0; j < rprofiles.ScopeProfiles().Len(); j++ {
+			sprofiles := rprofiles.ScopeProfiles().At(j)
+			profiles := sprofiles.Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				tCtx := ottlprofile.NewTransformContext(profiles.At(k), dictionary, sprofiles.Scope(), rprofiles.Resource(), sprofiles, rprofiles)
+				condition, err := p.Eval(ctx, tCtx)
+				if err != nil {
+					return err
+				}
+				if condition {
+					err := p.Execute(ctx, tCtx)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type profileSampleStatements struct {
+	ottl.StatementSequence[ottlprofilesample.TransformContext]
+	expr.BoolExpr[ottlprofilesample.TransformContext]
+}
+
+func (p profileSampleStatements) Context() ContextID {
+	return ProfileSample
+}
+
+func (p profileSampleStatements) ConsumeProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	dictionary := pd.ProfilesDictionary()
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		rprofiles := pd.ResourceProfiles().At(i)
+		for j := 0; j < rprofiles.ScopeProfiles().Len(); j++ {
+			sprofiles := rprofiles.ScopeProfiles().At(j)
+			profiles := sprofiles.Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				profile := profiles.At(k)
+				samples := profile.Sample()
+				for n := 0; n < samples.Len(); n++ {
+					tCtx := ottlprofilesample.NewTransformContext(samples.At(n), profile, dictionary, sprofiles.Scope(), rprofiles.Resource(), sprofiles, rprofiles)
+					condition, err := p.Eval(ctx, tCtx)
+					if err != nil {
+						return err
+					}
+					if condition {
+						err := p.Execute(ctx, tCtx)
+						if err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type ProfileParserCollection ottl.ParserCollection[ProfilesConsumer]
+
+type ProfileParserCollectionOption ottl.ParserCollectionOption[ProfilesConsumer]
+
+func WithProfileParser(functions map[string]ottl.Factory[ottlprofile.TransformContext]) ProfileParserCollectionOption {
+	return func(pc *ottl.ParserCollection[ProfilesConsumer]) error {
+		parser, err := ottlprofile.NewParser(functions, pc.Settings, ottlprofile.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlprofile.TransformContext]())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottlprofile.ContextName, &parser, ottl.WithStatementConverter(convertProfileStatements))(pc)
+	}
+}
+
+func WithProfileSampleParser(functions map[string]ottl.Factory[ottlprofilesample.TransformContext]) ProfileParserCollectionOption {
+	return func(pc *ottl.ParserCollection[ProfilesConsumer]) error {
+		parser, err := ottlprofilesample.NewParser(functions, pc.Settings, ottlprofilesample.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlprofilesample.TransformContext]())
+		if err != nil {
+			return err
+		}
+		return ottl.WithParserCollectionContext(ottlprofilesample.ContextName, &parser, ottl.WithStatementConverter(convertProfileSampleStatements))(pc)
+	}
+}
+
+func WithProfileErrorMode(errorMode ottl.ErrorMode) ProfileParserCollectionOption {
+	return ProfileParserCollectionOption(ottl.WithParserCollectionErrorMode[ProfilesConsumer](errorMode))
+}
+
+func NewProfileParserCollection(settings component.TelemetrySettings, options ...ProfileParserCollectionOption) (*ProfileParserCollection, error) {
+	pcOptions := []ottl.ParserCollectionOption[ProfilesConsumer]{
+		withCommonContextParsers[ProfilesConsumer](),
+		ottl.EnableParserCollectionModifiedPathsLogging[ProfilesConsumer](true),
+	}
+
+	for _, option := range options {
+		pcOptions = append(pcOptions, ottl.ParserCollectionOption[ProfilesConsumer](option))
+	}
+
+	pc, err := ottl.NewParserCollection(settings, pcOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	ppc := ProfileParserCollection(*pc)
+	return &ppc, nil
+}
+
+func convertProfileStatements(pc *ottl.ParserCollection[ProfilesConsumer], statements ottl.StatementsGetter, parsedStatements []*ottl.Statement[ottlprofile.TransformContext]) (ProfilesConsumer, error) {
+	contextStatements, err := toContextStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	errorMode := pc.ErrorMode
+	if contextStatements.ErrorMode != "" {
+		errorMode = contextStatements.ErrorMode
+	}
+	var parserOptions []ottl.Option[ottlprofile.TransformContext]
+	if contextStatements.Context == "" {
+		parserOptions = append(parserOptions, ottlprofile.EnablePathContextNames())
+	}
+	globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForProfileWithOptions, contextStatements.Conditions, errorMode, pc.Settings, filterottl.StandardProfileFuncs(), parserOptions)
+	if errGlobalBoolExpr != nil {
+		return nil, errGlobalBoolExpr
+	}
+	pStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlprofile.TransformContext](contextStatements, Profile, errorMode)...)
+	return profileStatements{pStatements, globalExpr}, nil
+}
+
+func convertProfileSampleStatements(pc *ottl.ParserCollection[ProfilesConsumer], statements ottl.StatementsGetter, parsedStatements []*ottl.Statement[ottlprofilesample.TransformContext]) (ProfilesConsumer, error) {
+	contextStatements, err := toContextStatements(statements)
+	if err != nil {
+		return nil, err
+	}
+	errorMode := pc.ErrorMode
+	if contextStatements.ErrorMode != "" {
+		errorMode = contextStatements.ErrorMode
+	}
+	var parserOptions []ottl.Option[ottlprofilesample.TransformContext]
+	if contextStatements.Context == "" {
+		parserOptions = append(parserOptions, ottlprofilesample.EnablePathContextNames())
+	}
+	globalExpr, errGlobalBoolExpr := parseGlobalExpr(filterottl.NewBoolExprForProfileSampleWithOptions, contextStatements.Conditions, errorMode, pc.Settings, filterottl.StandardProfileSampleFuncs(), parserOptions)
+	if errGlobalBoolExpr != nil {
+		return nil, errGlobalBoolExpr
+	}
+	psStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlprofilesample.TransformContext](contextStatements, ProfileSample, errorMode)...)
+	return profileSampleStatements{psStatements, globalExpr}, nil
+}
+
+func (ppc *ProfileParserCollection) ParseContextStatements(contextStatements ContextStatements) (ProfilesConsumer, error) {
+	pc := ottl.ParserCollection[ProfilesConsumer](*ppc)
+	if contextStatements.Context != "" {
+		return pc.ParseStatementsWithContext(string(contextStatements.Context), contextStatements, true)
+	}
+	return pc.ParseStatements(contextStatements, ottl.WithContextInferenceConditions(contextStatements.Conditions))
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common // import "github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+)
+
+// StatementTelemetry records the telemetry of the statements executed by the processor.
+type StatementTelemetry struct {
+	// telemetryBuilder is nil when the statement metrics are disabled.
+	telemetryBuilder      *metadata.TelemetryBuilder
+	debugSamplingInterval uint64
+}
+
+// NewStatementTelemetry returns the StatementTelemetry configured by config, or nil when
+// no statement telemetry is enabled.
+func NewStatementTelemetry(config StatementTelemetryConfig, telemetryBuilder *metadata.TelemetryBuilder) *StatementTelemetry {
+	if !config.Metrics && config.DebugSamplingInterval == 0 {
+		return nil
+	}
+	t := &StatementTelemetry{debugSamplingInterval: config.DebugSamplingInterval}
+	if config.Metrics {
+		t.telemetryBuilder = telemetryBuilder
+	}
+	return t
+}
+
+// WithStatementTelemetry returns copies of the given ContextStatements recording their
+// execution with telemetry.
+func WithStatementTelemetry(contextStatements []ContextStatements, telemetry *StatementTelemetry) []ContextStatements {
+	result := make([]ContextStatements, len(contextStatements))
+	for i, cs := range contextStatements {
+		cs.telemetry = telemetry
+		result[i] = cs
+	}
+	return result
+}
+
+// statementSequenceOptions returns the options of the StatementSequence executing the
+// statements of contextStatements in the given context.
+func statementSequenceOptions[K any](contextStatements *ContextStatements, contextID ContextID, errorMode ottl.ErrorMode) []ottl.StatementSequenceOption[K] {
+	options := []ottl.StatementSequenceOption[K]{ottl.WithStatementSequenceErrorMode[K](errorMode)}
+	t := contextStatements.telemetry
+	if t == nil {
+		return options
+	}
+	if t.telemetryBuilder != nil {
+		options = append(options, ottl.WithStatementSequenceExecutionHook[K](t.executionHook(contextID, contextStatements.Statements)))
+	}
+	if t.debugSamplingInterval > 0 {
+		options = append(options, ottl.WithStatementSequenceDiffLogging[K](t.debugSamplingInterval))
+	}
+	return options
+}
+
+// executionHook returns a hook recording the metrics of each execution of the given statements.
+// The attributes of each statement are computed once, so that recording them doesn't allocate.
+func (t *StatementTelemetry) executionHook(contextID ContextID, statements []string) func(context.Context, ottl.StatementExecution) {
+	attrs := make([]metric.MeasurementOption, len(statements))
+	for i, statement := range statements {
+		attrs[i] = statementAttributes(contextID, statement)
+	}
+	tb := t.telemetryBuilder
+	return func(ctx context.Context, execution ottl.StatementExecution) {
+		var attr metric.MeasurementOption
+		if execution.Index < len(attrs) {
+			attr = attrs[execution.Index]
+		} else {
+			attr = statementAttributes(contextID, execution.Statement)
+		}
+		tb.ProcessorTransformStatementExecutions.Add(ctx, 1, attr)
+		if execution.ConditionMatched {
+			tb.ProcessorTransformStatementConditionMatches.Add(ctx, 1, attr)
+		}
+		if execution.Err != nil {
+			tb.ProcessorTransformStatementErrors.Add(ctx, 1, attr)
+		}
+		tb.ProcessorTransformStatementDuration.Add(ctx, execution.Duration.Seconds(), attr)
+	}
+}
+
+func statementAttributes(contextID ContextID, statement string) metric.MeasurementOption {
+	return metric.WithAttributeSet(attribute.NewSet(
+		attribute.String("context", string(contextID)),
+		attribute.String("statement", statement),
+	))
+}
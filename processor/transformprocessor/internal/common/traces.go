@@ -97,7 +97,7 @@ type TraceParserCollectionOption ottl.ParserCollectionOption[TracesConsumer]
 
 func WithSpanParser(functions map[string]ottl.Factory[ottlspan.TransformContext]) TraceParserCollectionOption {
 	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
-		parser, err := ottlspan.NewParser(functions, pc.Settings, ottlspan.EnablePathContextNames())
+		parser, err := ottlspan.NewParser(functions, pc.Settings, ottlspan.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlspan.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -107,7 +107,7 @@ func WithSpanParser(functions map[string]ottl.Factory[ottlspan.TransformContext]
 
 func WithSpanEventParser(functions map[string]ottl.Factory[ottlspanevent.TransformContext]) TraceParserCollectionOption {
 	return func(pc *ottl.ParserCollection[TracesConsumer]) error {
-		parser, err := ottlspanevent.NewParser(functions, pc.Settings, ottlspanevent.EnablePathContextNames())
+		parser, err := ottlspanevent.NewParser(functions, pc.Settings, ottlspanevent.EnablePathContextNames(), ottl.WithExpressionDeduplication[ottlspanevent.TransformContext]())
 		if err != nil {
 			return err
 		}
@@ -155,7 +155,7 @@ func convertSpanStatements(pc *ottl.ParserCollection[TracesConsumer], statements
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	sStatements := ottlspan.NewStatementSequence(parsedStatements, pc.Settings, ottlspan.WithStatementSequenceErrorMode(errorMode))
+	sStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlspan.TransformContext](contextStatements, Span, errorMode)...)
 	return traceStatements{sStatements, globalExpr}, nil
 }
 
@@ -176,7 +176,7 @@ func convertSpanEventStatements(pc *ottl.ParserCollection[TracesConsumer], state
 	if errGlobalBoolExpr != nil {
 		return nil, errGlobalBoolExpr
 	}
-	seStatements := ottlspanevent.NewStatementSequence(parsedStatements, pc.Settings, ottlspanevent.WithStatementSequenceErrorMode(errorMode))
+	seStatements := ottl.NewStatementSequence(parsedStatements, pc.Settings, statementSequenceOptions[ottlspanevent.TransformContext](contextStatements, SpanEvent, errorMode)...)
 	return spanEventStatements{seStatements, globalExpr}, nil
 }
 
@@ -18,12 +18,13 @@ import (
 )
 
 type Processor struct {
-	contexts []common.LogsConsumer
-	logger   *zap.Logger
-	flatMode bool
+	contexts    []common.LogsConsumer
+	aggregators []*common.LogAggregator
+	logger      *zap.Logger
+	flatMode    bool
 }
 
-func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, flatMode bool, settings component.TelemetrySettings, logFunctions map[string]ottl.Factory[ottllog.TransformContext]) (*Processor, error) {
+func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.ErrorMode, flatMode bool, settings component.TelemetrySettings, logFunctions map[string]ottl.Factory[ottllog.TransformContext], aggregations []common.LogAggregation) (*Processor, error) {
 	pc, err := common.NewLogParserCollection(settings, common.WithLogParser(logFunctions), common.WithLogErrorMode(errorMode))
 	if err != nil {
 		return nil, err
@@ -39,18 +40,45 @@ func NewProcessor(contextStatements []common.ContextStatements, errorMode ottl.E
 		contexts[i] = context
 	}
 
+	aggregators := make([]*common.LogAggregator, len(aggregations))
+	for i, aggregation := range aggregations {
+		aggregator, err := common.NewLogAggregator(aggregation, errorMode, settings, logFunctions)
+		if err != nil {
+			errors = multierr.Append(errors, err)
+		}
+		aggregators[i] = aggregator
+	}
+
 	if errors != nil {
 		return nil, errors
 	}
 
 	return &Processor{
-		contexts: contexts,
-		logger:   settings.Logger,
-		flatMode: flatMode,
+		contexts:    contexts,
+		aggregators: aggregators,
+		logger:      settings.Logger,
+		flatMode:    flatMode,
 	}, nil
 }
 
 func (p *Processor) ProcessLogs(ctx context.Context, ld plog.Logs) (plog.Logs, error) {
+	if err := p.executeStatements(ctx, ld); err != nil {
+		return ld, err
+	}
+
+	// The aggregations are executed after the logs have been regrouped, when flatMode is enabled,
+	// so that the log records sharing a scope are aggregated together.
+	for _, a := range p.aggregators {
+		err := a.ConsumeLogs(ctx, ld)
+		if err != nil {
+			p.logger.Error("failed aggregating logs", zap.Error(err))
+			return ld, err
+		}
+	}
+	return ld, nil
+}
+
+func (p *Processor) executeStatements(ctx context.Context, ld plog.Logs) error {
 	if p.flatMode {
 		pdatautil.FlattenLogs(ld.ResourceLogs())
 		defer pdatautil.GroupByResourceLogs(ld.ResourceLogs())
@@ -60,8 +88,8 @@ func (p *Processor) ProcessLogs(ctx context.Context, ld plog.Logs) (plog.Logs, e
 		err := c.ConsumeLogs(ctx, ld)
 		if err != nil {
 			p.logger.Error("failed processing logs", zap.Error(err))
-			return ld, err
+			return err
 		}
 	}
-	return ld, nil
+	return nil
 }
@@ -60,7 +60,7 @@ func Test_ProcessLogs_ResourceContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "resource", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "resource", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -101,7 +101,7 @@ func Test_ProcessLogs_InferredResourceContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -142,7 +142,7 @@ func Test_ProcessLogs_ScopeContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "scope", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "scope", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -183,7 +183,7 @@ func Test_ProcessLogs_InferredScopeContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -437,7 +437,7 @@ func Test_ProcessLogs_LogContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "log", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "log", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -691,7 +691,7 @@ func Test_ProcessLogs_InferredLogContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.statement, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "", Statements: []string{tt.statement}}}, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -808,7 +808,7 @@ func Test_ProcessLogs_MixContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -898,7 +898,7 @@ func Test_ProcessLogs_InferredMixContext(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -931,7 +931,7 @@ func Test_ProcessLogs_ErrorMode(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(string(tt.context), func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor([]common.ContextStatements{{Context: tt.context, Statements: []string{`set(attributes["test"], ParseJSON(1))`}}}, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor([]common.ContextStatements{{Context: tt.context, Statements: []string{`set(attributes["test"], ParseJSON(1))`}}}, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -1013,7 +1013,7 @@ func Test_ProcessLogs_StatementsErrorMode(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor(tt.statements, tt.errorMode, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor(tt.statements, tt.errorMode, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 			_, err = processor.ProcessLogs(context.Background(), td)
 			if tt.wantErrorWith != "" {
@@ -1140,7 +1140,7 @@ func Test_ProcessLogs_CacheAccess(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor(tt.statements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor(tt.statements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -1154,6 +1154,69 @@ func Test_ProcessLogs_CacheAccess(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_Variables(t *testing.T) {
+	tests := []struct {
+		name       string
+		statements []common.ContextStatements
+		want       func(td plog.Logs)
+	}{
+		{
+			name: "inferred context",
+			statements: []common.ContextStatements{
+				{
+					Statements: []string{
+						`let method = ToUpperCase(log.attributes["http.method"])`,
+						`let health = log.attributes["http.path"] == "/health"`,
+						`set(log.attributes["method"], method) where health == true`,
+						`set(log.attributes["test"], Concat([method, log.body], " "))`,
+					},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("method", "GET")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("test", "GET operationA")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("method", "GET")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("test", "GET operationB")
+			},
+		},
+		{
+			name: "log context",
+			statements: []common.ContextStatements{
+				{
+					Context: common.Log,
+					Statements: []string{
+						`let flags = Split(attributes["flags"], "|")`,
+						`set(attributes["first_flag"], flags[0])`,
+						`set(attributes["flags_count"], Len(flags))`,
+					},
+				},
+			},
+			want: func(td plog.Logs) {
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutStr("first_flag", "A")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0).Attributes().PutInt("flags_count", 3)
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutStr("first_flag", "C")
+				td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(1).Attributes().PutInt("flags_count", 2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			td := constructLogs()
+			processor, err := NewProcessor(tt.statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessLogs(context.Background(), td)
+			require.NoError(t, err)
+
+			exTd := constructLogs()
+			tt.want(exTd)
+
+			assert.Equal(t, exTd, td)
+		})
+	}
+}
+
 func Test_ProcessLogs_InferredContextFromConditions(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -1190,7 +1253,7 @@ func Test_ProcessLogs_InferredContextFromConditions(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			td := constructLogs()
-			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+			processor, err := NewProcessor(tt.contextStatements, ottl.IgnoreError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 			assert.NoError(t, err)
 
 			_, err = processor.ProcessLogs(context.Background(), td)
@@ -1204,6 +1267,224 @@ func Test_ProcessLogs_InferredContextFromConditions(t *testing.T) {
 	}
 }
 
+func Test_ProcessLogs_Aggregations(t *testing.T) {
+	constructAggregationLogs := func() plog.Logs {
+		ld := plog.NewLogs()
+		rl := ld.ResourceLogs().AppendEmpty()
+		// The attributes are listed as key/value pairs to keep their order deterministic.
+		for _, scope := range [][][]string{
+			{
+				{"request.id", "a", "user.id", "u1", "msg", "start"},
+				{"request.id", "b", "msg", "start"},
+				{"request.id", "a", "msg", "end"},
+			},
+			{
+				{"request.id", "a", "user.id", "u2", "msg", "retry"},
+				{"msg", "orphan"},
+			},
+		} {
+			logs := rl.ScopeLogs().AppendEmpty().LogRecords()
+			for _, attrs := range scope {
+				lr := logs.AppendEmpty()
+				for i := 0; i < len(attrs); i += 2 {
+					lr.Attributes().PutStr(attrs[i], attrs[i+1])
+				}
+			}
+		}
+		return ld
+	}
+	record := func(ld plog.Logs, scope, index int) pcommon.Map {
+		return ld.ResourceLogs().At(0).ScopeLogs().At(scope).LogRecords().At(index).Attributes()
+	}
+
+	tests := []struct {
+		name        string
+		aggregation common.LogAggregation
+		want        func(ld plog.Logs)
+	}{
+		{
+			name: "first and count written to the records of each scope",
+			aggregation: common.LogAggregation{
+				GroupBy: []string{`log.attributes["request.id"]`},
+				Reductions: []common.Reduction{
+					{Function: common.FirstReduction, Value: `log.attributes["user.id"]`, Target: `log.attributes["user.id"]`},
+					{Function: common.CountReduction, Target: `log.attributes["request.size"]`},
+				},
+			},
+			want: func(ld plog.Logs) {
+				record(ld, 0, 0).PutInt("request.size", 2)
+				record(ld, 0, 1).PutInt("request.size", 1)
+				record(ld, 0, 2).PutStr("user.id", "u1")
+				record(ld, 0, 2).PutInt("request.size", 2)
+				record(ld, 1, 0).PutInt("request.size", 1)
+			},
+		},
+		{
+			name: "last and concat with resource boundary",
+			aggregation: common.LogAggregation{
+				GroupBy:  []string{`log.attributes["request.id"]`},
+				Boundary: common.ResourceBoundary,
+				Reductions: []common.Reduction{
+					{Function: common.LastReduction, Value: `log.attributes["user.id"]`, Target: `log.attributes["last.user.id"]`},
+					{Function: common.ConcatReduction, Value: `log.attributes["msg"]`, Separator: ",", Target: `log.attributes["messages"]`},
+				},
+			},
+			want: func(ld plog.Logs) {
+				for _, r := range []pcommon.Map{record(ld, 0, 0), record(ld, 0, 2), record(ld, 1, 0)} {
+					r.PutStr("last.user.id", "u2")
+					r.PutStr("messages", "start,end,retry")
+				}
+				record(ld, 0, 1).PutStr("messages", "start")
+			},
+		},
+		{
+			name: "conditions",
+			aggregation: common.LogAggregation{
+				GroupBy:    []string{`log.attributes["request.id"]`},
+				Conditions: []string{`log.attributes["msg"] != "end"`},
+				Boundary:   common.ResourceBoundary,
+				Reductions: []common.Reduction{
+					{Function: common.CountReduction, Target: `log.attributes["request.size"]`},
+				},
+			},
+			want: func(ld plog.Logs) {
+				record(ld, 0, 0).PutInt("request.size", 2)
+				record(ld, 0, 1).PutInt("request.size", 1)
+				record(ld, 1, 0).PutInt("request.size", 2)
+			},
+		},
+		{
+			name: "new record",
+			aggregation: common.LogAggregation{
+				GroupBy: []string{`log.attributes["request.id"]`},
+				Output:  common.NewRecordOutput,
+				Reductions: []common.Reduction{
+					{Function: common.FirstReduction, Value: `log.attributes["request.id"]`, Target: `log.attributes["request.id"]`},
+					{Function: common.ConcatReduction, Value: `log.attributes["msg"]`, Separator: " ", Target: `log.body`},
+				},
+			},
+			want: func(ld plog.Logs) {
+				scope := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+				lr := scope.AppendEmpty()
+				lr.Attributes().PutStr("request.id", "a")
+				lr.Body().SetStr("start end")
+				lr = scope.AppendEmpty()
+				lr.Attributes().PutStr("request.id", "b")
+				lr.Body().SetStr("start")
+				lr = ld.ResourceLogs().At(0).ScopeLogs().At(1).LogRecords().AppendEmpty()
+				lr.Attributes().PutStr("request.id", "a")
+				lr.Body().SetStr("retry")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ld := constructAggregationLogs()
+			processor, err := NewProcessor(nil, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, []common.LogAggregation{tt.aggregation})
+			require.NoError(t, err)
+
+			_, err = processor.ProcessLogs(context.Background(), ld)
+			require.NoError(t, err)
+
+			expected := constructAggregationLogs()
+			tt.want(expected)
+			assert.Equal(t, expected, ld)
+		})
+	}
+}
+
+func Test_ProcessLogs_AggregationsAfterStatements(t *testing.T) {
+	statements := []common.ContextStatements{
+		{
+			Context:    "log",
+			Statements: []string{`set(attributes["group"], "all")`},
+		},
+	}
+	aggregations := []common.LogAggregation{
+		{
+			GroupBy: []string{`log.attributes["group"]`},
+			Reductions: []common.Reduction{
+				{Function: common.CountReduction, Target: `log.attributes["group.size"]`},
+			},
+		},
+	}
+	processor, err := NewProcessor(statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, aggregations)
+	require.NoError(t, err)
+
+	td := constructLogs()
+	_, err = processor.ProcessLogs(context.Background(), td)
+	require.NoError(t, err)
+
+	logs := td.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords()
+	for i := 0; i < logs.Len(); i++ {
+		size, ok := logs.At(i).Attributes().Get("group.size")
+		require.True(t, ok)
+		assert.Equal(t, int64(logs.Len()), size.Int())
+	}
+}
+
+func Test_NewProcessor_AggregationsParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		aggregation   common.LogAggregation
+		wantErrorWith string
+	}{
+		{
+			name: "missing group_by",
+			aggregation: common.LogAggregation{
+				Reductions: []common.Reduction{{Function: common.CountReduction, Target: `log.attributes["count"]`}},
+			},
+			wantErrorWith: "at least one group_by expression",
+		},
+		{
+			name: "missing reductions",
+			aggregation: common.LogAggregation{
+				GroupBy: []string{`log.attributes["request.id"]`},
+			},
+			wantErrorWith: "at least one reduction",
+		},
+		{
+			name: "missing value",
+			aggregation: common.LogAggregation{
+				GroupBy:    []string{`log.attributes["request.id"]`},
+				Reductions: []common.Reduction{{Function: common.FirstReduction, Target: `log.attributes["first"]`}},
+			},
+			wantErrorWith: "requires a value",
+		},
+		{
+			name: "missing target",
+			aggregation: common.LogAggregation{
+				GroupBy:    []string{`log.attributes["request.id"]`},
+				Reductions: []common.Reduction{{Function: common.CountReduction}},
+			},
+			wantErrorWith: "missing target",
+		},
+		{
+			name: "invalid target",
+			aggregation: common.LogAggregation{
+				GroupBy:    []string{`log.attributes["request.id"]`},
+				Reductions: []common.Reduction{{Function: common.CountReduction, Target: `Len(log.body)`}},
+			},
+			wantErrorWith: "invalid reduction target",
+		},
+		{
+			name: "path without context",
+			aggregation: common.LogAggregation{
+				GroupBy:    []string{`attributes["request.id"]`},
+				Reductions: []common.Reduction{{Function: common.CountReduction, Target: `log.attributes["count"]`}},
+			},
+			wantErrorWith: `missing context name for path "attributes[request.id]"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewProcessor(nil, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, []common.LogAggregation{tt.aggregation})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErrorWith)
+		})
+	}
+}
+
 func Test_NewProcessor_ConditionsParse(t *testing.T) {
 	type testCase struct {
 		name          string
@@ -1260,7 +1541,7 @@ func Test_NewProcessor_ConditionsParse(t *testing.T) {
 		t.Run(ctx, func(t *testing.T) {
 			for _, tt := range tests {
 				t.Run(tt.name, func(t *testing.T) {
-					_, err := NewProcessor(tt.statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions)
+					_, err := NewProcessor(tt.statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), DefaultLogFunctions, nil)
 					if tt.wantErrorWith != "" {
 						if err == nil {
 							t.Errorf("expected error containing '%s', got: <nil>", tt.wantErrorWith)
@@ -1324,7 +1605,7 @@ func Test_NewProcessor_NonDefaultFunctions(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := NewProcessor(tt.statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), tt.logFunctions)
+			_, err := NewProcessor(tt.statements, ottl.PropagateError, false, componenttest.NewNopTelemetrySettings(), tt.logFunctions, nil)
 			if tt.wantErrorWith != "" {
 				if err == nil {
 					t.Errorf("expected error containing '%s', got: <nil>", tt.wantErrorWith)
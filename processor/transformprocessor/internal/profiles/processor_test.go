@@ -0,0 +1,293 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package profiles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
+)
+
+var (
+	DefaultProfileFunctions       = ProfileFunctions()
+	DefaultProfileSampleFunctions = ProfileSampleFunctions()
+)
+
+func Test_ProcessProfiles_ResourceContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(pd pprofile.Profiles)
+	}{
+		{
+			statement: `set(attributes["test"], "pass")`,
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).Resource().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where attributes["host.name"] == "wrong"`,
+			want: func(_ pprofile.Profiles) {
+			},
+		},
+		{
+			statement: `set(schema_url, "test_schema_url")`,
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).SetSchemaUrl("test_schema_url")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "resource", Statements: []string{tt.statement}}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.NoError(t, err)
+
+			exPd := constructProfiles()
+			tt.want(exPd)
+
+			assert.Equal(t, exPd, pd)
+		})
+	}
+}
+
+func Test_ProcessProfiles_ScopeContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(pd pprofile.Profiles)
+	}{
+		{
+			statement: `set(attributes["test"], "pass") where name == "scope"`,
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Scope().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statement: `set(version, "2")`,
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Scope().SetVersion("2")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "scope", Statements: []string{tt.statement}}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.NoError(t, err)
+
+			exPd := constructProfiles()
+			tt.want(exPd)
+
+			assert.Equal(t, exPd, pd)
+		})
+	}
+}
+
+func Test_ProcessProfiles_ProfileContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(pd pprofile.Profiles)
+	}{
+		{
+			statement: `set(original_payload_format, "pprof") where period == 100`,
+			want: func(pd pprofile.Profiles) {
+				getProfile(pd, 0).SetOriginalPayloadFormat("pprof")
+			},
+		},
+		{
+			statement: `set(attributes["test"], "pass") where period == 200`,
+			want: func(pd pprofile.Profiles) {
+				require.NoError(t, pprofile.PutAttribute(pd.ProfilesDictionary().AttributeTable(), getProfile(pd, 1), "test", pcommon.NewValueStr("pass")))
+			},
+		},
+		{
+			statement: `set(period, 300) where resource.attributes["host.name"] == "localhost" and instrumentation_scope.name == "scope"`,
+			want: func(pd pprofile.Profiles) {
+				getProfile(pd, 0).SetPeriod(300)
+				getProfile(pd, 1).SetPeriod(300)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "profile", Statements: []string{tt.statement}}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.NoError(t, err)
+
+			exPd := constructProfiles()
+			tt.want(exPd)
+
+			assert.Equal(t, exPd, pd)
+		})
+	}
+}
+
+func Test_ProcessProfiles_ProfileSampleContext(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      func(pd pprofile.Profiles)
+	}{
+		{
+			statement: `set(attributes["thread.name"], "worker") where values[0] > 15`,
+			want: func(pd pprofile.Profiles) {
+				sample := getProfile(pd, 0).Sample().At(1)
+				require.NoError(t, pprofile.PutAttribute(pd.ProfilesDictionary().AttributeTable(), sample, "thread.name", pcommon.NewValueStr("worker")))
+			},
+		},
+		{
+			statement: `set(locations_length, 0) where profile.period == 200`,
+			want: func(pd pprofile.Profiles) {
+				getProfile(pd, 1).Sample().At(0).SetLocationsLength(0)
+			},
+		},
+		{
+			statement: `set(values, [0]) where attributes["thread.name"] == "main"`,
+			want: func(pd pprofile.Profiles) {
+				getProfile(pd, 0).Sample().At(0).Value().FromRaw([]int64{0})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: "profilesample", Statements: []string{tt.statement}}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.NoError(t, err)
+
+			exPd := constructProfiles()
+			tt.want(exPd)
+
+			assert.Equal(t, exPd, pd)
+		})
+	}
+}
+
+func Test_ProcessProfiles_InferredContext(t *testing.T) {
+	tests := []struct {
+		statements []string
+		conditions []string
+		want       func(pd pprofile.Profiles)
+	}{
+		{
+			statements: []string{`set(resource.attributes["test"], "pass")`},
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).Resource().Attributes().PutStr("test", "pass")
+			},
+		},
+		{
+			statements: []string{`set(profile.period, 1) where profile.period == 200`},
+			want: func(pd pprofile.Profiles) {
+				getProfile(pd, 1).SetPeriod(1)
+			},
+		},
+		{
+			statements: []string{`set(resource.attributes["test"], "pass")`},
+			conditions: []string{`profilesample.values[0] == 10`},
+			want: func(pd pprofile.Profiles) {
+				pd.ResourceProfiles().At(0).Resource().Attributes().PutStr("test", "pass")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.statements[0], func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Statements: tt.statements, Conditions: tt.conditions}}, ottl.IgnoreError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.NoError(t, err)
+
+			exPd := constructProfiles()
+			tt.want(exPd)
+
+			assert.Equal(t, exPd, pd)
+		})
+	}
+}
+
+func Test_ProcessProfiles_ErrorMode(t *testing.T) {
+	tests := []struct {
+		statement string
+		context   common.ContextID
+	}{
+		{
+			statement: `set(attributes["test"], ParseJSON(1))`,
+			context:   "profile",
+		},
+		{
+			statement: `set(attributes["test"], ParseJSON(1))`,
+			context:   "profilesample",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.context), func(t *testing.T) {
+			pd := constructProfiles()
+			processor, err := NewProcessor([]common.ContextStatements{{Context: tt.context, Statements: []string{tt.statement}}}, ottl.PropagateError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+			require.NoError(t, err)
+
+			_, err = processor.ProcessProfiles(context.Background(), pd)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_NewProcessor_InvalidStatements(t *testing.T) {
+	_, err := NewProcessor([]common.ContextStatements{{Context: "profilesample", Statements: []string{`set(unknown, 1)`}}}, ottl.PropagateError, componenttest.NewNopTelemetrySettings(), DefaultProfileFunctions, DefaultProfileSampleFunctions)
+	assert.Error(t, err)
+}
+
+func getProfile(pd pprofile.Profiles, i int) pprofile.Profile {
+	return pd.ResourceProfiles().At(0).ScopeProfiles().At(0).Profiles().At(i)
+}
+
+func constructProfiles() pprofile.Profiles {
+	pd := pprofile.NewProfiles()
+	dictionary := pd.ProfilesDictionary()
+	rp := pd.ResourceProfiles().AppendEmpty()
+	rp.Resource().Attributes().PutStr("host.name", "localhost")
+	sp := rp.ScopeProfiles().AppendEmpty()
+	sp.Scope().SetName("scope")
+	sp.Scope().SetVersion("1")
+
+	profileOne := sp.Profiles().AppendEmpty()
+	profileOne.SetPeriod(100)
+	sampleOne := profileOne.Sample().AppendEmpty()
+	sampleOne.Value().FromRaw([]int64{10})
+	sampleOne.SetLocationsLength(2)
+	_ = pprofile.PutAttribute(dictionary.AttributeTable(), sampleOne, "thread.name", pcommon.NewValueStr("main"))
+	sampleTwo := profileOne.Sample().AppendEmpty()
+	sampleTwo.Value().FromRaw([]int64{20})
+	sampleTwo.SetLocationsLength(1)
+
+	profileTwo := sp.Profiles().AppendEmpty()
+	profileTwo.SetPeriod(200)
+	sampleThree := profileTwo.Sample().AppendEmpty()
+	sampleThree.Value().FromRaw([]int64{5})
+	sampleThree.SetLocationsLength(3)
+	return pd
+}
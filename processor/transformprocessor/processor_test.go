@@ -10,13 +10,22 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/processor/processortest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/common"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/processor/transformprocessor/internal/metadatatest"
 )
 
 func TestFlattenDataDisabledByDefault(t *testing.T) {
@@ -96,6 +105,89 @@ func TestProcessLogsWithFlatten(t *testing.T) {
 	assert.NoError(t, plogtest.CompareLogs(expected, actual[0]))
 }
 
+func TestProcessLogsWithStatementMetrics(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.ErrorMode = ottl.IgnoreError
+	oCfg.StatementTelemetry.Metrics = true
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context: "log",
+			Statements: []string{
+				`set(attributes["checked"], true) where attributes["env"] == "prod"`,
+				`set(attributes["env_size"], Len(attributes["env"]))`,
+			},
+		},
+	}
+	tel := componenttest.NewTelemetry()
+	t.Cleanup(func() { require.NoError(t, tel.Shutdown(context.Background())) })
+	sink := new(consumertest.LogsSink)
+	p, err := factory.CreateLogs(context.Background(), metadatatest.NewSettings(tel), oCfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	logs.AppendEmpty().Attributes().PutStr("env", "prod")
+	logs.AppendEmpty().Attributes().PutInt("env", 1)
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	conditionAttrs := attribute.NewSet(
+		attribute.String("context", "log"),
+		attribute.String("statement", `set(attributes["checked"], true) where attributes["env"] == "prod"`),
+	)
+	lenAttrs := attribute.NewSet(
+		attribute.String("context", "log"),
+		attribute.String("statement", `set(attributes["env_size"], Len(attributes["env"]))`),
+	)
+	metadatatest.AssertEqualProcessorTransformStatementExecutions(t, tel, []metricdata.DataPoint[int64]{
+		{Attributes: conditionAttrs, Value: 2},
+		{Attributes: lenAttrs, Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualProcessorTransformStatementConditionMatches(t, tel, []metricdata.DataPoint[int64]{
+		{Attributes: conditionAttrs, Value: 1},
+		{Attributes: lenAttrs, Value: 2},
+	}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualProcessorTransformStatementErrors(t, tel, []metricdata.DataPoint[int64]{
+		{Attributes: lenAttrs, Value: 1},
+	}, metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualProcessorTransformStatementDuration(t, tel, []metricdata.DataPoint[float64]{
+		{Attributes: conditionAttrs},
+		{Attributes: lenAttrs},
+	}, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreValue())
+}
+
+func TestProcessLogsWithStatementDebugLogging(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig()
+	oCfg := cfg.(*Config)
+	oCfg.StatementTelemetry.DebugSamplingInterval = 2
+	oCfg.LogStatements = []common.ContextStatements{
+		{
+			Context:    "log",
+			Statements: []string{`set(attributes["checked"], true)`},
+		},
+	}
+	core, observed := observer.New(zap.DebugLevel)
+	set := processortest.NewNopSettings(metadata.Type)
+	set.Logger = zap.New(core)
+	sink := new(consumertest.LogsSink)
+	p, err := factory.CreateLogs(context.Background(), set, oCfg, sink)
+	require.NoError(t, err)
+
+	ld := plog.NewLogs()
+	logs := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	for range 3 {
+		logs.AppendEmpty()
+	}
+	require.NoError(t, p.ConsumeLogs(context.Background(), ld))
+
+	// The first and the third log records are sampled.
+	entries := observed.FilterMessage("statement changes").All()
+	require.Len(t, entries, 2)
+	assert.Equal(t, `set(log.attributes["checked"], true)`, entries[0].ContextMap()["statement"])
+}
+
 func BenchmarkLogsWithoutFlatten(b *testing.B) {
 	factory := NewFactory()
 	cfg := factory.CreateDefaultConfig()
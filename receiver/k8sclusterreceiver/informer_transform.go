@@ -7,9 +7,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/daemonset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/httproute"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
@@ -19,7 +23,8 @@ import (
 )
 
 // transformObject transforms the k8s object by removing the data that is not utilized by the receiver.
-// Only highly utilized objects are transformed here while others are kept as is.
+// Only highly utilized objects are transformed here while others are kept as is, except for the objects
+// watched through the dynamic client that are always converted to their internal representation.
 func transformObject(object any) (any, error) {
 	switch o := object.(type) {
 	case *corev1.Pod:
@@ -38,6 +43,13 @@ func transformObject(object any) (any, error) {
 		return statefulset.Transform(o), nil
 	case *corev1.Service:
 		return service.Transform(o), nil
+	case *unstructured.Unstructured:
+		switch o.GroupVersionKind() {
+		case gvk.Gateway:
+			return gateway.Transform(o)
+		case gvk.HTTPRoute:
+			return httproute.Transform(o)
+		}
 	}
 	return object, nil
 }
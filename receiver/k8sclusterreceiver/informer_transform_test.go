@@ -10,7 +10,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/httproute"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
 
@@ -118,6 +121,31 @@ func TestTransformObject(t *testing.T) {
 			want:   testutils.NewHPA("1"),
 			same:   true,
 		},
+		{
+			name:   "gateway",
+			object: testutils.NewGateway("1"),
+			want: func() *gateway.Gateway {
+				gw, _ := gateway.Transform(testutils.NewGateway("1"))
+				return gw
+			}(),
+			same: false,
+		},
+		{
+			name:   "httproute",
+			object: testutils.NewHTTPRoute("1"),
+			want: func() *httproute.HTTPRoute {
+				route, _ := httproute.Transform(testutils.NewHTTPRoute("1"))
+				return route
+			}(),
+			same: false,
+		},
+		{
+			// Unstructured objects of other kinds are kept as is.
+			name:   "unstructured",
+			object: &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "Secret"}},
+			want:   &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "Secret"}},
+			same:   true,
+		},
 		{
 			name:   "invalid_type",
 			object: intPtr,
@@ -32,6 +32,12 @@ const (
 	K8sKindReplicationController = "ReplicationController"
 	K8sKindReplicaSet            = "ReplicaSet"
 	K8sStatefulSet               = "StatefulSet"
+	K8sKindIngress               = "Ingress"
+	K8sKindPersistentVolume      = "PersistentVolume"
+	K8sKindPersistentVolumeClaim = "PersistentVolumeClaim"
+	K8sKindStorageClass          = "StorageClass"
+	K8sKindGateway               = "Gateway"
+	K8sKindHTTPRoute             = "HTTPRoute"
 )
 
 // Keys for K8s metadata
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// k8sGatewayClassName is the metadata key of the class of the gateway.
+const k8sGatewayClassName = "k8s.gatewayclass.name"
+
+// Gateway holds the fields of a Gateway API Gateway used by the receiver. Gateways are watched
+// through the dynamic client, so that the receiver doesn't depend on the Gateway API client, and
+// the unstructured objects are converted to this type by Transform.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Spec   `json:"spec"`
+	Status Status `json:"status,omitempty"`
+}
+
+// Spec holds the fields of the Gateway spec used by the receiver.
+type Spec struct {
+	GatewayClassName string `json:"gatewayClassName"`
+}
+
+// Status holds the fields of the Gateway status used by the receiver.
+type Status struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Transform converts the unstructured Gateway to a Gateway, dropping the fields that we don't use
+// to reduce RAM utilization.
+// IMPORTANT: Make sure to update the Gateway type before using new gateway fields.
+func Transform(obj *unstructured.Unstructured) (*Gateway, error) {
+	gw := &Gateway{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, gw); err != nil {
+		return nil, err
+	}
+	gw.ObjectMeta = metadata.TransformObjectMeta(gw.ObjectMeta)
+	return gw, nil
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, gw *Gateway, ts pcommon.Timestamp) {
+	for _, c := range gw.Status.Conditions {
+		mb.RecordK8sGatewayConditionDataPoint(ts, ConditionValue(c.Status), c.Type)
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(gw.Namespace)
+	rb.SetK8sGatewayName(gw.Name)
+	rb.SetK8sGatewayUID(string(gw.UID))
+	rb.SetK8sGatewayclassName(gw.Spec.GatewayClassName)
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+// ConditionValue returns the value of a Gateway API condition status (true=1, false=0, unknown=-1).
+func ConditionValue(status metav1.ConditionStatus) int64 {
+	switch status {
+	case metav1.ConditionTrue:
+		return 1
+	case metav1.ConditionFalse:
+		return 0
+	default:
+		return -1
+	}
+}
+
+func GetMetadata(gw *Gateway) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&gw.ObjectMeta, constants.K8sKindGateway)
+	km.Metadata[k8sGatewayClassName] = gw.Spec.GatewayClassName
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(gw.UID): km,
+	}
+}
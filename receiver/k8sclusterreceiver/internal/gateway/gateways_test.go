@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gateway
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestTransform(t *testing.T) {
+	gw, err := Transform(testutils.NewGateway("1"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-gateway-1", gw.Name)
+	assert.Equal(t, "test-namespace", gw.Namespace)
+	assert.Equal(t, "test-gateway-1-uid", string(gw.UID))
+	assert.Equal(t, "test-gatewayclass", gw.Spec.GatewayClassName)
+	require.Len(t, gw.Status.Conditions, 2)
+	assert.Equal(t, "Accepted", gw.Status.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionTrue, gw.Status.Conditions[0].Status)
+
+	_, err = Transform(&unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{"gatewayClassName": int64(1)},
+	}})
+	assert.Error(t, err)
+}
+
+func TestGatewayMetrics(t *testing.T) {
+	gw, err := Transform(testutils.NewGateway("1"))
+	require.NoError(t, err)
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sGatewayCondition.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, gw, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+	),
+	)
+}
+
+func TestConditionValue(t *testing.T) {
+	assert.Equal(t, int64(1), ConditionValue(metav1.ConditionTrue))
+	assert.Equal(t, int64(0), ConditionValue(metav1.ConditionFalse))
+	assert.Equal(t, int64(-1), ConditionValue(metav1.ConditionUnknown))
+	assert.Equal(t, int64(-1), ConditionValue(""))
+}
+
+func TestGatewayMetadata(t *testing.T) {
+	gw, err := Transform(testutils.NewGateway("1"))
+	require.NoError(t, err)
+
+	meta := GetMetadata(gw)
+
+	require.Contains(t, meta, experimentalmetricmetadata.ResourceID("test-gateway-1-uid"))
+	km := meta[experimentalmetricmetadata.ResourceID("test-gateway-1-uid")]
+	require.Equal(t, "k8s.gateway", km.EntityType)
+	require.Equal(t, "test-gateway-1", km.Metadata["k8s.workload.name"])
+	require.Equal(t, "test-namespace", km.Metadata["k8s.namespace.name"])
+	require.Equal(t, "test-gatewayclass", km.Metadata["k8s.gatewayclass.name"])
+}
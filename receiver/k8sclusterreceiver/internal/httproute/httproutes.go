@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httproute // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/httproute"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// HTTPRoute holds the fields of a Gateway API HTTPRoute used by the receiver. HTTPRoutes are
+// watched through the dynamic client, and the unstructured objects are converted to this type
+// by Transform.
+type HTTPRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status Status `json:"status,omitempty"`
+}
+
+// Status holds the fields of the HTTPRoute status used by the receiver.
+type Status struct {
+	Parents []ParentStatus `json:"parents,omitempty"`
+}
+
+// ParentStatus holds the status of the route for one of its parents.
+type ParentStatus struct {
+	ParentRef  ParentReference    `json:"parentRef"`
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ParentReference identifies a parent of the route.
+type ParentReference struct {
+	Kind *string `json:"kind,omitempty"`
+	Name string  `json:"name"`
+}
+
+// Transform converts the unstructured HTTPRoute to an HTTPRoute, dropping the fields that we don't
+// use to reduce RAM utilization.
+// IMPORTANT: Make sure to update the HTTPRoute type before using new route fields.
+func Transform(obj *unstructured.Unstructured) (*HTTPRoute, error) {
+	route := &HTTPRoute{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, route); err != nil {
+		return nil, err
+	}
+	route.ObjectMeta = metadata.TransformObjectMeta(route.ObjectMeta)
+	return route, nil
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, route *HTTPRoute, ts pcommon.Timestamp) {
+	for _, parent := range route.Status.Parents {
+		// Routes can also be attached to other kinds of parents, e.g. Services for service meshes.
+		if parent.ParentRef.Kind != nil && *parent.ParentRef.Kind != constants.K8sKindGateway {
+			continue
+		}
+		for _, c := range parent.Conditions {
+			mb.RecordK8sHttprouteConditionDataPoint(ts, gateway.ConditionValue(c.Status), parent.ParentRef.Name, c.Type)
+		}
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(route.Namespace)
+	rb.SetK8sHttprouteName(route.Name)
+	rb.SetK8sHttprouteUID(string(route.UID))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadata(route *HTTPRoute) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(route.UID): metadata.GetGenericMetadata(&route.ObjectMeta, constants.K8sKindHTTPRoute),
+	}
+}
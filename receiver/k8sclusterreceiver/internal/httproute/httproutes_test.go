@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package httproute
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestTransform(t *testing.T) {
+	route, err := Transform(testutils.NewHTTPRoute("1"))
+	require.NoError(t, err)
+	assert.Equal(t, "test-httproute-1", route.Name)
+	assert.Equal(t, "test-namespace", route.Namespace)
+	assert.Equal(t, "test-httproute-1-uid", string(route.UID))
+	require.Len(t, route.Status.Parents, 2)
+	assert.Nil(t, route.Status.Parents[0].ParentRef.Kind)
+	assert.Equal(t, "test-gateway-1", route.Status.Parents[0].ParentRef.Name)
+	assert.Len(t, route.Status.Parents[0].Conditions, 2)
+	require.NotNil(t, route.Status.Parents[1].ParentRef.Kind)
+	assert.Equal(t, "Service", *route.Status.Parents[1].ParentRef.Kind)
+}
+
+func TestHTTPRouteMetrics(t *testing.T) {
+	route, err := Transform(testutils.NewHTTPRoute("1"))
+	require.NoError(t, err)
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sHttprouteCondition.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, route, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+		pmetrictest.IgnoreMetricDataPointsOrder(),
+	),
+	)
+}
+
+func TestHTTPRouteMetadata(t *testing.T) {
+	route, err := Transform(testutils.NewHTTPRoute("1"))
+	require.NoError(t, err)
+
+	meta := GetMetadata(route)
+
+	require.Contains(t, meta, experimentalmetricmetadata.ResourceID("test-httproute-1-uid"))
+	km := meta[experimentalmetricmetadata.ResourceID("test-httproute-1-uid")]
+	require.Equal(t, "k8s.httproute", km.EntityType)
+	require.Equal(t, "test-httproute-1", km.Metadata["k8s.workload.name"])
+	require.Equal(t, "test-namespace", km.Metadata["k8s.namespace.name"])
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// k8sIngressClassName is the metadata key of the class of the ingress.
+const k8sIngressClassName = "k8s.ingress.class_name"
+
+func RecordMetrics(mb *metadata.MetricsBuilder, ing *networkingv1.Ingress, ts pcommon.Timestamp) {
+	mb.RecordK8sIngressRulesDataPoint(ts, int64(len(ing.Spec.Rules)))
+	mb.RecordK8sIngressLoadBalancerAddressesDataPoint(ts, int64(len(ing.Status.LoadBalancer.Ingress)))
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(ing.Namespace)
+	rb.SetK8sIngressName(ing.Name)
+	rb.SetK8sIngressUID(string(ing.UID))
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadata(ing *networkingv1.Ingress) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&ing.ObjectMeta, constants.K8sKindIngress)
+	if ing.Spec.IngressClassName != nil {
+		km.Metadata[k8sIngressClassName] = *ing.Spec.IngressClassName
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(ing.UID): km,
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ingress
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestIngressMetrics(t *testing.T) {
+	ing := testutils.NewIngress("1")
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sIngressRules.Enabled = true
+	mbc.Metrics.K8sIngressLoadBalancerAddresses.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, ing, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestIngressMetadata(t *testing.T) {
+	ing := testutils.NewIngress("1")
+
+	meta := GetMetadata(ing)
+
+	require.Contains(t, meta, experimentalmetricmetadata.ResourceID("test-ingress-1-uid"))
+	km := meta[experimentalmetricmetadata.ResourceID("test-ingress-1-uid")]
+	require.Equal(t, "k8s.ingress", km.EntityType)
+	require.Equal(t, "test-ingress-1", km.Metadata["k8s.workload.name"])
+	require.Equal(t, "test-namespace", km.Metadata["k8s.namespace.name"])
+	require.Equal(t, "nginx", km.Metadata["k8s.ingress.class_name"])
+	require.Equal(t, "bar", km.Metadata["foo"])
+}
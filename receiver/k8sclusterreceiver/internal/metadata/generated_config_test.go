@@ -27,50 +27,59 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: true},
-					K8sContainerCPURequest:              MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: true},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: true},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: true},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: true},
-					K8sContainerReady:                   MetricConfig{Enabled: true},
-					K8sContainerRestarts:                MetricConfig{Enabled: true},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: true},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: true},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: true},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: true},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: true},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: true},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: true},
-					K8sDeploymentDesired:                MetricConfig{Enabled: true},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: true},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: true},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: true},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: true},
-					K8sJobActivePods:                    MetricConfig{Enabled: true},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: true},
-					K8sJobFailedPods:                    MetricConfig{Enabled: true},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: true},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: true},
-					K8sNamespacePhase:                   MetricConfig{Enabled: true},
-					K8sNodeCondition:                    MetricConfig{Enabled: true},
-					K8sPodPhase:                         MetricConfig{Enabled: true},
-					K8sPodStatusReason:                  MetricConfig{Enabled: true},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: true},
-					K8sReplicasetDesired:                MetricConfig{Enabled: true},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: true},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: true},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: true},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: true},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: true},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: true},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: true},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: true},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: true},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: true},
+					K8sContainerCPULimit:                   MetricConfig{Enabled: true},
+					K8sContainerCPURequest:                 MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageLimit:      MetricConfig{Enabled: true},
+					K8sContainerEphemeralstorageRequest:    MetricConfig{Enabled: true},
+					K8sContainerMemoryLimit:                MetricConfig{Enabled: true},
+					K8sContainerMemoryRequest:              MetricConfig{Enabled: true},
+					K8sContainerReady:                      MetricConfig{Enabled: true},
+					K8sContainerRestarts:                   MetricConfig{Enabled: true},
+					K8sContainerStorageLimit:               MetricConfig{Enabled: true},
+					K8sContainerStorageRequest:             MetricConfig{Enabled: true},
+					K8sCronjobActiveJobs:                   MetricConfig{Enabled: true},
+					K8sDaemonsetCurrentScheduledNodes:      MetricConfig{Enabled: true},
+					K8sDaemonsetDesiredScheduledNodes:      MetricConfig{Enabled: true},
+					K8sDaemonsetMisscheduledNodes:          MetricConfig{Enabled: true},
+					K8sDaemonsetReadyNodes:                 MetricConfig{Enabled: true},
+					K8sDeploymentAvailable:                 MetricConfig{Enabled: true},
+					K8sDeploymentDesired:                   MetricConfig{Enabled: true},
+					K8sGatewayCondition:                    MetricConfig{Enabled: true},
+					K8sHpaCurrentReplicas:                  MetricConfig{Enabled: true},
+					K8sHpaDesiredReplicas:                  MetricConfig{Enabled: true},
+					K8sHpaMaxReplicas:                      MetricConfig{Enabled: true},
+					K8sHpaMinReplicas:                      MetricConfig{Enabled: true},
+					K8sHttprouteCondition:                  MetricConfig{Enabled: true},
+					K8sIngressLoadBalancerAddresses:        MetricConfig{Enabled: true},
+					K8sIngressRules:                        MetricConfig{Enabled: true},
+					K8sJobActivePods:                       MetricConfig{Enabled: true},
+					K8sJobDesiredSuccessfulPods:            MetricConfig{Enabled: true},
+					K8sJobFailedPods:                       MetricConfig{Enabled: true},
+					K8sJobMaxParallelPods:                  MetricConfig{Enabled: true},
+					K8sJobSuccessfulPods:                   MetricConfig{Enabled: true},
+					K8sNamespacePhase:                      MetricConfig{Enabled: true},
+					K8sNodeCondition:                       MetricConfig{Enabled: true},
+					K8sPersistentvolumeCapacity:            MetricConfig{Enabled: true},
+					K8sPersistentvolumePhase:               MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimCapacity:       MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimPhase:          MetricConfig{Enabled: true},
+					K8sPersistentvolumeclaimStorageRequest: MetricConfig{Enabled: true},
+					K8sPodPhase:                            MetricConfig{Enabled: true},
+					K8sPodStatusReason:                     MetricConfig{Enabled: true},
+					K8sReplicasetAvailable:                 MetricConfig{Enabled: true},
+					K8sReplicasetDesired:                   MetricConfig{Enabled: true},
+					K8sReplicationControllerAvailable:      MetricConfig{Enabled: true},
+					K8sReplicationControllerDesired:        MetricConfig{Enabled: true},
+					K8sResourceQuotaHardLimit:              MetricConfig{Enabled: true},
+					K8sResourceQuotaUsed:                   MetricConfig{Enabled: true},
+					K8sStatefulsetCurrentPods:              MetricConfig{Enabled: true},
+					K8sStatefulsetDesiredPods:              MetricConfig{Enabled: true},
+					K8sStatefulsetReadyPods:                MetricConfig{Enabled: true},
+					K8sStatefulsetUpdatedPods:              MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaLimit:      MetricConfig{Enabled: true},
+					OpenshiftAppliedclusterquotaUsed:       MetricConfig{Enabled: true},
+					OpenshiftClusterquotaLimit:             MetricConfig{Enabled: true},
+					OpenshiftClusterquotaUsed:              MetricConfig{Enabled: true},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:                            ResourceAttributeConfig{Enabled: true},
@@ -86,11 +95,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sDaemonsetUID:                        ResourceAttributeConfig{Enabled: true},
 					K8sDeploymentName:                      ResourceAttributeConfig{Enabled: true},
 					K8sDeploymentUID:                       ResourceAttributeConfig{Enabled: true},
+					K8sGatewayName:                         ResourceAttributeConfig{Enabled: true},
+					K8sGatewayUID:                          ResourceAttributeConfig{Enabled: true},
+					K8sGatewayclassName:                    ResourceAttributeConfig{Enabled: true},
 					K8sHpaName:                             ResourceAttributeConfig{Enabled: true},
 					K8sHpaScaletargetrefApiversion:         ResourceAttributeConfig{Enabled: true},
 					K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: true},
 					K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: true},
 					K8sHpaUID:                              ResourceAttributeConfig{Enabled: true},
+					K8sHttprouteName:                       ResourceAttributeConfig{Enabled: true},
+					K8sHttprouteUID:                        ResourceAttributeConfig{Enabled: true},
+					K8sIngressName:                         ResourceAttributeConfig{Enabled: true},
+					K8sIngressUID:                          ResourceAttributeConfig{Enabled: true},
 					K8sJobName:                             ResourceAttributeConfig{Enabled: true},
 					K8sJobUID:                              ResourceAttributeConfig{Enabled: true},
 					K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: true},
@@ -98,6 +114,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: true},
 					K8sNodeName:                            ResourceAttributeConfig{Enabled: true},
 					K8sNodeUID:                             ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: true},
+					K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: true},
 					K8sPodName:                             ResourceAttributeConfig{Enabled: true},
 					K8sPodQosClass:                         ResourceAttributeConfig{Enabled: true},
 					K8sPodUID:                              ResourceAttributeConfig{Enabled: true},
@@ -109,6 +129,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: true},
 					K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: true},
 					K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassName:                    ResourceAttributeConfig{Enabled: true},
+					K8sStorageclassProvisioner:             ResourceAttributeConfig{Enabled: true},
 					OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: true},
 					OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: true},
 					OsDescription:                          ResourceAttributeConfig{Enabled: true},
@@ -120,50 +142,59 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
-					K8sContainerCPULimit:                MetricConfig{Enabled: false},
-					K8sContainerCPURequest:              MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageLimit:   MetricConfig{Enabled: false},
-					K8sContainerEphemeralstorageRequest: MetricConfig{Enabled: false},
-					K8sContainerMemoryLimit:             MetricConfig{Enabled: false},
-					K8sContainerMemoryRequest:           MetricConfig{Enabled: false},
-					K8sContainerReady:                   MetricConfig{Enabled: false},
-					K8sContainerRestarts:                MetricConfig{Enabled: false},
-					K8sContainerStorageLimit:            MetricConfig{Enabled: false},
-					K8sContainerStorageRequest:          MetricConfig{Enabled: false},
-					K8sCronjobActiveJobs:                MetricConfig{Enabled: false},
-					K8sDaemonsetCurrentScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetDesiredScheduledNodes:   MetricConfig{Enabled: false},
-					K8sDaemonsetMisscheduledNodes:       MetricConfig{Enabled: false},
-					K8sDaemonsetReadyNodes:              MetricConfig{Enabled: false},
-					K8sDeploymentAvailable:              MetricConfig{Enabled: false},
-					K8sDeploymentDesired:                MetricConfig{Enabled: false},
-					K8sHpaCurrentReplicas:               MetricConfig{Enabled: false},
-					K8sHpaDesiredReplicas:               MetricConfig{Enabled: false},
-					K8sHpaMaxReplicas:                   MetricConfig{Enabled: false},
-					K8sHpaMinReplicas:                   MetricConfig{Enabled: false},
-					K8sJobActivePods:                    MetricConfig{Enabled: false},
-					K8sJobDesiredSuccessfulPods:         MetricConfig{Enabled: false},
-					K8sJobFailedPods:                    MetricConfig{Enabled: false},
-					K8sJobMaxParallelPods:               MetricConfig{Enabled: false},
-					K8sJobSuccessfulPods:                MetricConfig{Enabled: false},
-					K8sNamespacePhase:                   MetricConfig{Enabled: false},
-					K8sNodeCondition:                    MetricConfig{Enabled: false},
-					K8sPodPhase:                         MetricConfig{Enabled: false},
-					K8sPodStatusReason:                  MetricConfig{Enabled: false},
-					K8sReplicasetAvailable:              MetricConfig{Enabled: false},
-					K8sReplicasetDesired:                MetricConfig{Enabled: false},
-					K8sReplicationControllerAvailable:   MetricConfig{Enabled: false},
-					K8sReplicationControllerDesired:     MetricConfig{Enabled: false},
-					K8sResourceQuotaHardLimit:           MetricConfig{Enabled: false},
-					K8sResourceQuotaUsed:                MetricConfig{Enabled: false},
-					K8sStatefulsetCurrentPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetDesiredPods:           MetricConfig{Enabled: false},
-					K8sStatefulsetReadyPods:             MetricConfig{Enabled: false},
-					K8sStatefulsetUpdatedPods:           MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaLimit:   MetricConfig{Enabled: false},
-					OpenshiftAppliedclusterquotaUsed:    MetricConfig{Enabled: false},
-					OpenshiftClusterquotaLimit:          MetricConfig{Enabled: false},
-					OpenshiftClusterquotaUsed:           MetricConfig{Enabled: false},
+					K8sContainerCPULimit:                   MetricConfig{Enabled: false},
+					K8sContainerCPURequest:                 MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageLimit:      MetricConfig{Enabled: false},
+					K8sContainerEphemeralstorageRequest:    MetricConfig{Enabled: false},
+					K8sContainerMemoryLimit:                MetricConfig{Enabled: false},
+					K8sContainerMemoryRequest:              MetricConfig{Enabled: false},
+					K8sContainerReady:                      MetricConfig{Enabled: false},
+					K8sContainerRestarts:                   MetricConfig{Enabled: false},
+					K8sContainerStorageLimit:               MetricConfig{Enabled: false},
+					K8sContainerStorageRequest:             MetricConfig{Enabled: false},
+					K8sCronjobActiveJobs:                   MetricConfig{Enabled: false},
+					K8sDaemonsetCurrentScheduledNodes:      MetricConfig{Enabled: false},
+					K8sDaemonsetDesiredScheduledNodes:      MetricConfig{Enabled: false},
+					K8sDaemonsetMisscheduledNodes:          MetricConfig{Enabled: false},
+					K8sDaemonsetReadyNodes:                 MetricConfig{Enabled: false},
+					K8sDeploymentAvailable:                 MetricConfig{Enabled: false},
+					K8sDeploymentDesired:                   MetricConfig{Enabled: false},
+					K8sGatewayCondition:                    MetricConfig{Enabled: false},
+					K8sHpaCurrentReplicas:                  MetricConfig{Enabled: false},
+					K8sHpaDesiredReplicas:                  MetricConfig{Enabled: false},
+					K8sHpaMaxReplicas:                      MetricConfig{Enabled: false},
+					K8sHpaMinReplicas:                      MetricConfig{Enabled: false},
+					K8sHttprouteCondition:                  MetricConfig{Enabled: false},
+					K8sIngressLoadBalancerAddresses:        MetricConfig{Enabled: false},
+					K8sIngressRules:                        MetricConfig{Enabled: false},
+					K8sJobActivePods:                       MetricConfig{Enabled: false},
+					K8sJobDesiredSuccessfulPods:            MetricConfig{Enabled: false},
+					K8sJobFailedPods:                       MetricConfig{Enabled: false},
+					K8sJobMaxParallelPods:                  MetricConfig{Enabled: false},
+					K8sJobSuccessfulPods:                   MetricConfig{Enabled: false},
+					K8sNamespacePhase:                      MetricConfig{Enabled: false},
+					K8sNodeCondition:                       MetricConfig{Enabled: false},
+					K8sPersistentvolumeCapacity:            MetricConfig{Enabled: false},
+					K8sPersistentvolumePhase:               MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimCapacity:       MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimPhase:          MetricConfig{Enabled: false},
+					K8sPersistentvolumeclaimStorageRequest: MetricConfig{Enabled: false},
+					K8sPodPhase:                            MetricConfig{Enabled: false},
+					K8sPodStatusReason:                     MetricConfig{Enabled: false},
+					K8sReplicasetAvailable:                 MetricConfig{Enabled: false},
+					K8sReplicasetDesired:                   MetricConfig{Enabled: false},
+					K8sReplicationControllerAvailable:      MetricConfig{Enabled: false},
+					K8sReplicationControllerDesired:        MetricConfig{Enabled: false},
+					K8sResourceQuotaHardLimit:              MetricConfig{Enabled: false},
+					K8sResourceQuotaUsed:                   MetricConfig{Enabled: false},
+					K8sStatefulsetCurrentPods:              MetricConfig{Enabled: false},
+					K8sStatefulsetDesiredPods:              MetricConfig{Enabled: false},
+					K8sStatefulsetReadyPods:                MetricConfig{Enabled: false},
+					K8sStatefulsetUpdatedPods:              MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaLimit:      MetricConfig{Enabled: false},
+					OpenshiftAppliedclusterquotaUsed:       MetricConfig{Enabled: false},
+					OpenshiftClusterquotaLimit:             MetricConfig{Enabled: false},
+					OpenshiftClusterquotaUsed:              MetricConfig{Enabled: false},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					ContainerID:                            ResourceAttributeConfig{Enabled: false},
@@ -179,11 +210,18 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sDaemonsetUID:                        ResourceAttributeConfig{Enabled: false},
 					K8sDeploymentName:                      ResourceAttributeConfig{Enabled: false},
 					K8sDeploymentUID:                       ResourceAttributeConfig{Enabled: false},
+					K8sGatewayName:                         ResourceAttributeConfig{Enabled: false},
+					K8sGatewayUID:                          ResourceAttributeConfig{Enabled: false},
+					K8sGatewayclassName:                    ResourceAttributeConfig{Enabled: false},
 					K8sHpaName:                             ResourceAttributeConfig{Enabled: false},
 					K8sHpaScaletargetrefApiversion:         ResourceAttributeConfig{Enabled: false},
 					K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: false},
 					K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: false},
 					K8sHpaUID:                              ResourceAttributeConfig{Enabled: false},
+					K8sHttprouteName:                       ResourceAttributeConfig{Enabled: false},
+					K8sHttprouteUID:                        ResourceAttributeConfig{Enabled: false},
+					K8sIngressName:                         ResourceAttributeConfig{Enabled: false},
+					K8sIngressUID:                          ResourceAttributeConfig{Enabled: false},
 					K8sJobName:                             ResourceAttributeConfig{Enabled: false},
 					K8sJobUID:                              ResourceAttributeConfig{Enabled: false},
 					K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: false},
@@ -191,6 +229,10 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: false},
 					K8sNodeName:                            ResourceAttributeConfig{Enabled: false},
 					K8sNodeUID:                             ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: false},
+					K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: false},
 					K8sPodName:                             ResourceAttributeConfig{Enabled: false},
 					K8sPodQosClass:                         ResourceAttributeConfig{Enabled: false},
 					K8sPodUID:                              ResourceAttributeConfig{Enabled: false},
@@ -202,6 +244,8 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: false},
 					K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: false},
 					K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassName:                    ResourceAttributeConfig{Enabled: false},
+					K8sStorageclassProvisioner:             ResourceAttributeConfig{Enabled: false},
 					OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: false},
 					OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: false},
 					OsDescription:                          ResourceAttributeConfig{Enabled: false},
@@ -254,11 +298,18 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sDaemonsetUID:                        ResourceAttributeConfig{Enabled: true},
 				K8sDeploymentName:                      ResourceAttributeConfig{Enabled: true},
 				K8sDeploymentUID:                       ResourceAttributeConfig{Enabled: true},
+				K8sGatewayName:                         ResourceAttributeConfig{Enabled: true},
+				K8sGatewayUID:                          ResourceAttributeConfig{Enabled: true},
+				K8sGatewayclassName:                    ResourceAttributeConfig{Enabled: true},
 				K8sHpaName:                             ResourceAttributeConfig{Enabled: true},
 				K8sHpaScaletargetrefApiversion:         ResourceAttributeConfig{Enabled: true},
 				K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: true},
 				K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: true},
 				K8sHpaUID:                              ResourceAttributeConfig{Enabled: true},
+				K8sHttprouteName:                       ResourceAttributeConfig{Enabled: true},
+				K8sHttprouteUID:                        ResourceAttributeConfig{Enabled: true},
+				K8sIngressName:                         ResourceAttributeConfig{Enabled: true},
+				K8sIngressUID:                          ResourceAttributeConfig{Enabled: true},
 				K8sJobName:                             ResourceAttributeConfig{Enabled: true},
 				K8sJobUID:                              ResourceAttributeConfig{Enabled: true},
 				K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: true},
@@ -266,6 +317,10 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: true},
 				K8sNodeName:                            ResourceAttributeConfig{Enabled: true},
 				K8sNodeUID:                             ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: true},
+				K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: true},
 				K8sPodName:                             ResourceAttributeConfig{Enabled: true},
 				K8sPodQosClass:                         ResourceAttributeConfig{Enabled: true},
 				K8sPodUID:                              ResourceAttributeConfig{Enabled: true},
@@ -277,6 +332,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: true},
 				K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassName:                    ResourceAttributeConfig{Enabled: true},
+				K8sStorageclassProvisioner:             ResourceAttributeConfig{Enabled: true},
 				OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: true},
 				OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: true},
 				OsDescription:                          ResourceAttributeConfig{Enabled: true},
@@ -299,11 +356,18 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sDaemonsetUID:                        ResourceAttributeConfig{Enabled: false},
 				K8sDeploymentName:                      ResourceAttributeConfig{Enabled: false},
 				K8sDeploymentUID:                       ResourceAttributeConfig{Enabled: false},
+				K8sGatewayName:                         ResourceAttributeConfig{Enabled: false},
+				K8sGatewayUID:                          ResourceAttributeConfig{Enabled: false},
+				K8sGatewayclassName:                    ResourceAttributeConfig{Enabled: false},
 				K8sHpaName:                             ResourceAttributeConfig{Enabled: false},
 				K8sHpaScaletargetrefApiversion:         ResourceAttributeConfig{Enabled: false},
 				K8sHpaScaletargetrefKind:               ResourceAttributeConfig{Enabled: false},
 				K8sHpaScaletargetrefName:               ResourceAttributeConfig{Enabled: false},
 				K8sHpaUID:                              ResourceAttributeConfig{Enabled: false},
+				K8sHttprouteName:                       ResourceAttributeConfig{Enabled: false},
+				K8sHttprouteUID:                        ResourceAttributeConfig{Enabled: false},
+				K8sIngressName:                         ResourceAttributeConfig{Enabled: false},
+				K8sIngressUID:                          ResourceAttributeConfig{Enabled: false},
 				K8sJobName:                             ResourceAttributeConfig{Enabled: false},
 				K8sJobUID:                              ResourceAttributeConfig{Enabled: false},
 				K8sKubeletVersion:                      ResourceAttributeConfig{Enabled: false},
@@ -311,6 +375,10 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sNamespaceUID:                        ResourceAttributeConfig{Enabled: false},
 				K8sNodeName:                            ResourceAttributeConfig{Enabled: false},
 				K8sNodeUID:                             ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeName:                ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeUID:                 ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimName:           ResourceAttributeConfig{Enabled: false},
+				K8sPersistentvolumeclaimUID:            ResourceAttributeConfig{Enabled: false},
 				K8sPodName:                             ResourceAttributeConfig{Enabled: false},
 				K8sPodQosClass:                         ResourceAttributeConfig{Enabled: false},
 				K8sPodUID:                              ResourceAttributeConfig{Enabled: false},
@@ -322,6 +390,8 @@ func TestResourceAttributesConfig(t *testing.T) {
 				K8sResourcequotaUID:                    ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetName:                     ResourceAttributeConfig{Enabled: false},
 				K8sStatefulsetUID:                      ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassName:                    ResourceAttributeConfig{Enabled: false},
+				K8sStorageclassProvisioner:             ResourceAttributeConfig{Enabled: false},
 				OpenshiftClusterquotaName:              ResourceAttributeConfig{Enabled: false},
 				OpenshiftClusterquotaUID:               ResourceAttributeConfig{Enabled: false},
 				OsDescription:                          ResourceAttributeConfig{Enabled: false},
@@ -65,6 +65,9 @@ var MetricsInfo = metricsInfo{
 	K8sDeploymentDesired: metricInfo{
 		Name: "k8s.deployment.desired",
 	},
+	K8sGatewayCondition: metricInfo{
+		Name: "k8s.gateway.condition",
+	},
 	K8sHpaCurrentReplicas: metricInfo{
 		Name: "k8s.hpa.current_replicas",
 	},
@@ -77,6 +80,15 @@ var MetricsInfo = metricsInfo{
 	K8sHpaMinReplicas: metricInfo{
 		Name: "k8s.hpa.min_replicas",
 	},
+	K8sHttprouteCondition: metricInfo{
+		Name: "k8s.httproute.condition",
+	},
+	K8sIngressLoadBalancerAddresses: metricInfo{
+		Name: "k8s.ingress.load_balancer_addresses",
+	},
+	K8sIngressRules: metricInfo{
+		Name: "k8s.ingress.rules",
+	},
 	K8sJobActivePods: metricInfo{
 		Name: "k8s.job.active_pods",
 	},
@@ -98,6 +110,21 @@ var MetricsInfo = metricsInfo{
 	K8sNodeCondition: metricInfo{
 		Name: "k8s.node.condition",
 	},
+	K8sPersistentvolumeCapacity: metricInfo{
+		Name: "k8s.persistentvolume.capacity",
+	},
+	K8sPersistentvolumePhase: metricInfo{
+		Name: "k8s.persistentvolume.phase",
+	},
+	K8sPersistentvolumeclaimCapacity: metricInfo{
+		Name: "k8s.persistentvolumeclaim.capacity",
+	},
+	K8sPersistentvolumeclaimPhase: metricInfo{
+		Name: "k8s.persistentvolumeclaim.phase",
+	},
+	K8sPersistentvolumeclaimStorageRequest: metricInfo{
+		Name: "k8s.persistentvolumeclaim.storage_request",
+	},
 	K8sPodPhase: metricInfo{
 		Name: "k8s.pod.phase",
 	},
@@ -149,50 +176,59 @@ var MetricsInfo = metricsInfo{
 }
 
 type metricsInfo struct {
-	K8sContainerCPULimit                metricInfo
-	K8sContainerCPURequest              metricInfo
-	K8sContainerEphemeralstorageLimit   metricInfo
-	K8sContainerEphemeralstorageRequest metricInfo
-	K8sContainerMemoryLimit             metricInfo
-	K8sContainerMemoryRequest           metricInfo
-	K8sContainerReady                   metricInfo
-	K8sContainerRestarts                metricInfo
-	K8sContainerStorageLimit            metricInfo
-	K8sContainerStorageRequest          metricInfo
-	K8sCronjobActiveJobs                metricInfo
-	K8sDaemonsetCurrentScheduledNodes   metricInfo
-	K8sDaemonsetDesiredScheduledNodes   metricInfo
-	K8sDaemonsetMisscheduledNodes       metricInfo
-	K8sDaemonsetReadyNodes              metricInfo
-	K8sDeploymentAvailable              metricInfo
-	K8sDeploymentDesired                metricInfo
-	K8sHpaCurrentReplicas               metricInfo
-	K8sHpaDesiredReplicas               metricInfo
-	K8sHpaMaxReplicas                   metricInfo
-	K8sHpaMinReplicas                   metricInfo
-	K8sJobActivePods                    metricInfo
-	K8sJobDesiredSuccessfulPods         metricInfo
-	K8sJobFailedPods                    metricInfo
-	K8sJobMaxParallelPods               metricInfo
-	K8sJobSuccessfulPods                metricInfo
-	K8sNamespacePhase                   metricInfo
-	K8sNodeCondition                    metricInfo
-	K8sPodPhase                         metricInfo
-	K8sPodStatusReason                  metricInfo
-	K8sReplicasetAvailable              metricInfo
-	K8sReplicasetDesired                metricInfo
-	K8sReplicationControllerAvailable   metricInfo
-	K8sReplicationControllerDesired     metricInfo
-	K8sResourceQuotaHardLimit           metricInfo
-	K8sResourceQuotaUsed                metricInfo
-	K8sStatefulsetCurrentPods           metricInfo
-	K8sStatefulsetDesiredPods           metricInfo
-	K8sStatefulsetReadyPods             metricInfo
-	K8sStatefulsetUpdatedPods           metricInfo
-	OpenshiftAppliedclusterquotaLimit   metricInfo
-	OpenshiftAppliedclusterquotaUsed    metricInfo
-	OpenshiftClusterquotaLimit          metricInfo
-	OpenshiftClusterquotaUsed           metricInfo
+	K8sContainerCPULimit                   metricInfo
+	K8sContainerCPURequest                 metricInfo
+	K8sContainerEphemeralstorageLimit      metricInfo
+	K8sContainerEphemeralstorageRequest    metricInfo
+	K8sContainerMemoryLimit                metricInfo
+	K8sContainerMemoryRequest              metricInfo
+	K8sContainerReady                      metricInfo
+	K8sContainerRestarts                   metricInfo
+	K8sContainerStorageLimit               metricInfo
+	K8sContainerStorageRequest             metricInfo
+	K8sCronjobActiveJobs                   metricInfo
+	K8sDaemonsetCurrentScheduledNodes      metricInfo
+	K8sDaemonsetDesiredScheduledNodes      metricInfo
+	K8sDaemonsetMisscheduledNodes          metricInfo
+	K8sDaemonsetReadyNodes                 metricInfo
+	K8sDeploymentAvailable                 metricInfo
+	K8sDeploymentDesired                   metricInfo
+	K8sGatewayCondition                    metricInfo
+	K8sHpaCurrentReplicas                  metricInfo
+	K8sHpaDesiredReplicas                  metricInfo
+	K8sHpaMaxReplicas                      metricInfo
+	K8sHpaMinReplicas                      metricInfo
+	K8sHttprouteCondition                  metricInfo
+	K8sIngressLoadBalancerAddresses        metricInfo
+	K8sIngressRules                        metricInfo
+	K8sJobActivePods                       metricInfo
+	K8sJobDesiredSuccessfulPods            metricInfo
+	K8sJobFailedPods                       metricInfo
+	K8sJobMaxParallelPods                  metricInfo
+	K8sJobSuccessfulPods                   metricInfo
+	K8sNamespacePhase                      metricInfo
+	K8sNodeCondition                       metricInfo
+	K8sPersistentvolumeCapacity            metricInfo
+	K8sPersistentvolumePhase               metricInfo
+	K8sPersistentvolumeclaimCapacity       metricInfo
+	K8sPersistentvolumeclaimPhase          metricInfo
+	K8sPersistentvolumeclaimStorageRequest metricInfo
+	K8sPodPhase                            metricInfo
+	K8sPodStatusReason                     metricInfo
+	K8sReplicasetAvailable                 metricInfo
+	K8sReplicasetDesired                   metricInfo
+	K8sReplicationControllerAvailable      metricInfo
+	K8sReplicationControllerDesired        metricInfo
+	K8sResourceQuotaHardLimit              metricInfo
+	K8sResourceQuotaUsed                   metricInfo
+	K8sStatefulsetCurrentPods              metricInfo
+	K8sStatefulsetDesiredPods              metricInfo
+	K8sStatefulsetReadyPods                metricInfo
+	K8sStatefulsetUpdatedPods              metricInfo
+	OpenshiftAppliedclusterquotaLimit      metricInfo
+	OpenshiftAppliedclusterquotaUsed       metricInfo
+	OpenshiftClusterquotaLimit             metricInfo
+	OpenshiftClusterquotaUsed              metricInfo
 }
 
 type metricInfo struct {
@@ -1032,6 +1068,57 @@ func newMetricK8sDeploymentDesired(cfg MetricConfig) metricK8sDeploymentDesired
 	return m
 }
 
+type metricK8sGatewayCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.gateway.condition metric with initial data.
+func (m *metricK8sGatewayCondition) init() {
+	m.data.SetName("k8s.gateway.condition")
+	m.data.SetDescription("The condition of a particular Gateway (1 for true, 0 for false, -1 for unknown)")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sGatewayCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sGatewayCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sGatewayCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sGatewayCondition(cfg MetricConfig) metricK8sGatewayCondition {
+	m := metricK8sGatewayCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sHpaCurrentReplicas struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1228,6 +1315,156 @@ func newMetricK8sHpaMinReplicas(cfg MetricConfig) metricK8sHpaMinReplicas {
 	return m
 }
 
+type metricK8sHttprouteCondition struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.httproute.condition metric with initial data.
+func (m *metricK8sHttprouteCondition) init() {
+	m.data.SetName("k8s.httproute.condition")
+	m.data.SetDescription("The condition of a particular HTTPRoute for one of its parent Gateways (1 for true, 0 for false, -1 for unknown)")
+	m.data.SetUnit("{condition}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricK8sHttprouteCondition) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, k8sGatewayNameAttributeValue string, conditionAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("k8s.gateway.name", k8sGatewayNameAttributeValue)
+	dp.Attributes().PutStr("condition", conditionAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sHttprouteCondition) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sHttprouteCondition) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sHttprouteCondition(cfg MetricConfig) metricK8sHttprouteCondition {
+	m := metricK8sHttprouteCondition{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sIngressLoadBalancerAddresses struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.load_balancer_addresses metric with initial data.
+func (m *metricK8sIngressLoadBalancerAddresses) init() {
+	m.data.SetName("k8s.ingress.load_balancer_addresses")
+	m.data.SetDescription("Number of load balancer addresses (IPs or hostnames) reported in the status of the ingress")
+	m.data.SetUnit("{address}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressLoadBalancerAddresses) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressLoadBalancerAddresses) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressLoadBalancerAddresses) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressLoadBalancerAddresses(cfg MetricConfig) metricK8sIngressLoadBalancerAddresses {
+	m := metricK8sIngressLoadBalancerAddresses{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sIngressRules struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.ingress.rules metric with initial data.
+func (m *metricK8sIngressRules) init() {
+	m.data.SetName("k8s.ingress.rules")
+	m.data.SetDescription("Number of rules defined for the ingress")
+	m.data.SetUnit("{rule}")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sIngressRules) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sIngressRules) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sIngressRules) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sIngressRules(cfg MetricConfig) metricK8sIngressRules {
+	m := metricK8sIngressRules{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sJobActivePods struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -1573,6 +1810,251 @@ func newMetricK8sNodeCondition(cfg MetricConfig) metricK8sNodeCondition {
 	return m
 }
 
+type metricK8sPersistentvolumeCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeCapacity) init() {
+	m.data.SetName("k8s.persistentvolume.capacity")
+	m.data.SetDescription("The storage capacity of the persistent volume")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeCapacity(cfg MetricConfig) metricK8sPersistentvolumeCapacity {
+	m := metricK8sPersistentvolumeCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumePhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolume.phase metric with initial data.
+func (m *metricK8sPersistentvolumePhase) init() {
+	m.data.SetName("k8s.persistentvolume.phase")
+	m.data.SetDescription("Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumePhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumePhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumePhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumePhase(cfg MetricConfig) metricK8sPersistentvolumePhase {
+	m := metricK8sPersistentvolumePhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimCapacity struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.capacity metric with initial data.
+func (m *metricK8sPersistentvolumeclaimCapacity) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.capacity")
+	m.data.SetDescription("The storage capacity of the volume bound to the persistent volume claim")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimCapacity) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimCapacity) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimCapacity) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimCapacity(cfg MetricConfig) metricK8sPersistentvolumeclaimCapacity {
+	m := metricK8sPersistentvolumeclaimCapacity{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimPhase struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.phase metric with initial data.
+func (m *metricK8sPersistentvolumeclaimPhase) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.phase")
+	m.data.SetDescription("Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)")
+	m.data.SetUnit("")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimPhase) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimPhase) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimPhase) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimPhase(cfg MetricConfig) metricK8sPersistentvolumeclaimPhase {
+	m := metricK8sPersistentvolumeclaimPhase{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricK8sPersistentvolumeclaimStorageRequest struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	config   MetricConfig   // metric config provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills k8s.persistentvolumeclaim.storage_request metric with initial data.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) init() {
+	m.data.SetName("k8s.persistentvolumeclaim.storage_request")
+	m.data.SetDescription("The storage requested by the persistent volume claim")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+}
+
+func (m *metricK8sPersistentvolumeclaimStorageRequest) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64) {
+	if !m.config.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricK8sPersistentvolumeclaimStorageRequest) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricK8sPersistentvolumeclaimStorageRequest(cfg MetricConfig) metricK8sPersistentvolumeclaimStorageRequest {
+	m := metricK8sPersistentvolumeclaimStorageRequest{config: cfg}
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricK8sPodPhase struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	config   MetricConfig   // metric config provided by user.
@@ -2374,57 +2856,66 @@ func newMetricOpenshiftClusterquotaUsed(cfg MetricConfig) metricOpenshiftCluster
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                                    MetricsBuilderConfig // config of the metrics builder.
-	startTime                                 pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                           int                  // maximum observed number of metrics per resource.
-	metricsBuffer                             pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                                 component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter            map[string]filter.Filter
-	resourceAttributeExcludeFilter            map[string]filter.Filter
-	metricK8sContainerCPULimit                metricK8sContainerCPULimit
-	metricK8sContainerCPURequest              metricK8sContainerCPURequest
-	metricK8sContainerEphemeralstorageLimit   metricK8sContainerEphemeralstorageLimit
-	metricK8sContainerEphemeralstorageRequest metricK8sContainerEphemeralstorageRequest
-	metricK8sContainerMemoryLimit             metricK8sContainerMemoryLimit
-	metricK8sContainerMemoryRequest           metricK8sContainerMemoryRequest
-	metricK8sContainerReady                   metricK8sContainerReady
-	metricK8sContainerRestarts                metricK8sContainerRestarts
-	metricK8sContainerStorageLimit            metricK8sContainerStorageLimit
-	metricK8sContainerStorageRequest          metricK8sContainerStorageRequest
-	metricK8sCronjobActiveJobs                metricK8sCronjobActiveJobs
-	metricK8sDaemonsetCurrentScheduledNodes   metricK8sDaemonsetCurrentScheduledNodes
-	metricK8sDaemonsetDesiredScheduledNodes   metricK8sDaemonsetDesiredScheduledNodes
-	metricK8sDaemonsetMisscheduledNodes       metricK8sDaemonsetMisscheduledNodes
-	metricK8sDaemonsetReadyNodes              metricK8sDaemonsetReadyNodes
-	metricK8sDeploymentAvailable              metricK8sDeploymentAvailable
-	metricK8sDeploymentDesired                metricK8sDeploymentDesired
-	metricK8sHpaCurrentReplicas               metricK8sHpaCurrentReplicas
-	metricK8sHpaDesiredReplicas               metricK8sHpaDesiredReplicas
-	metricK8sHpaMaxReplicas                   metricK8sHpaMaxReplicas
-	metricK8sHpaMinReplicas                   metricK8sHpaMinReplicas
-	metricK8sJobActivePods                    metricK8sJobActivePods
-	metricK8sJobDesiredSuccessfulPods         metricK8sJobDesiredSuccessfulPods
-	metricK8sJobFailedPods                    metricK8sJobFailedPods
-	metricK8sJobMaxParallelPods               metricK8sJobMaxParallelPods
-	metricK8sJobSuccessfulPods                metricK8sJobSuccessfulPods
-	metricK8sNamespacePhase                   metricK8sNamespacePhase
-	metricK8sNodeCondition                    metricK8sNodeCondition
-	metricK8sPodPhase                         metricK8sPodPhase
-	metricK8sPodStatusReason                  metricK8sPodStatusReason
-	metricK8sReplicasetAvailable              metricK8sReplicasetAvailable
-	metricK8sReplicasetDesired                metricK8sReplicasetDesired
-	metricK8sReplicationControllerAvailable   metricK8sReplicationControllerAvailable
-	metricK8sReplicationControllerDesired     metricK8sReplicationControllerDesired
-	metricK8sResourceQuotaHardLimit           metricK8sResourceQuotaHardLimit
-	metricK8sResourceQuotaUsed                metricK8sResourceQuotaUsed
-	metricK8sStatefulsetCurrentPods           metricK8sStatefulsetCurrentPods
-	metricK8sStatefulsetDesiredPods           metricK8sStatefulsetDesiredPods
-	metricK8sStatefulsetReadyPods             metricK8sStatefulsetReadyPods
-	metricK8sStatefulsetUpdatedPods           metricK8sStatefulsetUpdatedPods
-	metricOpenshiftAppliedclusterquotaLimit   metricOpenshiftAppliedclusterquotaLimit
-	metricOpenshiftAppliedclusterquotaUsed    metricOpenshiftAppliedclusterquotaUsed
-	metricOpenshiftClusterquotaLimit          metricOpenshiftClusterquotaLimit
-	metricOpenshiftClusterquotaUsed           metricOpenshiftClusterquotaUsed
+	config                                       MetricsBuilderConfig // config of the metrics builder.
+	startTime                                    pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                              int                  // maximum observed number of metrics per resource.
+	metricsBuffer                                pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                    component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter               map[string]filter.Filter
+	resourceAttributeExcludeFilter               map[string]filter.Filter
+	metricK8sContainerCPULimit                   metricK8sContainerCPULimit
+	metricK8sContainerCPURequest                 metricK8sContainerCPURequest
+	metricK8sContainerEphemeralstorageLimit      metricK8sContainerEphemeralstorageLimit
+	metricK8sContainerEphemeralstorageRequest    metricK8sContainerEphemeralstorageRequest
+	metricK8sContainerMemoryLimit                metricK8sContainerMemoryLimit
+	metricK8sContainerMemoryRequest              metricK8sContainerMemoryRequest
+	metricK8sContainerReady                      metricK8sContainerReady
+	metricK8sContainerRestarts                   metricK8sContainerRestarts
+	metricK8sContainerStorageLimit               metricK8sContainerStorageLimit
+	metricK8sContainerStorageRequest             metricK8sContainerStorageRequest
+	metricK8sCronjobActiveJobs                   metricK8sCronjobActiveJobs
+	metricK8sDaemonsetCurrentScheduledNodes      metricK8sDaemonsetCurrentScheduledNodes
+	metricK8sDaemonsetDesiredScheduledNodes      metricK8sDaemonsetDesiredScheduledNodes
+	metricK8sDaemonsetMisscheduledNodes          metricK8sDaemonsetMisscheduledNodes
+	metricK8sDaemonsetReadyNodes                 metricK8sDaemonsetReadyNodes
+	metricK8sDeploymentAvailable                 metricK8sDeploymentAvailable
+	metricK8sDeploymentDesired                   metricK8sDeploymentDesired
+	metricK8sGatewayCondition                    metricK8sGatewayCondition
+	metricK8sHpaCurrentReplicas                  metricK8sHpaCurrentReplicas
+	metricK8sHpaDesiredReplicas                  metricK8sHpaDesiredReplicas
+	metricK8sHpaMaxReplicas                      metricK8sHpaMaxReplicas
+	metricK8sHpaMinReplicas                      metricK8sHpaMinReplicas
+	metricK8sHttprouteCondition                  metricK8sHttprouteCondition
+	metricK8sIngressLoadBalancerAddresses        metricK8sIngressLoadBalancerAddresses
+	metricK8sIngressRules                        metricK8sIngressRules
+	metricK8sJobActivePods                       metricK8sJobActivePods
+	metricK8sJobDesiredSuccessfulPods            metricK8sJobDesiredSuccessfulPods
+	metricK8sJobFailedPods                       metricK8sJobFailedPods
+	metricK8sJobMaxParallelPods                  metricK8sJobMaxParallelPods
+	metricK8sJobSuccessfulPods                   metricK8sJobSuccessfulPods
+	metricK8sNamespacePhase                      metricK8sNamespacePhase
+	metricK8sNodeCondition                       metricK8sNodeCondition
+	metricK8sPersistentvolumeCapacity            metricK8sPersistentvolumeCapacity
+	metricK8sPersistentvolumePhase               metricK8sPersistentvolumePhase
+	metricK8sPersistentvolumeclaimCapacity       metricK8sPersistentvolumeclaimCapacity
+	metricK8sPersistentvolumeclaimPhase          metricK8sPersistentvolumeclaimPhase
+	metricK8sPersistentvolumeclaimStorageRequest metricK8sPersistentvolumeclaimStorageRequest
+	metricK8sPodPhase                            metricK8sPodPhase
+	metricK8sPodStatusReason                     metricK8sPodStatusReason
+	metricK8sReplicasetAvailable                 metricK8sReplicasetAvailable
+	metricK8sReplicasetDesired                   metricK8sReplicasetDesired
+	metricK8sReplicationControllerAvailable      metricK8sReplicationControllerAvailable
+	metricK8sReplicationControllerDesired        metricK8sReplicationControllerDesired
+	metricK8sResourceQuotaHardLimit              metricK8sResourceQuotaHardLimit
+	metricK8sResourceQuotaUsed                   metricK8sResourceQuotaUsed
+	metricK8sStatefulsetCurrentPods              metricK8sStatefulsetCurrentPods
+	metricK8sStatefulsetDesiredPods              metricK8sStatefulsetDesiredPods
+	metricK8sStatefulsetReadyPods                metricK8sStatefulsetReadyPods
+	metricK8sStatefulsetUpdatedPods              metricK8sStatefulsetUpdatedPods
+	metricOpenshiftAppliedclusterquotaLimit      metricOpenshiftAppliedclusterquotaLimit
+	metricOpenshiftAppliedclusterquotaUsed       metricOpenshiftAppliedclusterquotaUsed
+	metricOpenshiftClusterquotaLimit             metricOpenshiftClusterquotaLimit
+	metricOpenshiftClusterquotaUsed              metricOpenshiftClusterquotaUsed
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -2453,49 +2944,58 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 		metricK8sContainerCPULimit:              newMetricK8sContainerCPULimit(mbc.Metrics.K8sContainerCPULimit),
 		metricK8sContainerCPURequest:            newMetricK8sContainerCPURequest(mbc.Metrics.K8sContainerCPURequest),
 		metricK8sContainerEphemeralstorageLimit: newMetricK8sContainerEphemeralstorageLimit(mbc.Metrics.K8sContainerEphemeralstorageLimit),
-		metricK8sContainerEphemeralstorageRequest: newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
-		metricK8sContainerMemoryLimit:             newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
-		metricK8sContainerMemoryRequest:           newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
-		metricK8sContainerReady:                   newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
-		metricK8sContainerRestarts:                newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
-		metricK8sContainerStorageLimit:            newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
-		metricK8sContainerStorageRequest:          newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
-		metricK8sCronjobActiveJobs:                newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
-		metricK8sDaemonsetCurrentScheduledNodes:   newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
-		metricK8sDaemonsetDesiredScheduledNodes:   newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
-		metricK8sDaemonsetMisscheduledNodes:       newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
-		metricK8sDaemonsetReadyNodes:              newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
-		metricK8sDeploymentAvailable:              newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
-		metricK8sDeploymentDesired:                newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
-		metricK8sHpaCurrentReplicas:               newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
-		metricK8sHpaDesiredReplicas:               newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
-		metricK8sHpaMaxReplicas:                   newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
-		metricK8sHpaMinReplicas:                   newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
-		metricK8sJobActivePods:                    newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
-		metricK8sJobDesiredSuccessfulPods:         newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
-		metricK8sJobFailedPods:                    newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
-		metricK8sJobMaxParallelPods:               newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
-		metricK8sJobSuccessfulPods:                newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
-		metricK8sNamespacePhase:                   newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
-		metricK8sNodeCondition:                    newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
-		metricK8sPodPhase:                         newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
-		metricK8sPodStatusReason:                  newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
-		metricK8sReplicasetAvailable:              newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
-		metricK8sReplicasetDesired:                newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
-		metricK8sReplicationControllerAvailable:   newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
-		metricK8sReplicationControllerDesired:     newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
-		metricK8sResourceQuotaHardLimit:           newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
-		metricK8sResourceQuotaUsed:                newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
-		metricK8sStatefulsetCurrentPods:           newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
-		metricK8sStatefulsetDesiredPods:           newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
-		metricK8sStatefulsetReadyPods:             newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
-		metricK8sStatefulsetUpdatedPods:           newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
-		metricOpenshiftAppliedclusterquotaLimit:   newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
-		metricOpenshiftAppliedclusterquotaUsed:    newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
-		metricOpenshiftClusterquotaLimit:          newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
-		metricOpenshiftClusterquotaUsed:           newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
-		resourceAttributeIncludeFilter:            make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter:            make(map[string]filter.Filter),
+		metricK8sContainerEphemeralstorageRequest:    newMetricK8sContainerEphemeralstorageRequest(mbc.Metrics.K8sContainerEphemeralstorageRequest),
+		metricK8sContainerMemoryLimit:                newMetricK8sContainerMemoryLimit(mbc.Metrics.K8sContainerMemoryLimit),
+		metricK8sContainerMemoryRequest:              newMetricK8sContainerMemoryRequest(mbc.Metrics.K8sContainerMemoryRequest),
+		metricK8sContainerReady:                      newMetricK8sContainerReady(mbc.Metrics.K8sContainerReady),
+		metricK8sContainerRestarts:                   newMetricK8sContainerRestarts(mbc.Metrics.K8sContainerRestarts),
+		metricK8sContainerStorageLimit:               newMetricK8sContainerStorageLimit(mbc.Metrics.K8sContainerStorageLimit),
+		metricK8sContainerStorageRequest:             newMetricK8sContainerStorageRequest(mbc.Metrics.K8sContainerStorageRequest),
+		metricK8sCronjobActiveJobs:                   newMetricK8sCronjobActiveJobs(mbc.Metrics.K8sCronjobActiveJobs),
+		metricK8sDaemonsetCurrentScheduledNodes:      newMetricK8sDaemonsetCurrentScheduledNodes(mbc.Metrics.K8sDaemonsetCurrentScheduledNodes),
+		metricK8sDaemonsetDesiredScheduledNodes:      newMetricK8sDaemonsetDesiredScheduledNodes(mbc.Metrics.K8sDaemonsetDesiredScheduledNodes),
+		metricK8sDaemonsetMisscheduledNodes:          newMetricK8sDaemonsetMisscheduledNodes(mbc.Metrics.K8sDaemonsetMisscheduledNodes),
+		metricK8sDaemonsetReadyNodes:                 newMetricK8sDaemonsetReadyNodes(mbc.Metrics.K8sDaemonsetReadyNodes),
+		metricK8sDeploymentAvailable:                 newMetricK8sDeploymentAvailable(mbc.Metrics.K8sDeploymentAvailable),
+		metricK8sDeploymentDesired:                   newMetricK8sDeploymentDesired(mbc.Metrics.K8sDeploymentDesired),
+		metricK8sGatewayCondition:                    newMetricK8sGatewayCondition(mbc.Metrics.K8sGatewayCondition),
+		metricK8sHpaCurrentReplicas:                  newMetricK8sHpaCurrentReplicas(mbc.Metrics.K8sHpaCurrentReplicas),
+		metricK8sHpaDesiredReplicas:                  newMetricK8sHpaDesiredReplicas(mbc.Metrics.K8sHpaDesiredReplicas),
+		metricK8sHpaMaxReplicas:                      newMetricK8sHpaMaxReplicas(mbc.Metrics.K8sHpaMaxReplicas),
+		metricK8sHpaMinReplicas:                      newMetricK8sHpaMinReplicas(mbc.Metrics.K8sHpaMinReplicas),
+		metricK8sHttprouteCondition:                  newMetricK8sHttprouteCondition(mbc.Metrics.K8sHttprouteCondition),
+		metricK8sIngressLoadBalancerAddresses:        newMetricK8sIngressLoadBalancerAddresses(mbc.Metrics.K8sIngressLoadBalancerAddresses),
+		metricK8sIngressRules:                        newMetricK8sIngressRules(mbc.Metrics.K8sIngressRules),
+		metricK8sJobActivePods:                       newMetricK8sJobActivePods(mbc.Metrics.K8sJobActivePods),
+		metricK8sJobDesiredSuccessfulPods:            newMetricK8sJobDesiredSuccessfulPods(mbc.Metrics.K8sJobDesiredSuccessfulPods),
+		metricK8sJobFailedPods:                       newMetricK8sJobFailedPods(mbc.Metrics.K8sJobFailedPods),
+		metricK8sJobMaxParallelPods:                  newMetricK8sJobMaxParallelPods(mbc.Metrics.K8sJobMaxParallelPods),
+		metricK8sJobSuccessfulPods:                   newMetricK8sJobSuccessfulPods(mbc.Metrics.K8sJobSuccessfulPods),
+		metricK8sNamespacePhase:                      newMetricK8sNamespacePhase(mbc.Metrics.K8sNamespacePhase),
+		metricK8sNodeCondition:                       newMetricK8sNodeCondition(mbc.Metrics.K8sNodeCondition),
+		metricK8sPersistentvolumeCapacity:            newMetricK8sPersistentvolumeCapacity(mbc.Metrics.K8sPersistentvolumeCapacity),
+		metricK8sPersistentvolumePhase:               newMetricK8sPersistentvolumePhase(mbc.Metrics.K8sPersistentvolumePhase),
+		metricK8sPersistentvolumeclaimCapacity:       newMetricK8sPersistentvolumeclaimCapacity(mbc.Metrics.K8sPersistentvolumeclaimCapacity),
+		metricK8sPersistentvolumeclaimPhase:          newMetricK8sPersistentvolumeclaimPhase(mbc.Metrics.K8sPersistentvolumeclaimPhase),
+		metricK8sPersistentvolumeclaimStorageRequest: newMetricK8sPersistentvolumeclaimStorageRequest(mbc.Metrics.K8sPersistentvolumeclaimStorageRequest),
+		metricK8sPodPhase:                            newMetricK8sPodPhase(mbc.Metrics.K8sPodPhase),
+		metricK8sPodStatusReason:                     newMetricK8sPodStatusReason(mbc.Metrics.K8sPodStatusReason),
+		metricK8sReplicasetAvailable:                 newMetricK8sReplicasetAvailable(mbc.Metrics.K8sReplicasetAvailable),
+		metricK8sReplicasetDesired:                   newMetricK8sReplicasetDesired(mbc.Metrics.K8sReplicasetDesired),
+		metricK8sReplicationControllerAvailable:      newMetricK8sReplicationControllerAvailable(mbc.Metrics.K8sReplicationControllerAvailable),
+		metricK8sReplicationControllerDesired:        newMetricK8sReplicationControllerDesired(mbc.Metrics.K8sReplicationControllerDesired),
+		metricK8sResourceQuotaHardLimit:              newMetricK8sResourceQuotaHardLimit(mbc.Metrics.K8sResourceQuotaHardLimit),
+		metricK8sResourceQuotaUsed:                   newMetricK8sResourceQuotaUsed(mbc.Metrics.K8sResourceQuotaUsed),
+		metricK8sStatefulsetCurrentPods:              newMetricK8sStatefulsetCurrentPods(mbc.Metrics.K8sStatefulsetCurrentPods),
+		metricK8sStatefulsetDesiredPods:              newMetricK8sStatefulsetDesiredPods(mbc.Metrics.K8sStatefulsetDesiredPods),
+		metricK8sStatefulsetReadyPods:                newMetricK8sStatefulsetReadyPods(mbc.Metrics.K8sStatefulsetReadyPods),
+		metricK8sStatefulsetUpdatedPods:              newMetricK8sStatefulsetUpdatedPods(mbc.Metrics.K8sStatefulsetUpdatedPods),
+		metricOpenshiftAppliedclusterquotaLimit:      newMetricOpenshiftAppliedclusterquotaLimit(mbc.Metrics.OpenshiftAppliedclusterquotaLimit),
+		metricOpenshiftAppliedclusterquotaUsed:       newMetricOpenshiftAppliedclusterquotaUsed(mbc.Metrics.OpenshiftAppliedclusterquotaUsed),
+		metricOpenshiftClusterquotaLimit:             newMetricOpenshiftClusterquotaLimit(mbc.Metrics.OpenshiftClusterquotaLimit),
+		metricOpenshiftClusterquotaUsed:              newMetricOpenshiftClusterquotaUsed(mbc.Metrics.OpenshiftClusterquotaUsed),
+		resourceAttributeIncludeFilter:               make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:               make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.ContainerID.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["container.id"] = filter.CreateFilter(mbc.ResourceAttributes.ContainerID.MetricsInclude)
@@ -2575,6 +3075,24 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sDeploymentUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.deployment.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sDeploymentUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sGatewayName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.gateway.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sGatewayName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.gateway.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sGatewayUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.gateway.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sGatewayUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.gateway.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayUID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sGatewayclassName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.gatewayclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayclassName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sGatewayclassName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.gatewayclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sGatewayclassName.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sHpaName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.hpa.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHpaName.MetricsInclude)
 	}
@@ -2605,6 +3123,30 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sHpaUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.hpa.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHpaUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sHttprouteName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.httproute.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHttprouteName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sHttprouteName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.httproute.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHttprouteName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sHttprouteUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.httproute.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHttprouteUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sHttprouteUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.httproute.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sHttprouteUID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.ingress.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.ingress.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.ingress.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sIngressUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.ingress.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sIngressUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sJobName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.job.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sJobName.MetricsInclude)
 	}
@@ -2647,6 +3189,30 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sNodeUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.node.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sNodeUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolume.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolume.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolume.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolume.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeUID.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolumeclaim.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolumeclaim.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.persistentvolumeclaim.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.persistentvolumeclaim.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPersistentvolumeclaimUID.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.K8sPodName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["k8s.pod.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sPodName.MetricsInclude)
 	}
@@ -2713,6 +3279,18 @@ func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, opt
 	if mbc.ResourceAttributes.K8sStatefulsetUID.MetricsExclude != nil {
 		mb.resourceAttributeExcludeFilter["k8s.statefulset.uid"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStatefulsetUID.MetricsExclude)
 	}
+	if mbc.ResourceAttributes.K8sStorageclassName.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.storageclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassName.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sStorageclassName.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.storageclass.name"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassName.MetricsExclude)
+	}
+	if mbc.ResourceAttributes.K8sStorageclassProvisioner.MetricsInclude != nil {
+		mb.resourceAttributeIncludeFilter["k8s.storageclass.provisioner"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassProvisioner.MetricsInclude)
+	}
+	if mbc.ResourceAttributes.K8sStorageclassProvisioner.MetricsExclude != nil {
+		mb.resourceAttributeExcludeFilter["k8s.storageclass.provisioner"] = filter.CreateFilter(mbc.ResourceAttributes.K8sStorageclassProvisioner.MetricsExclude)
+	}
 	if mbc.ResourceAttributes.OpenshiftClusterquotaName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["openshift.clusterquota.name"] = filter.CreateFilter(mbc.ResourceAttributes.OpenshiftClusterquotaName.MetricsInclude)
 	}
@@ -2824,10 +3402,14 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sDaemonsetReadyNodes.emit(ils.Metrics())
 	mb.metricK8sDeploymentAvailable.emit(ils.Metrics())
 	mb.metricK8sDeploymentDesired.emit(ils.Metrics())
+	mb.metricK8sGatewayCondition.emit(ils.Metrics())
 	mb.metricK8sHpaCurrentReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaDesiredReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaMaxReplicas.emit(ils.Metrics())
 	mb.metricK8sHpaMinReplicas.emit(ils.Metrics())
+	mb.metricK8sHttprouteCondition.emit(ils.Metrics())
+	mb.metricK8sIngressLoadBalancerAddresses.emit(ils.Metrics())
+	mb.metricK8sIngressRules.emit(ils.Metrics())
 	mb.metricK8sJobActivePods.emit(ils.Metrics())
 	mb.metricK8sJobDesiredSuccessfulPods.emit(ils.Metrics())
 	mb.metricK8sJobFailedPods.emit(ils.Metrics())
@@ -2835,6 +3417,11 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	mb.metricK8sJobSuccessfulPods.emit(ils.Metrics())
 	mb.metricK8sNamespacePhase.emit(ils.Metrics())
 	mb.metricK8sNodeCondition.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumePhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimCapacity.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimPhase.emit(ils.Metrics())
+	mb.metricK8sPersistentvolumeclaimStorageRequest.emit(ils.Metrics())
 	mb.metricK8sPodPhase.emit(ils.Metrics())
 	mb.metricK8sPodStatusReason.emit(ils.Metrics())
 	mb.metricK8sReplicasetAvailable.emit(ils.Metrics())
@@ -2967,6 +3554,11 @@ func (mb *MetricsBuilder) RecordK8sDeploymentDesiredDataPoint(ts pcommon.Timesta
 	mb.metricK8sDeploymentDesired.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sGatewayConditionDataPoint adds a data point to k8s.gateway.condition metric.
+func (mb *MetricsBuilder) RecordK8sGatewayConditionDataPoint(ts pcommon.Timestamp, val int64, conditionAttributeValue string) {
+	mb.metricK8sGatewayCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
+}
+
 // RecordK8sHpaCurrentReplicasDataPoint adds a data point to k8s.hpa.current_replicas metric.
 func (mb *MetricsBuilder) RecordK8sHpaCurrentReplicasDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sHpaCurrentReplicas.recordDataPoint(mb.startTime, ts, val)
@@ -2987,6 +3579,21 @@ func (mb *MetricsBuilder) RecordK8sHpaMinReplicasDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sHpaMinReplicas.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordK8sHttprouteConditionDataPoint adds a data point to k8s.httproute.condition metric.
+func (mb *MetricsBuilder) RecordK8sHttprouteConditionDataPoint(ts pcommon.Timestamp, val int64, k8sGatewayNameAttributeValue string, conditionAttributeValue string) {
+	mb.metricK8sHttprouteCondition.recordDataPoint(mb.startTime, ts, val, k8sGatewayNameAttributeValue, conditionAttributeValue)
+}
+
+// RecordK8sIngressLoadBalancerAddressesDataPoint adds a data point to k8s.ingress.load_balancer_addresses metric.
+func (mb *MetricsBuilder) RecordK8sIngressLoadBalancerAddressesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressLoadBalancerAddresses.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sIngressRulesDataPoint adds a data point to k8s.ingress.rules metric.
+func (mb *MetricsBuilder) RecordK8sIngressRulesDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sIngressRules.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sJobActivePodsDataPoint adds a data point to k8s.job.active_pods metric.
 func (mb *MetricsBuilder) RecordK8sJobActivePodsDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sJobActivePods.recordDataPoint(mb.startTime, ts, val)
@@ -3022,6 +3629,31 @@ func (mb *MetricsBuilder) RecordK8sNodeConditionDataPoint(ts pcommon.Timestamp,
 	mb.metricK8sNodeCondition.recordDataPoint(mb.startTime, ts, val, conditionAttributeValue)
 }
 
+// RecordK8sPersistentvolumeCapacityDataPoint adds a data point to k8s.persistentvolume.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumePhaseDataPoint adds a data point to k8s.persistentvolume.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumePhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumePhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimCapacityDataPoint adds a data point to k8s.persistentvolumeclaim.capacity metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimCapacityDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimCapacity.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimPhaseDataPoint adds a data point to k8s.persistentvolumeclaim.phase metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimPhaseDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimPhase.recordDataPoint(mb.startTime, ts, val)
+}
+
+// RecordK8sPersistentvolumeclaimStorageRequestDataPoint adds a data point to k8s.persistentvolumeclaim.storage_request metric.
+func (mb *MetricsBuilder) RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts pcommon.Timestamp, val int64) {
+	mb.metricK8sPersistentvolumeclaimStorageRequest.recordDataPoint(mb.startTime, ts, val)
+}
+
 // RecordK8sPodPhaseDataPoint adds a data point to k8s.pod.phase metric.
 func (mb *MetricsBuilder) RecordK8sPodPhaseDataPoint(ts pcommon.Timestamp, val int64) {
 	mb.metricK8sPodPhase.recordDataPoint(mb.startTime, ts, val)
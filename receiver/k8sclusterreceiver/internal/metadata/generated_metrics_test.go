@@ -136,6 +136,9 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sDeploymentDesiredDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sGatewayConditionDataPoint(ts, 1, "condition-val")
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sHpaCurrentReplicasDataPoint(ts, 1)
@@ -152,6 +155,15 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sHpaMinReplicasDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordK8sHttprouteConditionDataPoint(ts, 1, "k8s.gateway.name-val", "condition-val")
+
+			allMetricsCount++
+			mb.RecordK8sIngressLoadBalancerAddressesDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sIngressRulesDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sJobActivePodsDataPoint(ts, 1)
@@ -179,6 +191,21 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordK8sNodeConditionDataPoint(ts, 1, "condition-val")
 
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumePhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimCapacityDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, 1)
+
+			allMetricsCount++
+			mb.RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts, 1)
+
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordK8sPodPhaseDataPoint(ts, 1)
@@ -256,11 +283,18 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sDaemonsetUID("k8s.daemonset.uid-val")
 			rb.SetK8sDeploymentName("k8s.deployment.name-val")
 			rb.SetK8sDeploymentUID("k8s.deployment.uid-val")
+			rb.SetK8sGatewayName("k8s.gateway.name-val")
+			rb.SetK8sGatewayUID("k8s.gateway.uid-val")
+			rb.SetK8sGatewayclassName("k8s.gatewayclass.name-val")
 			rb.SetK8sHpaName("k8s.hpa.name-val")
 			rb.SetK8sHpaScaletargetrefApiversion("k8s.hpa.scaletargetref.apiversion-val")
 			rb.SetK8sHpaScaletargetrefKind("k8s.hpa.scaletargetref.kind-val")
 			rb.SetK8sHpaScaletargetrefName("k8s.hpa.scaletargetref.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sHttprouteName("k8s.httproute.name-val")
+			rb.SetK8sHttprouteUID("k8s.httproute.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
@@ -268,6 +302,10 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
@@ -279,6 +317,8 @@ func TestMetricsBuilder(t *testing.T) {
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
+			rb.SetK8sStorageclassProvisioner("k8s.storageclass.provisioner-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
 			rb.SetOsDescription("os.description-val")
@@ -509,6 +549,21 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.gateway.condition":
+					assert.False(t, validatedMetrics["k8s.gateway.condition"], "Found a duplicate in the metrics slice: k8s.gateway.condition")
+					validatedMetrics["k8s.gateway.condition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The condition of a particular Gateway (1 for true, 0 for false, -1 for unknown)", ms.At(i).Description())
+					assert.Equal(t, "{condition}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("condition")
+					assert.True(t, ok)
+					assert.Equal(t, "condition-val", attrVal.Str())
 				case "k8s.hpa.current_replicas":
 					assert.False(t, validatedMetrics["k8s.hpa.current_replicas"], "Found a duplicate in the metrics slice: k8s.hpa.current_replicas")
 					validatedMetrics["k8s.hpa.current_replicas"] = true
@@ -557,6 +612,48 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.httproute.condition":
+					assert.False(t, validatedMetrics["k8s.httproute.condition"], "Found a duplicate in the metrics slice: k8s.httproute.condition")
+					validatedMetrics["k8s.httproute.condition"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The condition of a particular HTTPRoute for one of its parent Gateways (1 for true, 0 for false, -1 for unknown)", ms.At(i).Description())
+					assert.Equal(t, "{condition}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+					attrVal, ok := dp.Attributes().Get("k8s.gateway.name")
+					assert.True(t, ok)
+					assert.Equal(t, "k8s.gateway.name-val", attrVal.Str())
+					attrVal, ok = dp.Attributes().Get("condition")
+					assert.True(t, ok)
+					assert.Equal(t, "condition-val", attrVal.Str())
+				case "k8s.ingress.load_balancer_addresses":
+					assert.False(t, validatedMetrics["k8s.ingress.load_balancer_addresses"], "Found a duplicate in the metrics slice: k8s.ingress.load_balancer_addresses")
+					validatedMetrics["k8s.ingress.load_balancer_addresses"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of load balancer addresses (IPs or hostnames) reported in the status of the ingress", ms.At(i).Description())
+					assert.Equal(t, "{address}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.ingress.rules":
+					assert.False(t, validatedMetrics["k8s.ingress.rules"], "Found a duplicate in the metrics slice: k8s.ingress.rules")
+					validatedMetrics["k8s.ingress.rules"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Number of rules defined for the ingress", ms.At(i).Description())
+					assert.Equal(t, "{rule}", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.job.active_pods":
 					assert.False(t, validatedMetrics["k8s.job.active_pods"], "Found a duplicate in the metrics slice: k8s.job.active_pods")
 					validatedMetrics["k8s.job.active_pods"] = true
@@ -644,6 +741,66 @@ func TestMetricsBuilder(t *testing.T) {
 					attrVal, ok := dp.Attributes().Get("condition")
 					assert.True(t, ok)
 					assert.Equal(t, "condition-val", attrVal.Str())
+				case "k8s.persistentvolume.capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolume.capacity")
+					validatedMetrics["k8s.persistentvolume.capacity"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage capacity of the persistent volume", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolume.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolume.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolume.phase")
+					validatedMetrics["k8s.persistentvolume.phase"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current phase of the persistent volume (1 - Pending, 2 - Available, 3 - Bound, 4 - Released, 5 - Failed)", ms.At(i).Description())
+					assert.Empty(t, ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.capacity":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.capacity"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.capacity")
+					validatedMetrics["k8s.persistentvolumeclaim.capacity"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage capacity of the volume bound to the persistent volume claim", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.phase":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.phase"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.phase")
+					validatedMetrics["k8s.persistentvolumeclaim.phase"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "Current phase of the persistent volume claim (1 - Pending, 2 - Bound, 3 - Lost)", ms.At(i).Description())
+					assert.Empty(t, ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
+				case "k8s.persistentvolumeclaim.storage_request":
+					assert.False(t, validatedMetrics["k8s.persistentvolumeclaim.storage_request"], "Found a duplicate in the metrics slice: k8s.persistentvolumeclaim.storage_request")
+					validatedMetrics["k8s.persistentvolumeclaim.storage_request"] = true
+					assert.Equal(t, pmetric.MetricTypeGauge, ms.At(i).Type())
+					assert.Equal(t, 1, ms.At(i).Gauge().DataPoints().Len())
+					assert.Equal(t, "The storage requested by the persistent volume claim", ms.At(i).Description())
+					assert.Equal(t, "By", ms.At(i).Unit())
+					dp := ms.At(i).Gauge().DataPoints().At(0)
+					assert.Equal(t, start, dp.StartTimestamp())
+					assert.Equal(t, ts, dp.Timestamp())
+					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+					assert.Equal(t, int64(1), dp.IntValue())
 				case "k8s.pod.phase":
 					assert.False(t, validatedMetrics["k8s.pod.phase"], "Found a duplicate in the metrics slice: k8s.pod.phase")
 					validatedMetrics["k8s.pod.phase"] = true
@@ -112,6 +112,27 @@ func (rb *ResourceBuilder) SetK8sDeploymentUID(val string) {
 	}
 }
 
+// SetK8sGatewayName sets provided value as "k8s.gateway.name" attribute.
+func (rb *ResourceBuilder) SetK8sGatewayName(val string) {
+	if rb.config.K8sGatewayName.Enabled {
+		rb.res.Attributes().PutStr("k8s.gateway.name", val)
+	}
+}
+
+// SetK8sGatewayUID sets provided value as "k8s.gateway.uid" attribute.
+func (rb *ResourceBuilder) SetK8sGatewayUID(val string) {
+	if rb.config.K8sGatewayUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.gateway.uid", val)
+	}
+}
+
+// SetK8sGatewayclassName sets provided value as "k8s.gatewayclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sGatewayclassName(val string) {
+	if rb.config.K8sGatewayclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.gatewayclass.name", val)
+	}
+}
+
 // SetK8sHpaName sets provided value as "k8s.hpa.name" attribute.
 func (rb *ResourceBuilder) SetK8sHpaName(val string) {
 	if rb.config.K8sHpaName.Enabled {
@@ -147,6 +168,34 @@ func (rb *ResourceBuilder) SetK8sHpaUID(val string) {
 	}
 }
 
+// SetK8sHttprouteName sets provided value as "k8s.httproute.name" attribute.
+func (rb *ResourceBuilder) SetK8sHttprouteName(val string) {
+	if rb.config.K8sHttprouteName.Enabled {
+		rb.res.Attributes().PutStr("k8s.httproute.name", val)
+	}
+}
+
+// SetK8sHttprouteUID sets provided value as "k8s.httproute.uid" attribute.
+func (rb *ResourceBuilder) SetK8sHttprouteUID(val string) {
+	if rb.config.K8sHttprouteUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.httproute.uid", val)
+	}
+}
+
+// SetK8sIngressName sets provided value as "k8s.ingress.name" attribute.
+func (rb *ResourceBuilder) SetK8sIngressName(val string) {
+	if rb.config.K8sIngressName.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.name", val)
+	}
+}
+
+// SetK8sIngressUID sets provided value as "k8s.ingress.uid" attribute.
+func (rb *ResourceBuilder) SetK8sIngressUID(val string) {
+	if rb.config.K8sIngressUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.ingress.uid", val)
+	}
+}
+
 // SetK8sJobName sets provided value as "k8s.job.name" attribute.
 func (rb *ResourceBuilder) SetK8sJobName(val string) {
 	if rb.config.K8sJobName.Enabled {
@@ -196,6 +245,34 @@ func (rb *ResourceBuilder) SetK8sNodeUID(val string) {
 	}
 }
 
+// SetK8sPersistentvolumeName sets provided value as "k8s.persistentvolume.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeName(val string) {
+	if rb.config.K8sPersistentvolumeName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeUID sets provided value as "k8s.persistentvolume.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeUID(val string) {
+	if rb.config.K8sPersistentvolumeUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolume.uid", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimName sets provided value as "k8s.persistentvolumeclaim.name" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimName(val string) {
+	if rb.config.K8sPersistentvolumeclaimName.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.name", val)
+	}
+}
+
+// SetK8sPersistentvolumeclaimUID sets provided value as "k8s.persistentvolumeclaim.uid" attribute.
+func (rb *ResourceBuilder) SetK8sPersistentvolumeclaimUID(val string) {
+	if rb.config.K8sPersistentvolumeclaimUID.Enabled {
+		rb.res.Attributes().PutStr("k8s.persistentvolumeclaim.uid", val)
+	}
+}
+
 // SetK8sPodName sets provided value as "k8s.pod.name" attribute.
 func (rb *ResourceBuilder) SetK8sPodName(val string) {
 	if rb.config.K8sPodName.Enabled {
@@ -273,6 +350,20 @@ func (rb *ResourceBuilder) SetK8sStatefulsetUID(val string) {
 	}
 }
 
+// SetK8sStorageclassName sets provided value as "k8s.storageclass.name" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassName(val string) {
+	if rb.config.K8sStorageclassName.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.name", val)
+	}
+}
+
+// SetK8sStorageclassProvisioner sets provided value as "k8s.storageclass.provisioner" attribute.
+func (rb *ResourceBuilder) SetK8sStorageclassProvisioner(val string) {
+	if rb.config.K8sStorageclassProvisioner.Enabled {
+		rb.res.Attributes().PutStr("k8s.storageclass.provisioner", val)
+	}
+}
+
 // SetOpenshiftClusterquotaName sets provided value as "openshift.clusterquota.name" attribute.
 func (rb *ResourceBuilder) SetOpenshiftClusterquotaName(val string) {
 	if rb.config.OpenshiftClusterquotaName.Enabled {
@@ -26,11 +26,18 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sDaemonsetUID("k8s.daemonset.uid-val")
 			rb.SetK8sDeploymentName("k8s.deployment.name-val")
 			rb.SetK8sDeploymentUID("k8s.deployment.uid-val")
+			rb.SetK8sGatewayName("k8s.gateway.name-val")
+			rb.SetK8sGatewayUID("k8s.gateway.uid-val")
+			rb.SetK8sGatewayclassName("k8s.gatewayclass.name-val")
 			rb.SetK8sHpaName("k8s.hpa.name-val")
 			rb.SetK8sHpaScaletargetrefApiversion("k8s.hpa.scaletargetref.apiversion-val")
 			rb.SetK8sHpaScaletargetrefKind("k8s.hpa.scaletargetref.kind-val")
 			rb.SetK8sHpaScaletargetrefName("k8s.hpa.scaletargetref.name-val")
 			rb.SetK8sHpaUID("k8s.hpa.uid-val")
+			rb.SetK8sHttprouteName("k8s.httproute.name-val")
+			rb.SetK8sHttprouteUID("k8s.httproute.uid-val")
+			rb.SetK8sIngressName("k8s.ingress.name-val")
+			rb.SetK8sIngressUID("k8s.ingress.uid-val")
 			rb.SetK8sJobName("k8s.job.name-val")
 			rb.SetK8sJobUID("k8s.job.uid-val")
 			rb.SetK8sKubeletVersion("k8s.kubelet.version-val")
@@ -38,6 +45,10 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sNamespaceUID("k8s.namespace.uid-val")
 			rb.SetK8sNodeName("k8s.node.name-val")
 			rb.SetK8sNodeUID("k8s.node.uid-val")
+			rb.SetK8sPersistentvolumeName("k8s.persistentvolume.name-val")
+			rb.SetK8sPersistentvolumeUID("k8s.persistentvolume.uid-val")
+			rb.SetK8sPersistentvolumeclaimName("k8s.persistentvolumeclaim.name-val")
+			rb.SetK8sPersistentvolumeclaimUID("k8s.persistentvolumeclaim.uid-val")
 			rb.SetK8sPodName("k8s.pod.name-val")
 			rb.SetK8sPodQosClass("k8s.pod.qos_class-val")
 			rb.SetK8sPodUID("k8s.pod.uid-val")
@@ -49,6 +60,8 @@ func TestResourceBuilder(t *testing.T) {
 			rb.SetK8sResourcequotaUID("k8s.resourcequota.uid-val")
 			rb.SetK8sStatefulsetName("k8s.statefulset.name-val")
 			rb.SetK8sStatefulsetUID("k8s.statefulset.uid-val")
+			rb.SetK8sStorageclassName("k8s.storageclass.name-val")
+			rb.SetK8sStorageclassProvisioner("k8s.storageclass.provisioner-val")
 			rb.SetOpenshiftClusterquotaName("openshift.clusterquota.name-val")
 			rb.SetOpenshiftClusterquotaUID("openshift.clusterquota.uid-val")
 			rb.SetOsDescription("os.description-val")
@@ -59,9 +72,9 @@ func TestResourceBuilder(t *testing.T) {
 
 			switch tt {
 			case "default":
-				assert.Equal(t, 30, res.Attributes().Len())
+				assert.Equal(t, 43, res.Attributes().Len())
 			case "all_set":
-				assert.Equal(t, 40, res.Attributes().Len())
+				assert.Equal(t, 53, res.Attributes().Len())
 			case "none_set":
 				assert.Equal(t, 0, res.Attributes().Len())
 				return
@@ -134,6 +147,21 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.deployment.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.gateway.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.gateway.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.gateway.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.gateway.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.gatewayclass.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.gatewayclass.name-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.hpa.name")
 			assert.True(t, ok)
 			if ok {
@@ -159,6 +187,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.hpa.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.httproute.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.httproute.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.httproute.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.httproute.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.ingress.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.ingress.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.ingress.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.ingress.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.job.name")
 			assert.True(t, ok)
 			if ok {
@@ -194,6 +242,26 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.node.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolume.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolume.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolume.uid-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolumeclaim.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.persistentvolumeclaim.uid")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.persistentvolumeclaim.uid-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("k8s.pod.name")
 			assert.True(t, ok)
 			if ok {
@@ -249,6 +317,16 @@ func TestResourceBuilder(t *testing.T) {
 			if ok {
 				assert.Equal(t, "k8s.statefulset.uid-val", val.Str())
 			}
+			val, ok = res.Attributes().Get("k8s.storageclass.name")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.storageclass.name-val", val.Str())
+			}
+			val, ok = res.Attributes().Get("k8s.storageclass.provisioner")
+			assert.True(t, ok)
+			if ok {
+				assert.Equal(t, "k8s.storageclass.provisioner-val", val.Str())
+			}
 			val, ok = res.Attributes().Get("openshift.clusterquota.name")
 			assert.True(t, ok)
 			if ok {
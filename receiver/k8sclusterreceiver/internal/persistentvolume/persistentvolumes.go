@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
+)
+
+// Keys for persistent volume metadata.
+const (
+	k8sPersistentVolumePhase = "k8s.persistentvolume.phase"
+	k8sStorageClassName      = "k8s.storageclass.name"
+)
+
+var phaseValues = map[corev1.PersistentVolumePhase]int64{
+	corev1.VolumePending:   1,
+	corev1.VolumeAvailable: 2,
+	corev1.VolumeBound:     3,
+	corev1.VolumeReleased:  4,
+	corev1.VolumeFailed:    5,
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pv *corev1.PersistentVolume, ms *metadata.Store, ts pcommon.Timestamp) {
+	if phase, ok := phaseValues[pv.Status.Phase]; ok {
+		mb.RecordK8sPersistentvolumePhaseDataPoint(ts, phase)
+	}
+	if capacity, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeCapacityDataPoint(ts, capacity.Value())
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sPersistentvolumeName(pv.Name)
+	rb.SetK8sPersistentvolumeUID(string(pv.UID))
+	if pv.Spec.StorageClassName != "" {
+		rb.SetK8sStorageclassName(pv.Spec.StorageClassName)
+		if provisioner := storageclass.Provisioner(ms, pv.Spec.StorageClassName); provisioner != "" {
+			rb.SetK8sStorageclassProvisioner(provisioner)
+		}
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadata(pv *corev1.PersistentVolume) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&pv.ObjectMeta, constants.K8sKindPersistentVolume)
+	// Persistent volumes are cluster-scoped.
+	delete(km.Metadata, constants.K8sKeyNamespaceName)
+
+	if pv.Status.Phase != "" {
+		km.Metadata[k8sPersistentVolumePhase] = string(pv.Status.Phase)
+	}
+	if pv.Spec.StorageClassName != "" {
+		km.Metadata[k8sStorageClassName] = pv.Spec.StorageClassName
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(pv.UID): km,
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolume
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestPersistentVolumeMetrics(t *testing.T) {
+	obj := testutils.NewPersistentVolume("1")
+	sc := testutils.NewStorageClass("1")
+	ms := metadata.NewStore()
+	ms.Setup(gvk.StorageClass, &testutils.MockStore{
+		Cache: map[string]any{sc.Name: sc},
+	})
+
+	ts := pcommon.Timestamp(time.Now().UnixNano())
+	mbc := metadata.DefaultMetricsBuilderConfig()
+	mbc.Metrics.K8sPersistentvolumePhase.Enabled = true
+	mbc.Metrics.K8sPersistentvolumeCapacity.Enabled = true
+	mb := metadata.NewMetricsBuilder(mbc, receivertest.NewNopSettings(metadata.Type))
+	RecordMetrics(mb, obj, ms, ts)
+	m := mb.Emit()
+
+	expected, err := golden.ReadMetrics(filepath.Join("testdata", "expected.yaml"))
+	require.NoError(t, err)
+	require.NoError(t, pmetrictest.CompareMetrics(expected, m,
+		pmetrictest.IgnoreTimestamp(),
+		pmetrictest.IgnoreStartTimestamp(),
+		pmetrictest.IgnoreResourceMetricsOrder(),
+		pmetrictest.IgnoreMetricsOrder(),
+		pmetrictest.IgnoreScopeMetricsOrder(),
+	),
+	)
+}
+
+func TestPersistentVolumeMetadata(t *testing.T) {
+	pv := testutils.NewPersistentVolume("1")
+
+	meta := GetMetadata(pv)
+
+	require.Contains(t, meta, experimentalmetricmetadata.ResourceID("test-persistentvolume-1-uid"))
+	km := meta[experimentalmetricmetadata.ResourceID("test-persistentvolume-1-uid")]
+	require.Equal(t, "k8s.persistentvolume", km.EntityType)
+	require.Equal(t, "test-persistentvolume-1", km.Metadata["k8s.workload.name"])
+	require.NotContains(t, km.Metadata, "k8s.namespace.name")
+	require.Equal(t, "Bound", km.Metadata["k8s.persistentvolume.phase"])
+	require.Equal(t, "test-storageclass-1", km.Metadata["k8s.storageclass.name"])
+}
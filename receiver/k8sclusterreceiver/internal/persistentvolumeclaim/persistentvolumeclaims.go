@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package persistentvolumeclaim // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
+)
+
+// Keys for persistent volume claim metadata.
+const (
+	k8sPersistentVolumeClaimPhase = "k8s.persistentvolumeclaim.phase"
+	k8sPersistentVolumeName       = "k8s.persistentvolume.name"
+	k8sStorageClassName           = "k8s.storageclass.name"
+)
+
+var phaseValues = map[corev1.PersistentVolumeClaimPhase]int64{
+	corev1.ClaimPending: 1,
+	corev1.ClaimBound:   2,
+	corev1.ClaimLost:    3,
+}
+
+func RecordMetrics(mb *metadata.MetricsBuilder, pvc *corev1.PersistentVolumeClaim, ms *metadata.Store, ts pcommon.Timestamp) {
+	if phase, ok := phaseValues[pvc.Status.Phase]; ok {
+		mb.RecordK8sPersistentvolumeclaimPhaseDataPoint(ts, phase)
+	}
+	if request, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimStorageRequestDataPoint(ts, request.Value())
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		mb.RecordK8sPersistentvolumeclaimCapacityDataPoint(ts, capacity.Value())
+	}
+
+	rb := mb.NewResourceBuilder()
+	rb.SetK8sNamespaceName(pvc.Namespace)
+	rb.SetK8sPersistentvolumeclaimName(pvc.Name)
+	rb.SetK8sPersistentvolumeclaimUID(string(pvc.UID))
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		rb.SetK8sStorageclassName(*pvc.Spec.StorageClassName)
+		if provisioner := storageclass.Provisioner(ms, *pvc.Spec.StorageClassName); provisioner != "" {
+			rb.SetK8sStorageclassProvisioner(provisioner)
+		}
+	}
+	mb.EmitForResource(metadata.WithResource(rb.Emit()))
+}
+
+func GetMetadata(pvc *corev1.PersistentVolumeClaim) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&pvc.ObjectMeta, constants.K8sKindPersistentVolumeClaim)
+	if pvc.Status.Phase != "" {
+		km.Metadata[k8sPersistentVolumeClaimPhase] = string(pvc.Status.Phase)
+	}
+	if pvc.Spec.VolumeName != "" {
+		km.Metadata[k8sPersistentVolumeName] = pvc.Spec.VolumeName
+	}
+	if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+		km.Metadata[k8sStorageClassName] = *pvc.Spec.StorageClassName
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(pvc.UID): km,
+	}
+}
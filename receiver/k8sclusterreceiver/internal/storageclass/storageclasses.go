@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storageclass // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
+
+import (
+	"strconv"
+
+	storagev1 "k8s.io/api/storage/v1"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/constants"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+)
+
+// Keys for storage class metadata.
+const (
+	k8sStorageClassProvisioner          = "k8s.storageclass.provisioner"
+	k8sStorageClassReclaimPolicy        = "k8s.storageclass.reclaim_policy"
+	k8sStorageClassVolumeBindingMode    = "k8s.storageclass.volume_binding_mode"
+	k8sStorageClassAllowVolumeExpansion = "k8s.storageclass.allow_volume_expansion"
+)
+
+// Provisioner returns the provisioner of the storage class with the given name. An empty string is
+// returned if the storage classes are not watched, or if the storage class doesn't exist.
+func Provisioner(ms *metadata.Store, name string) string {
+	store := ms.Get(gvk.StorageClass)
+	if store == nil || name == "" {
+		return ""
+	}
+	obj, exists, err := store.GetByKey(name)
+	if err != nil || !exists {
+		return ""
+	}
+	return obj.(*storagev1.StorageClass).Provisioner
+}
+
+func GetMetadata(sc *storagev1.StorageClass) map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata {
+	km := metadata.GetGenericMetadata(&sc.ObjectMeta, constants.K8sKindStorageClass)
+	// Storage classes are cluster-scoped.
+	delete(km.Metadata, constants.K8sKeyNamespaceName)
+
+	km.Metadata[k8sStorageClassProvisioner] = sc.Provisioner
+	if sc.ReclaimPolicy != nil {
+		km.Metadata[k8sStorageClassReclaimPolicy] = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		km.Metadata[k8sStorageClassVolumeBindingMode] = string(*sc.VolumeBindingMode)
+	}
+	if sc.AllowVolumeExpansion != nil {
+		km.Metadata[k8sStorageClassAllowVolumeExpansion] = strconv.FormatBool(*sc.AllowVolumeExpansion)
+	}
+	return map[experimentalmetricmetadata.ResourceID]*metadata.KubernetesMetadata{
+		experimentalmetricmetadata.ResourceID(sc.UID): km,
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package storageclass
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
+)
+
+func TestProvisioner(t *testing.T) {
+	sc := testutils.NewStorageClass("1")
+
+	ms := metadata.NewStore()
+	assert.Empty(t, Provisioner(ms, sc.Name), "storage classes not watched")
+
+	ms.Setup(gvk.StorageClass, &testutils.MockStore{
+		Cache: map[string]any{sc.Name: sc},
+	})
+	assert.Equal(t, "ebs.csi.aws.com", Provisioner(ms, sc.Name))
+	assert.Empty(t, Provisioner(ms, "unknown"))
+	assert.Empty(t, Provisioner(ms, ""))
+
+	ms.Setup(gvk.StorageClass, &testutils.MockStore{WantErr: true})
+	assert.Empty(t, Provisioner(ms, sc.Name))
+}
+
+func TestStorageClassMetadata(t *testing.T) {
+	sc := testutils.NewStorageClass("1")
+
+	meta := GetMetadata(sc)
+
+	require.Contains(t, meta, experimentalmetricmetadata.ResourceID("test-storageclass-1-uid"))
+	km := meta[experimentalmetricmetadata.ResourceID("test-storageclass-1-uid")]
+	require.Equal(t, "k8s.storageclass", km.EntityType)
+	require.Equal(t, "test-storageclass-1", km.Metadata["k8s.workload.name"])
+	require.NotContains(t, km.Metadata, "k8s.namespace.name")
+	require.Equal(t, "ebs.csi.aws.com", km.Metadata["k8s.storageclass.provisioner"])
+	require.Equal(t, "Delete", km.Metadata["k8s.storageclass.reclaim_policy"])
+	require.Equal(t, "WaitForFirstConsumer", km.Metadata["k8s.storageclass.volume_binding_mode"])
+	require.Equal(t, "true", km.Metadata["k8s.storageclass.allow_volume_expansion"])
+}
@@ -11,8 +11,11 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -414,3 +417,181 @@ func NewCronJob(id string) *batchv1.CronJob {
 		},
 	}
 }
+
+func NewIngress(id string) *networkingv1.Ingress {
+	className := "nginx"
+	return &networkingv1.Ingress{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-ingress-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-ingress-" + id + "-uid"),
+			Labels: map[string]string{
+				"foo":  "bar",
+				"foo1": "",
+			},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: &className,
+			Rules: []networkingv1.IngressRule{
+				{Host: "foo.example.com"},
+				{Host: "bar.example.com"},
+				{Host: "baz.example.com"},
+			},
+		},
+		Status: networkingv1.IngressStatus{
+			LoadBalancer: networkingv1.IngressLoadBalancerStatus{
+				Ingress: []networkingv1.IngressLoadBalancerIngress{{IP: "10.0.0.1"}},
+			},
+		},
+	}
+}
+
+func NewStorageClass(id string) *storagev1.StorageClass {
+	reclaimPolicy := corev1.PersistentVolumeReclaimDelete
+	bindingMode := storagev1.VolumeBindingWaitForFirstConsumer
+	allowExpansion := true
+	return &storagev1.StorageClass{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-storageclass-" + id,
+			UID:  types.UID("test-storageclass-" + id + "-uid"),
+		},
+		Provisioner:          "ebs.csi.aws.com",
+		ReclaimPolicy:        &reclaimPolicy,
+		VolumeBindingMode:    &bindingMode,
+		AllowVolumeExpansion: &allowExpansion,
+	}
+}
+
+func NewPersistentVolume(id string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: v1.ObjectMeta{
+			Name: "test-persistentvolume-" + id,
+			UID:  types.UID("test-persistentvolume-" + id + "-uid"),
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+			StorageClassName: "test-storageclass-" + id,
+		},
+		Status: corev1.PersistentVolumeStatus{
+			Phase: corev1.VolumeBound,
+		},
+	}
+}
+
+func NewPersistentVolumeClaim(id string) *corev1.PersistentVolumeClaim {
+	storageClassName := "test-storageclass-" + id
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-persistentvolumeclaim-" + id,
+			Namespace: "test-namespace",
+			UID:       types.UID("test-persistentvolumeclaim-" + id + "-uid"),
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("5Gi"),
+				},
+			},
+			StorageClassName: &storageClassName,
+			VolumeName:       "test-persistentvolume-" + id,
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Phase: corev1.ClaimBound,
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+	}
+}
+
+// NewGateway returns an unstructured Gateway, as received from the dynamic client.
+func NewGateway(id string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "Gateway",
+			"metadata": map[string]any{
+				"name":      "test-gateway-" + id,
+				"namespace": "test-namespace",
+				"uid":       "test-gateway-" + id + "-uid",
+			},
+			"spec": map[string]any{
+				"gatewayClassName": "test-gatewayclass",
+				"listeners": []any{
+					map[string]any{"name": "http", "port": int64(80), "protocol": "HTTP"},
+				},
+			},
+			"status": map[string]any{
+				"conditions": []any{
+					map[string]any{
+						"type":               "Accepted",
+						"status":             "True",
+						"reason":             "Accepted",
+						"lastTransitionTime": "2024-01-01T00:00:00Z",
+					},
+					map[string]any{
+						"type":               "Programmed",
+						"status":             "False",
+						"reason":             "Invalid",
+						"lastTransitionTime": "2024-01-01T00:00:00Z",
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewHTTPRoute returns an unstructured HTTPRoute, as received from the dynamic client.
+func NewHTTPRoute(id string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "gateway.networking.k8s.io/v1",
+			"kind":       "HTTPRoute",
+			"metadata": map[string]any{
+				"name":      "test-httproute-" + id,
+				"namespace": "test-namespace",
+				"uid":       "test-httproute-" + id + "-uid",
+			},
+			"spec": map[string]any{
+				"parentRefs": []any{
+					map[string]any{"name": "test-gateway-" + id},
+				},
+			},
+			"status": map[string]any{
+				"parents": []any{
+					map[string]any{
+						"parentRef":      map[string]any{"name": "test-gateway-" + id},
+						"controllerName": "example.com/gateway-controller",
+						"conditions": []any{
+							map[string]any{
+								"type":               "Accepted",
+								"status":             "True",
+								"reason":             "Accepted",
+								"lastTransitionTime": "2024-01-01T00:00:00Z",
+							},
+							map[string]any{
+								"type":               "ResolvedRefs",
+								"status":             "Unknown",
+								"reason":             "Pending",
+								"lastTransitionTime": "2024-01-01T00:00:00Z",
+							},
+						},
+					},
+					map[string]any{
+						"parentRef": map[string]any{"kind": "Service", "name": "test-service"},
+						"conditions": []any{
+							map[string]any{
+								"type":               "Accepted",
+								"status":             "True",
+								"reason":             "Accepted",
+								"lastTransitionTime": "2024-01-01T00:00:00Z",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
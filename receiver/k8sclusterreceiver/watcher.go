@@ -21,8 +21,12 @@ import (
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -32,16 +36,22 @@ import (
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/cronjob"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/daemonset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/deployment"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/hpa"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/httproute"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/ingress"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/jobs"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/namespace"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/node"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolume"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/persistentvolumeclaim"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/pod"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicaset"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/replicationcontroller"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/statefulset"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/storageclass"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/utils"
 )
 
@@ -53,6 +63,7 @@ type sharedInformer interface {
 type resourceWatcher struct {
 	client              kubernetes.Interface
 	osQuotaClient       quotaclientset.Interface
+	dynamicClient       dynamic.Interface
 	informerFactories   []sharedInformer
 	metadataStore       *metadata.Store
 	logger              *zap.Logger
@@ -66,6 +77,7 @@ type resourceWatcher struct {
 	// For mocking.
 	makeClient               func(apiConf k8sconfig.APIConfig) (kubernetes.Interface, error)
 	makeOpenShiftQuotaClient func(apiConf k8sconfig.APIConfig) (quotaclientset.Interface, error)
+	makeDynamicClient        func(apiConf k8sconfig.APIConfig) (dynamic.Interface, error)
 }
 
 type metadataConsumer func(metadata []*experimentalmetricmetadata.MetadataUpdate) error
@@ -81,6 +93,7 @@ func newResourceWatcher(set receiver.Settings, cfg *Config, metadataStore *metad
 		config:                   cfg,
 		makeClient:               k8sconfig.MakeClient,
 		makeOpenShiftQuotaClient: k8sconfig.MakeOpenShiftQuotaClient,
+		makeDynamicClient:        k8sconfig.MakeDynamicClient,
 	}
 }
 
@@ -98,6 +111,14 @@ func (rw *resourceWatcher) initialize() error {
 		}
 	}
 
+	// The Gateway API objects are custom resources, only watched through the dynamic client.
+	if rw.isKindEnabled(gvk.Gateway.Kind) || rw.isKindEnabled(gvk.HTTPRoute.Kind) {
+		rw.dynamicClient, err = rw.makeDynamicClient(rw.config.APIConfig)
+		if err != nil {
+			return fmt.Errorf("Failed to create Kubernetes dynamic client: %w", err)
+		}
+	}
+
 	err = rw.prepareSharedInformerFactory()
 	if err != nil {
 		return err
@@ -127,9 +148,28 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		"Job":                     {gvk.Job},
 		"CronJob":                 {gvk.CronJob},
 		"HorizontalPodAutoscaler": {gvk.HorizontalPodAutoscaler},
+		"Ingress":                 {gvk.Ingress},
+		"PersistentVolume":        {gvk.PersistentVolume},
+		"PersistentVolumeClaim":   {gvk.PersistentVolumeClaim},
+		"StorageClass":            {gvk.StorageClass},
+		"Gateway":                 {gvk.Gateway},
+		"HTTPRoute":               {gvk.HTTPRoute},
+	}
+
+	var dynamicFactory dynamicinformer.DynamicSharedInformerFactory
+	if rw.dynamicClient != nil {
+		dynamicFactory = dynamicinformer.NewFilteredDynamicSharedInformerFactory(
+			rw.dynamicClient,
+			rw.config.MetadataCollectionInterval,
+			rw.config.Namespace,
+			nil,
+		)
 	}
 
 	for kind, gvks := range supportedKinds {
+		if !rw.isKindEnabled(kind) {
+			continue
+		}
 		anySupported := false
 		for _, gvk := range gvks {
 			supported, err := rw.isKindSupported(gvk)
@@ -138,7 +178,11 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 			}
 			if supported {
 				anySupported = true
-				rw.setupInformerForKind(gvk, factory)
+				if resource, ok := dynamicResources[gvk]; ok && dynamicFactory != nil {
+					rw.setupInformer(gvk, dynamicFactory.ForResource(resource).Informer())
+				} else {
+					rw.setupInformerForKind(gvk, factory)
+				}
 			}
 		}
 		if !anySupported {
@@ -153,7 +197,50 @@ func (rw *resourceWatcher) prepareSharedInformerFactory() error {
 		rw.informerFactories = append(rw.informerFactories, quotaFactory)
 	}
 	rw.informerFactories = append(rw.informerFactories, factory)
+	if dynamicFactory != nil {
+		rw.informerFactories = append(rw.informerFactories, dynamicSharedInformer{dynamicFactory})
+	}
+
+	return nil
+}
+
+// isKindEnabled returns whether the objects of a kind must be watched. The kinds added after the
+// initial set of kinds are only watched if one of their metrics is enabled, as they require
+// permissions that the receiver's existing deployments haven't been granted.
+func (rw *resourceWatcher) isKindEnabled(kind string) bool {
+	m := rw.config.Metrics
+	switch kind {
+	case gvk.Ingress.Kind:
+		return m.K8sIngressRules.Enabled || m.K8sIngressLoadBalancerAddresses.Enabled
+	case gvk.PersistentVolume.Kind:
+		return m.K8sPersistentvolumePhase.Enabled || m.K8sPersistentvolumeCapacity.Enabled
+	case gvk.PersistentVolumeClaim.Kind:
+		return m.K8sPersistentvolumeclaimPhase.Enabled || m.K8sPersistentvolumeclaimCapacity.Enabled ||
+			m.K8sPersistentvolumeclaimStorageRequest.Enabled
+	case gvk.StorageClass.Kind:
+		// Storage classes provide the provisioner of the persistent volumes and claims.
+		return rw.isKindEnabled(gvk.PersistentVolume.Kind) || rw.isKindEnabled(gvk.PersistentVolumeClaim.Kind)
+	case gvk.Gateway.Kind:
+		return m.K8sGatewayCondition.Enabled
+	case gvk.HTTPRoute.Kind:
+		return m.K8sHttprouteCondition.Enabled
+	}
+	return true
+}
+
+// dynamicResources maps the kinds watched through the dynamic client to their resources.
+var dynamicResources = map[schema.GroupVersionKind]schema.GroupVersionResource{
+	gvk.Gateway:   gvk.Gateway.GroupVersion().WithResource("gateways"),
+	gvk.HTTPRoute: gvk.HTTPRoute.GroupVersion().WithResource("httproutes"),
+}
 
+// dynamicSharedInformer adapts a dynamic informer factory to the sharedInformer interface.
+type dynamicSharedInformer struct {
+	dynamicinformer.DynamicSharedInformerFactory
+}
+
+func (f dynamicSharedInformer) WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool {
+	f.DynamicSharedInformerFactory.WaitForCacheSync(stopCh)
 	return nil
 }
 
@@ -225,6 +312,18 @@ func (rw *resourceWatcher) setupInformerForKind(kind schema.GroupVersionKind, fa
 		rw.setupInformer(kind, factory.Batch().V1().CronJobs().Informer())
 	case gvk.HorizontalPodAutoscaler:
 		rw.setupInformer(kind, factory.Autoscaling().V2().HorizontalPodAutoscalers().Informer())
+	case gvk.Ingress:
+		rw.setupInformer(kind, factory.Networking().V1().Ingresses().Informer())
+	case gvk.PersistentVolume:
+		if rw.config.Namespace == "" {
+			rw.setupInformer(kind, factory.Core().V1().PersistentVolumes().Informer())
+		}
+	case gvk.PersistentVolumeClaim:
+		rw.setupInformer(kind, factory.Core().V1().PersistentVolumeClaims().Informer())
+	case gvk.StorageClass:
+		if rw.config.Namespace == "" {
+			rw.setupInformer(kind, factory.Storage().V1().StorageClasses().Informer())
+		}
 	default:
 		rw.logger.Error("Could not setup an informer for provided group version kind",
 			zap.String("group version kind", kind.String()))
@@ -329,6 +428,18 @@ func (rw *resourceWatcher) objMetadata(obj any) map[experimentalmetricmetadata.R
 		return hpa.GetMetadata(o)
 	case *corev1.Namespace:
 		return namespace.GetMetadata(o)
+	case *networkingv1.Ingress:
+		return ingress.GetMetadata(o)
+	case *corev1.PersistentVolume:
+		return persistentvolume.GetMetadata(o)
+	case *corev1.PersistentVolumeClaim:
+		return persistentvolumeclaim.GetMetadata(o)
+	case *storagev1.StorageClass:
+		return storageclass.GetMetadata(o)
+	case *gateway.Gateway:
+		return gateway.GetMetadata(o)
+	case *httproute.HTTPRoute:
+		return httproute.GetMetadata(o)
 	}
 	return nil
 }
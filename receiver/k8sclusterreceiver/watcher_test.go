@@ -4,6 +4,7 @@
 package k8sclusterreceiver
 
 import (
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,14 +19,18 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/internal/common/maps"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/experimentalmetricmetadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gateway"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/gvk"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/httproute"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/metadata"
 	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/k8sclusterreceiver/internal/testutils"
 )
@@ -205,6 +210,96 @@ func TestPrepareSharedInformerFactory(t *testing.T) {
 	}
 }
 
+func TestIsKindEnabled(t *testing.T) {
+	rw := &resourceWatcher{config: &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}}
+	for _, kind := range []schema.GroupVersionKind{gvk.Pod, gvk.Node, gvk.Deployment, gvk.HorizontalPodAutoscaler} {
+		assert.True(t, rw.isKindEnabled(kind.Kind), kind.Kind)
+	}
+	// The kinds that require additional permissions are only watched if their metrics are enabled.
+	for _, kind := range []schema.GroupVersionKind{gvk.Ingress, gvk.PersistentVolume, gvk.PersistentVolumeClaim, gvk.StorageClass, gvk.Gateway, gvk.HTTPRoute} {
+		assert.False(t, rw.isKindEnabled(kind.Kind), kind.Kind)
+	}
+
+	rw.config.Metrics.K8sIngressRules.Enabled = true
+	rw.config.Metrics.K8sPersistentvolumeclaimStorageRequest.Enabled = true
+	rw.config.Metrics.K8sHttprouteCondition.Enabled = true
+	assert.True(t, rw.isKindEnabled(gvk.Ingress.Kind))
+	assert.False(t, rw.isKindEnabled(gvk.PersistentVolume.Kind))
+	assert.True(t, rw.isKindEnabled(gvk.PersistentVolumeClaim.Kind))
+	assert.True(t, rw.isKindEnabled(gvk.StorageClass.Kind))
+	assert.False(t, rw.isKindEnabled(gvk.Gateway.Kind))
+	assert.True(t, rw.isKindEnabled(gvk.HTTPRoute.Kind))
+}
+
+func TestPrepareSharedInformerFactoryWithOptionalKinds(t *testing.T) {
+	client := newFakeClientWithAllResources()
+	client.Resources[0].APIResources = append(client.Resources[0].APIResources,
+		gvkToAPIResource(gvk.PersistentVolume),
+		gvkToAPIResource(gvk.PersistentVolumeClaim),
+	)
+	client.Resources = append(client.Resources,
+		&metav1.APIResourceList{
+			GroupVersion: "networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{gvkToAPIResource(gvk.Ingress)},
+		},
+		&metav1.APIResourceList{
+			GroupVersion: "storage.k8s.io/v1",
+			APIResources: []metav1.APIResource{gvkToAPIResource(gvk.StorageClass)},
+		},
+		&metav1.APIResourceList{
+			GroupVersion: "gateway.networking.k8s.io/v1",
+			APIResources: []metav1.APIResource{gvkToAPIResource(gvk.Gateway), gvkToAPIResource(gvk.HTTPRoute)},
+		},
+	)
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		dynamicResources[gvk.Gateway]:   "GatewayList",
+		dynamicResources[gvk.HTTPRoute]: "HTTPRouteList",
+	})
+	// The resource of the Gateways can't be guessed from their kind, so the objects are added to the tracker
+	// with their resource rather than to the client constructor.
+	require.NoError(t, dynamicClient.Tracker().Create(dynamicResources[gvk.Gateway], testutils.NewGateway("1"), "test-namespace"))
+	require.NoError(t, dynamicClient.Tracker().Create(dynamicResources[gvk.HTTPRoute], testutils.NewHTTPRoute("1"), "test-namespace"))
+
+	cfg := &Config{MetricsBuilderConfig: metadata.DefaultMetricsBuilderConfig()}
+	cfg.Metrics.K8sIngressRules.Enabled = true
+	cfg.Metrics.K8sPersistentvolumePhase.Enabled = true
+	cfg.Metrics.K8sPersistentvolumeclaimPhase.Enabled = true
+	cfg.Metrics.K8sGatewayCondition.Enabled = true
+	cfg.Metrics.K8sHttprouteCondition.Enabled = true
+
+	obs, logs := observer.New(zap.WarnLevel)
+	rw := &resourceWatcher{
+		client:              client,
+		dynamicClient:       dynamicClient,
+		logger:              zap.New(obs),
+		metadataStore:       metadata.NewStore(),
+		config:              cfg,
+		initialSyncDone:     &atomic.Bool{},
+		initialSyncTimedOut: &atomic.Bool{},
+	}
+	rw.initialSyncDone.Store(true)
+
+	require.NoError(t, rw.prepareSharedInformerFactory())
+	assert.Equal(t, 0, logs.Len())
+	for _, kind := range []schema.GroupVersionKind{gvk.Ingress, gvk.PersistentVolume, gvk.PersistentVolumeClaim, gvk.StorageClass, gvk.Gateway, gvk.HTTPRoute} {
+		assert.NotNil(t, rw.metadataStore.Get(kind), kind.Kind)
+	}
+	require.Len(t, rw.informerFactories, 2)
+
+	// The Gateway API objects are stored in their internal representation.
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	for _, factory := range rw.informerFactories {
+		factory.Start(stopCh)
+		factory.WaitForCacheSync(stopCh)
+	}
+	require.Eventually(t, func() bool {
+		return len(rw.metadataStore.Get(gvk.Gateway).List()) == 1 && len(rw.metadataStore.Get(gvk.HTTPRoute).List()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.IsType(t, &gateway.Gateway{}, rw.metadataStore.Get(gvk.Gateway).List()[0])
+	assert.IsType(t, &httproute.HTTPRoute{}, rw.metadataStore.Get(gvk.HTTPRoute).List()[0])
+}
+
 func TestSetupInformerForKind(t *testing.T) {
 	obs, logs := observer.New(zap.WarnLevel)
 	obsLogger := zap.New(obs)
@@ -5,6 +5,7 @@ package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-
 
 import (
 	"context"
+	"errors"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/consumer"
@@ -42,12 +43,44 @@ type Config struct {
 	fileconsumer.Config `mapstructure:",squash"`
 	StorageID           *component.ID `mapstructure:"storage"`
 	ReplayFile          bool          `mapstructure:"replay_file"`
+	Replay              ReplayConfig  `mapstructure:"replay"`
+}
+
+func (c *Config) Validate() error {
+	if !c.Replay.Enabled {
+		if c.Replay.Loop || c.Replay.RewriteTimestamps {
+			return errors.New("replay.loop and replay.rewrite_timestamps require replay.enabled")
+		}
+		return nil
+	}
+	if c.Replay.SpeedFactor <= 0 {
+		return errors.New("replay.speed_factor must be greater than 0")
+	}
+	if c.Replay.Loop && c.StartAt != "beginning" {
+		return errors.New("replay.loop requires start_at to be beginning")
+	}
+	return nil
 }
 
 func createDefaultConfig() component.Config {
 	return &Config{
 		Config: *fileconsumer.NewConfig(),
+		Replay: ReplayConfig{
+			SpeedFactor: 1,
+		},
+	}
+}
+
+// options returns the fileconsumer options and the replayer, if replay is enabled.
+func (c *Config) options() ([]fileconsumer.Option, *replayer) {
+	opts := make([]fileconsumer.Option, 0)
+	if c.ReplayFile || c.Replay.Loop {
+		opts = append(opts, fileconsumer.WithNoTracking())
 	}
+	if !c.Replay.Enabled {
+		return opts, nil
+	}
+	return opts, newReplayer(c.Replay)
 }
 
 type otlpjsonfilereceiver struct {
@@ -79,10 +112,7 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts, replay := cfg.options()
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartLogsOp(ctx)
@@ -104,7 +134,12 @@ func createLogsReceiver(_ context.Context, settings receiver.Settings, configura
 							}
 						}
 					}
-					err = logs.ConsumeLogs(ctx, l)
+					if replay != nil {
+						err = replay.replayLogs(ctx, l)
+					}
+					if err == nil {
+						err = logs.ConsumeLogs(ctx, l)
+					}
 				}
 				obsrecv.EndLogsOp(ctx, metadata.Type.String(), logRecordCount, err)
 			}
@@ -129,10 +164,7 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts, replay := cfg.options()
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartMetricsOp(ctx)
@@ -153,7 +185,12 @@ func createMetricsReceiver(_ context.Context, settings receiver.Settings, config
 							}
 						}
 					}
-					err = metrics.ConsumeMetrics(ctx, m)
+					if replay != nil {
+						err = replay.replayMetrics(ctx, m)
+					}
+					if err == nil {
+						err = metrics.ConsumeMetrics(ctx, m)
+					}
 				}
 				obsrecv.EndMetricsOp(ctx, metadata.Type.String(), m.MetricCount(), err)
 			}
@@ -178,10 +215,7 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 		return nil, err
 	}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts, replay := cfg.options()
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, attributes map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			ctx = obsrecv.StartTracesOp(ctx)
@@ -202,7 +236,12 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 							}
 						}
 					}
-					err = traces.ConsumeTraces(ctx, t)
+					if replay != nil {
+						err = replay.replayTraces(ctx, t)
+					}
+					if err == nil {
+						err = traces.ConsumeTraces(ctx, t)
+					}
 				}
 				obsrecv.EndTracesOp(ctx, metadata.Type.String(), t.SpanCount(), err)
 			}
@@ -219,15 +258,15 @@ func createTracesReceiver(_ context.Context, settings receiver.Settings, configu
 func createProfilesReceiver(_ context.Context, settings receiver.Settings, configuration component.Config, profiles xconsumer.Profiles) (xreceiver.Profiles, error) {
 	profilesUnmarshaler := &pprofile.JSONUnmarshaler{}
 	cfg := configuration.(*Config)
-	opts := make([]fileconsumer.Option, 0)
-	if cfg.ReplayFile {
-		opts = append(opts, fileconsumer.WithNoTracking())
-	}
+	opts, replay := cfg.options()
 	input, err := cfg.Build(settings.TelemetrySettings, func(ctx context.Context, tokens [][]byte, _ map[string]any, _ int64, _ []int64) error {
 		for _, token := range tokens {
 			p, _ := profilesUnmarshaler.UnmarshalProfiles(token)
 			// TODO Append token.Attributes
 			if p.ResourceProfiles().Len() != 0 {
+				if replay != nil && replay.replayProfiles(ctx, p) != nil {
+					continue
+				}
 				_ = profiles.ConsumeProfiles(ctx, p)
 			}
 		}
@@ -16,6 +16,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/confmap/confmaptest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/pdata/pprofile"
@@ -215,6 +216,9 @@ func testdataConfigYamlAsMap() *Config {
 				Exclude: []string{"/var/log/example.log"},
 			},
 		},
+		Replay: ReplayConfig{
+			SpeedFactor: 1,
+		},
 	}
 }
 
@@ -231,6 +235,112 @@ func TestLoadConfig(t *testing.T) {
 	assert.Equal(t, testdataConfigYamlAsMap(), cfg)
 }
 
+func TestLoadConfigReplay(t *testing.T) {
+	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		expected     ReplayConfig
+		errorMessage string
+	}{
+		{
+			name: "replay",
+			expected: ReplayConfig{
+				Enabled:           true,
+				SpeedFactor:       10,
+				RewriteTimestamps: true,
+				Loop:              true,
+			},
+		},
+		{
+			name:         "replay_invalid_speed_factor",
+			errorMessage: "replay.speed_factor must be greater than 0",
+		},
+		{
+			name:         "replay_loop_start_at_end",
+			errorMessage: "replay.loop requires start_at to be beginning",
+		},
+		{
+			name:         "replay_disabled",
+			errorMessage: "replay.loop and replay.rewrite_timestamps require replay.enabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := NewFactory().CreateDefaultConfig().(*Config)
+			sub, err := cm.Sub(component.NewIDWithName(metadata.Type, tt.name).String())
+			require.NoError(t, err)
+			require.NoError(t, sub.Unmarshal(cfg))
+
+			if tt.errorMessage != "" {
+				assert.EqualError(t, cfg.Validate(), tt.errorMessage)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+			assert.Equal(t, tt.expected, cfg.Replay)
+		})
+	}
+}
+
+func TestFileLogsReceiverReplay(t *testing.T) {
+	tempFolder := t.TempDir()
+	factory := NewFactory()
+	cfg := createDefaultConfig().(*Config)
+	cfg.Include = []string{filepath.Join(tempFolder, "*")}
+	cfg.StartAt = "beginning"
+	cfg.IncludeFileName = false
+	cfg.PollInterval = 100 * time.Millisecond
+	cfg.Replay = ReplayConfig{
+		Enabled:           true,
+		SpeedFactor:       10,
+		RewriteTimestamps: true,
+		Loop:              true,
+	}
+
+	// Three lines recorded a second apart, replayed ten times faster.
+	origin := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	marshaler := &plog.JSONMarshaler{}
+	var b []byte
+	for i := 0; i < 3; i++ {
+		ld := plog.NewLogs()
+		lr := ld.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+		lr.SetTimestamp(pcommon.NewTimestampFromTime(origin.Add(time.Duration(i) * time.Second)))
+		lr.Body().SetInt(int64(i))
+		line, err := marshaler.MarshalLogs(ld)
+		require.NoError(t, err)
+		b = append(b, line...)
+		b = append(b, '\n')
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(tempFolder, "logs.json"), b, 0o600))
+
+	sink := new(consumertest.LogsSink)
+	receiver, err := factory.CreateLogs(context.Background(), receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	start := time.Now()
+	require.NoError(t, receiver.Start(context.Background(), nil))
+
+	// The files are replayed again once done.
+	require.Eventually(t, func() bool {
+		return sink.LogRecordCount() >= 6
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, receiver.Shutdown(context.Background()))
+
+	logs := sink.AllLogs()
+	timestamps := make([]time.Time, 0, len(logs))
+	for i, ld := range logs[:6] {
+		lr := ld.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+		assert.Equal(t, int64(i%3), lr.Body().Int())
+		timestamps = append(timestamps, lr.Timestamp().AsTime())
+	}
+	// The first pass takes 200ms instead of 2s, and the timestamps are rewritten relative to the replay.
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	assert.WithinDuration(t, start, timestamps[0], time.Second)
+	assert.Equal(t, 100*time.Millisecond, timestamps[1].Sub(timestamps[0]))
+	assert.Equal(t, 100*time.Millisecond, timestamps[2].Sub(timestamps[1]))
+	assert.True(t, timestamps[3].After(timestamps[2]))
+}
+
 func TestFileMixedSignals(t *testing.T) {
 	tempFolder := t.TempDir()
 	factory := NewFactory()
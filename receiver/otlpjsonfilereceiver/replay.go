@@ -0,0 +1,306 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/otlpjsonfilereceiver"
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// ReplayConfig configures the emission of the file contents at the pace given by their timestamps.
+type ReplayConfig struct {
+	// Enabled paces the emission of each line according to the earliest timestamp it contains,
+	// relative to the first line read.
+	Enabled bool `mapstructure:"enabled"`
+	// SpeedFactor divides the delays between lines, 2 replays twice as fast as the original data.
+	SpeedFactor float64 `mapstructure:"speed_factor"`
+	// RewriteTimestamps shifts the timestamps of each line so that it appears to be emitted now.
+	RewriteTimestamps bool `mapstructure:"rewrite_timestamps"`
+	// Loop reads the files again from the beginning once they have been replayed.
+	Loop bool `mapstructure:"loop"`
+}
+
+// replayer schedules the emission of the unmarshaled lines. It is shared by all the files
+// read by a receiver, so that they are replayed on the same timeline.
+type replayer struct {
+	speedFactor float64
+	rewrite     bool
+	loop        bool
+	now         func() time.Time
+	sleep       func(ctx context.Context, d time.Duration) error
+
+	mu sync.Mutex
+	// origin is the earliest timestamp of the current pass, emitted at wallStart.
+	origin    pcommon.Timestamp
+	wallStart time.Time
+	latest    pcommon.Timestamp
+}
+
+func newReplayer(cfg ReplayConfig) *replayer {
+	return &replayer{
+		speedFactor: cfg.SpeedFactor,
+		rewrite:     cfg.RewriteTimestamps,
+		loop:        cfg.Loop,
+		now:         time.Now,
+		sleep:       sleepContext,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// wait blocks until a line whose earliest timestamp is ts is due, and returns the offset in
+// nanoseconds to add to its timestamps, which is zero unless timestamps are rewritten.
+// Lines without timestamps are emitted immediately.
+func (r *replayer) wait(ctx context.Context, ts pcommon.Timestamp) (int64, error) {
+	if ts == 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	now := r.now()
+	switch {
+	case r.wallStart.IsZero():
+		r.origin, r.wallStart = ts, now
+	case r.loop && ts <= r.origin && r.latest > r.origin:
+		// The files are read again from the beginning, start a new pass.
+		r.origin, r.wallStart, r.latest = ts, now, 0
+	}
+	r.latest = max(r.latest, ts)
+	// Lines older than the first one are emitted immediately.
+	target := r.wallStart.Add(time.Duration(float64(int64(ts)-int64(r.origin)) / r.speedFactor))
+	r.mu.Unlock()
+
+	if err := r.sleep(ctx, target.Sub(now)); err != nil {
+		return 0, err
+	}
+	if !r.rewrite {
+		return 0, nil
+	}
+	return target.UnixNano() - int64(ts), nil
+}
+
+func (r *replayer) replayLogs(ctx context.Context, ld plog.Logs) error {
+	delta, err := r.wait(ctx, logsStartTime(ld))
+	if err != nil || delta == 0 {
+		return err
+	}
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		scopeLogs := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			logRecords := scopeLogs.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				lr := logRecords.At(k)
+				lr.SetTimestamp(shiftTimestamp(lr.Timestamp(), delta))
+				lr.SetObservedTimestamp(shiftTimestamp(lr.ObservedTimestamp(), delta))
+			}
+		}
+	}
+	return nil
+}
+
+func (r *replayer) replayMetrics(ctx context.Context, md pmetric.Metrics) error {
+	delta, err := r.wait(ctx, metricsStartTime(md))
+	if err != nil || delta == 0 {
+		return err
+	}
+	forEachDataPoint(md, func(start, ts pcommon.Timestamp, exemplars pmetric.ExemplarSlice) (pcommon.Timestamp, pcommon.Timestamp) {
+		for i := 0; i < exemplars.Len(); i++ {
+			exemplars.At(i).SetTimestamp(shiftTimestamp(exemplars.At(i).Timestamp(), delta))
+		}
+		return shiftTimestamp(start, delta), shiftTimestamp(ts, delta)
+	})
+	return nil
+}
+
+func (r *replayer) replayTraces(ctx context.Context, td ptrace.Traces) error {
+	delta, err := r.wait(ctx, tracesStartTime(td))
+	if err != nil || delta == 0 {
+		return err
+	}
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		scopeSpans := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				span.SetStartTimestamp(shiftTimestamp(span.StartTimestamp(), delta))
+				span.SetEndTimestamp(shiftTimestamp(span.EndTimestamp(), delta))
+				for l := 0; l < span.Events().Len(); l++ {
+					event := span.Events().At(l)
+					event.SetTimestamp(shiftTimestamp(event.Timestamp(), delta))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *replayer) replayProfiles(ctx context.Context, pd pprofile.Profiles) error {
+	delta, err := r.wait(ctx, profilesStartTime(pd))
+	if err != nil || delta == 0 {
+		return err
+	}
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		scopeProfiles := pd.ResourceProfiles().At(i).ScopeProfiles()
+		for j := 0; j < scopeProfiles.Len(); j++ {
+			profiles := scopeProfiles.At(j).Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				profile := profiles.At(k)
+				profile.SetTime(shiftTimestamp(profile.Time(), delta))
+				profile.SetStartTime(shiftTimestamp(profile.StartTime(), delta))
+				for l := 0; l < profile.Sample().Len(); l++ {
+					timestamps := profile.Sample().At(l).TimestampsUnixNano()
+					for m := 0; m < timestamps.Len(); m++ {
+						timestamps.SetAt(m, uint64(shiftTimestamp(pcommon.Timestamp(timestamps.At(m)), delta)))
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// shiftTimestamp adds delta to ts, unset timestamps are left unset.
+func shiftTimestamp(ts pcommon.Timestamp, delta int64) pcommon.Timestamp {
+	if ts == 0 {
+		return 0
+	}
+	return pcommon.Timestamp(int64(ts) + delta)
+}
+
+// earliest returns the earliest of the non-zero timestamps a and b.
+func earliest(a, b pcommon.Timestamp) pcommon.Timestamp {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+func logsStartTime(ld plog.Logs) pcommon.Timestamp {
+	var start pcommon.Timestamp
+	for i := 0; i < ld.ResourceLogs().Len(); i++ {
+		scopeLogs := ld.ResourceLogs().At(i).ScopeLogs()
+		for j := 0; j < scopeLogs.Len(); j++ {
+			logRecords := scopeLogs.At(j).LogRecords()
+			for k := 0; k < logRecords.Len(); k++ {
+				lr := logRecords.At(k)
+				ts := lr.Timestamp()
+				if ts == 0 {
+					ts = lr.ObservedTimestamp()
+				}
+				start = earliest(start, ts)
+			}
+		}
+	}
+	return start
+}
+
+func metricsStartTime(md pmetric.Metrics) pcommon.Timestamp {
+	var start pcommon.Timestamp
+	forEachDataPoint(md, func(startTs, ts pcommon.Timestamp, _ pmetric.ExemplarSlice) (pcommon.Timestamp, pcommon.Timestamp) {
+		start = earliest(start, ts)
+		return startTs, ts
+	})
+	return start
+}
+
+func tracesStartTime(td ptrace.Traces) pcommon.Timestamp {
+	var start pcommon.Timestamp
+	for i := 0; i < td.ResourceSpans().Len(); i++ {
+		scopeSpans := td.ResourceSpans().At(i).ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				start = earliest(start, spans.At(k).StartTimestamp())
+			}
+		}
+	}
+	return start
+}
+
+func profilesStartTime(pd pprofile.Profiles) pcommon.Timestamp {
+	var start pcommon.Timestamp
+	for i := 0; i < pd.ResourceProfiles().Len(); i++ {
+		scopeProfiles := pd.ResourceProfiles().At(i).ScopeProfiles()
+		for j := 0; j < scopeProfiles.Len(); j++ {
+			profiles := scopeProfiles.At(j).Profiles()
+			for k := 0; k < profiles.Len(); k++ {
+				start = earliest(start, profiles.At(k).Time())
+			}
+		}
+	}
+	return start
+}
+
+// forEachDataPoint calls fn with the start and end timestamps and the exemplars of every data point,
+// and sets the timestamps it returns.
+func forEachDataPoint(md pmetric.Metrics, fn func(start, ts pcommon.Timestamp, exemplars pmetric.ExemplarSlice) (pcommon.Timestamp, pcommon.Timestamp)) {
+	for i := 0; i < md.ResourceMetrics().Len(); i++ {
+		scopeMetrics := md.ResourceMetrics().At(i).ScopeMetrics()
+		for j := 0; j < scopeMetrics.Len(); j++ {
+			metrics := scopeMetrics.At(j).Metrics()
+			for k := 0; k < metrics.Len(); k++ {
+				metric := metrics.At(k)
+				switch metric.Type() {
+				case pmetric.MetricTypeGauge:
+					numberDataPoints(metric.Gauge().DataPoints(), fn)
+				case pmetric.MetricTypeSum:
+					numberDataPoints(metric.Sum().DataPoints(), fn)
+				case pmetric.MetricTypeHistogram:
+					dps := metric.Histogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						start, ts := fn(dp.StartTimestamp(), dp.Timestamp(), dp.Exemplars())
+						dp.SetStartTimestamp(start)
+						dp.SetTimestamp(ts)
+					}
+				case pmetric.MetricTypeExponentialHistogram:
+					dps := metric.ExponentialHistogram().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						start, ts := fn(dp.StartTimestamp(), dp.Timestamp(), dp.Exemplars())
+						dp.SetStartTimestamp(start)
+						dp.SetTimestamp(ts)
+					}
+				case pmetric.MetricTypeSummary:
+					dps := metric.Summary().DataPoints()
+					for l := 0; l < dps.Len(); l++ {
+						dp := dps.At(l)
+						start, ts := fn(dp.StartTimestamp(), dp.Timestamp(), pmetric.NewExemplarSlice())
+						dp.SetStartTimestamp(start)
+						dp.SetTimestamp(ts)
+					}
+				}
+			}
+		}
+	}
+}
+
+func numberDataPoints(dps pmetric.NumberDataPointSlice, fn func(start, ts pcommon.Timestamp, exemplars pmetric.ExemplarSlice) (pcommon.Timestamp, pcommon.Timestamp)) {
+	for i := 0; i < dps.Len(); i++ {
+		dp := dps.At(i)
+		start, ts := fn(dp.StartTimestamp(), dp.Timestamp(), dp.Exemplars())
+		dp.SetStartTimestamp(start)
+		dp.SetTimestamp(ts)
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpjsonfilereceiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// newTestReplayer returns a replayer whose clock only advances when it sleeps.
+func newTestReplayer(cfg ReplayConfig) (*replayer, *[]time.Duration) {
+	clock := time.Date(2025, time.June, 1, 12, 0, 0, 0, time.UTC)
+	var sleeps []time.Duration
+	r := newReplayer(cfg)
+	r.now = func() time.Time { return clock }
+	r.sleep = func(_ context.Context, d time.Duration) error {
+		sleeps = append(sleeps, d)
+		if d > 0 {
+			clock = clock.Add(d)
+		}
+		return nil
+	}
+	return r, &sleeps
+}
+
+func ts(seconds int) pcommon.Timestamp {
+	return pcommon.Timestamp(int64(seconds) * int64(time.Second))
+}
+
+func TestReplayerPacing(t *testing.T) {
+	r, sleeps := newTestReplayer(ReplayConfig{Enabled: true, SpeedFactor: 2})
+	for _, s := range []int{100, 102, 102, 101, 110} {
+		delta, err := r.wait(context.Background(), ts(s))
+		require.NoError(t, err)
+		assert.Zero(t, delta)
+	}
+	// Lines without timestamps are not delayed.
+	_, err := r.wait(context.Background(), 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, []time.Duration{0, time.Second, 0, -500 * time.Millisecond, 4 * time.Second}, *sleeps)
+}
+
+func TestReplayerLoop(t *testing.T) {
+	r, sleeps := newTestReplayer(ReplayConfig{Enabled: true, SpeedFactor: 1, Loop: true})
+	for _, s := range []int{100, 101, 103, 100, 101} {
+		_, err := r.wait(context.Background(), ts(s))
+		require.NoError(t, err)
+	}
+	// The second pass starts over when the first timestamp is read again.
+	assert.Equal(t, []time.Duration{0, time.Second, 2 * time.Second, 0, time.Second}, *sleeps)
+}
+
+func TestReplayerRewriteTimestamps(t *testing.T) {
+	r, _ := newTestReplayer(ReplayConfig{Enabled: true, SpeedFactor: 1, RewriteTimestamps: true})
+	now := r.now()
+
+	td := ptrace.NewTraces()
+	span := td.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans().AppendEmpty()
+	span.SetStartTimestamp(ts(10))
+	span.SetEndTimestamp(ts(12))
+	span.Events().AppendEmpty().SetTimestamp(ts(11))
+	require.NoError(t, r.replayTraces(context.Background(), td))
+
+	assert.Equal(t, now, span.StartTimestamp().AsTime())
+	assert.Equal(t, now.Add(2*time.Second), span.EndTimestamp().AsTime())
+	assert.Equal(t, now.Add(time.Second), span.Events().At(0).Timestamp().AsTime())
+
+	md := pmetric.NewMetrics()
+	dp := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics().AppendEmpty().SetEmptySum().DataPoints().AppendEmpty()
+	dp.SetTimestamp(ts(15))
+	dp.Exemplars().AppendEmpty().SetTimestamp(ts(14))
+	require.NoError(t, r.replayMetrics(context.Background(), md))
+
+	// Emitted 5s after the spans, keeping the start timestamp unset.
+	assert.Equal(t, now.Add(5*time.Second), dp.Timestamp().AsTime())
+	assert.Zero(t, dp.StartTimestamp())
+	assert.Equal(t, now.Add(4*time.Second), dp.Exemplars().At(0).Timestamp().AsTime())
+}
+
+func TestReplayerCanceled(t *testing.T) {
+	r := newReplayer(ReplayConfig{Enabled: true, SpeedFactor: 1})
+	_, err := r.wait(context.Background(), ts(0)+1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.wait(ctx, ts(3600))
+	assert.ErrorIs(t, err, context.Canceled)
+}